@@ -491,6 +491,11 @@ func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddre
 	h.sh.OnValidatorBeginUnbonding(ctx, consAddr, valAddr)
 }
 
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	h.dh.OnValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+	h.sh.OnValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+}
+
 func (h Hooks) OnDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.dh.OnDelegationCreated(ctx, delAddr, valAddr)
 	h.sh.OnDelegationCreated(ctx, delAddr, valAddr)
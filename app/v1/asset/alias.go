@@ -6,29 +6,49 @@ import (
 )
 
 type (
-	MsgIssueToken           = types.MsgIssueToken
-	MsgCreateGateway        = types.MsgCreateGateway
-	MsgEditGateway          = types.MsgEditGateway
-	MsgEditToken            = types.MsgEditToken
-	MsgTransferGatewayOwner = types.MsgTransferGatewayOwner
-	MsgMintToken            = types.MsgMintToken
-	MsgTransferTokenOwner   = types.MsgTransferTokenOwner
-	Tokens                  = types.Tokens
-	Gateway                 = types.Gateway
-	Gateways                = types.Gateways
-	Params                  = types.Params
-	FungibleToken           = types.FungibleToken
-	AssetFamily             = types.AssetFamily
-	AssetSource             = types.AssetSource
-	QueryTokenParams        = types.QueryTokenParams
-	QueryTokensParams       = types.QueryTokensParams
-	QueryGatewayParams      = types.QueryGatewayParams
-	QueryGatewaysParams     = types.QueryGatewaysParams
-	QueryGatewayFeeParams   = types.QueryGatewayFeeParams
-	QueryTokenFeesParams    = types.QueryTokenFeesParams
-	GatewayFeeOutput        = types.GatewayFeeOutput
-	TokenFeesOutput         = types.TokenFeesOutput
-	GenesisState            = types.GenesisState
+	MsgIssueToken            = types.MsgIssueToken
+	MsgCreateGateway         = types.MsgCreateGateway
+	MsgEditGateway           = types.MsgEditGateway
+	MsgEditToken             = types.MsgEditToken
+	MsgTransferGatewayOwner  = types.MsgTransferGatewayOwner
+	MsgMintToken             = types.MsgMintToken
+	MsgBurnToken             = types.MsgBurnToken
+	MsgFreezeToken           = types.MsgFreezeToken
+	MsgUnfreezeToken         = types.MsgUnfreezeToken
+	MsgEditWhitelist         = types.MsgEditWhitelist
+	MsgTransferTokenOwner    = types.MsgTransferTokenOwner
+	MsgMintNFT               = types.MsgMintNFT
+	MsgTransferNFT           = types.MsgTransferNFT
+	MsgEditNFT               = types.MsgEditNFT
+	MsgBurnNFT               = types.MsgBurnNFT
+	Tokens                   = types.Tokens
+	Gateway                  = types.Gateway
+	Gateways                 = types.Gateways
+	Params                   = types.Params
+	FungibleToken            = types.FungibleToken
+	Collection               = types.Collection
+	Collections              = types.Collections
+	NFT                      = types.NFT
+	NFTs                     = types.NFTs
+	AssetFamily              = types.AssetFamily
+	AssetSource              = types.AssetSource
+	QueryTokenParams         = types.QueryTokenParams
+	QueryTokensParams        = types.QueryTokensParams
+	QueryGatewayParams       = types.QueryGatewayParams
+	QueryGatewaysParams      = types.QueryGatewaysParams
+	QueryGatewayFeeParams    = types.QueryGatewayFeeParams
+	QueryTokenFeesParams     = types.QueryTokenFeesParams
+	QuerySupplyParams        = types.QuerySupplyParams
+	QueryTokenAccountsParams = types.QueryTokenAccountsParams
+	QueryCollectionParams    = types.QueryCollectionParams
+	QueryCollectionsParams   = types.QueryCollectionsParams
+	QueryNFTParams           = types.QueryNFTParams
+	QueryNFTsParams          = types.QueryNFTsParams
+	GatewayFeeOutput         = types.GatewayFeeOutput
+	TokenFeesOutput          = types.TokenFeesOutput
+	TokenSupply              = types.TokenSupply
+	TokenAccounts            = types.TokenAccounts
+	GenesisState             = types.GenesisState
 
 	Keeper = keeper.Keeper
 )
@@ -38,6 +58,7 @@ var (
 	EXTERNAL               = types.EXTERNAL
 	GATEWAY                = types.GATEWAY
 	FUNGIBLE               = types.FUNGIBLE
+	NON_FUNGIBLE           = types.NON_FUNGIBLE
 	DefaultCodespace       = types.DefaultCodespace
 	DefaultParamSpace      = types.DefaultParamSpace
 	DoNotModify            = types.DoNotModify
@@ -60,8 +81,19 @@ var (
 	NewMsgEditToken            = types.NewMsgEditToken
 	NewMsgTransferGatewayOwner = types.NewMsgTransferGatewayOwner
 	NewMsgMintToken            = types.NewMsgMintToken
+	NewMsgBurnToken            = types.NewMsgBurnToken
+	NewMsgFreezeToken          = types.NewMsgFreezeToken
+	NewMsgUnfreezeToken        = types.NewMsgUnfreezeToken
+	NewMsgEditWhitelist        = types.NewMsgEditWhitelist
+	NewTokenAccounts           = types.NewTokenAccounts
 	NewMsgTransferTokenOwner   = types.NewMsgTransferTokenOwner
 	NewMsgIssueToken           = types.NewMsgIssueToken
+	NewCollection              = types.NewCollection
+	NewNFT                     = types.NewNFT
+	NewMsgMintNFT              = types.NewMsgMintNFT
+	NewMsgTransferNFT          = types.NewMsgTransferNFT
+	NewMsgEditNFT              = types.NewMsgEditNFT
+	NewMsgBurnNFT              = types.NewMsgBurnNFT
 	DefaultParams              = types.DefaultParams
 	DefaultParamsForTest       = types.DefaultParamsForTest
 	ValidateParams             = types.ValidateParams
@@ -71,10 +103,18 @@ var (
 	QueryGateway                = types.QueryGateway
 	QueryGateways               = types.QueryGateways
 	QueryFees                   = types.QueryFees
+	QuerySupply                 = types.QuerySupply
+	QueryFrozen                 = types.QueryFrozen
+	QueryWhitelist              = types.QueryWhitelist
+	QueryCollection             = types.QueryCollection
+	QueryCollections            = types.QueryCollections
+	QueryNFT                    = types.QueryNFT
+	QueryNFTs                   = types.QueryNFTs
 	NewKeeper                   = keeper.NewKeeper
 	TokenIssueFeeHandler        = keeper.TokenIssueFeeHandler
 	GatewayTokenIssueFeeHandler = keeper.GatewayTokenIssueFeeHandler
 	GatewayCreateFeeHandler     = keeper.GatewayCreateFeeHandler
 	NewQuerier                  = keeper.NewQuerier
 	NewAnteHandler              = keeper.NewAnteHandler
+	NewFreezeAnteHandler        = keeper.NewFreezeAnteHandler
 )
@@ -25,6 +25,34 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 			panic(err.Error())
 		}
 	}
+
+	// init non-fungible collections
+	for _, collection := range data.Collections {
+		if _, err := k.AddCollection(ctx, collection); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	// init nfts
+	for _, nft := range data.NFTs {
+		if err := k.ImportNFT(ctx, nft); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	// init frozen accounts
+	for _, accounts := range data.FrozenAccounts {
+		if err := k.ImportTokenAccounts(ctx, accounts, true); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	// init whitelisted accounts
+	for _, accounts := range data.Whitelists {
+		if err := k.ImportTokenAccounts(ctx, accounts, false); err != nil {
+			panic(err.Error())
+		}
+	}
 }
 
 // ExportGenesis - output genesis parameters
@@ -36,34 +64,72 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		return false
 	})
 
-	// export created token
+	// export created token along with the frozen and whitelisted accounts
 	var tokens Tokens
+	var frozenAccounts, whitelists []TokenAccounts
 	k.IterateTokens(ctx, func(token FungibleToken) (stop bool) {
 		tokens = append(tokens, token)
+
+		if accounts := k.GetFrozenAccounts(ctx, token.GetUniqueID()); len(accounts) > 0 {
+			frozenAccounts = append(frozenAccounts, NewTokenAccounts(token.GetUniqueID(), accounts))
+		}
+		if accounts := k.GetWhitelist(ctx, token.GetUniqueID()); len(accounts) > 0 {
+			whitelists = append(whitelists, NewTokenAccounts(token.GetUniqueID(), accounts))
+		}
 		return false
 	})
+
+	// export issued collections
+	var collections Collections
+	k.IterateCollections(ctx, func(collection Collection) (stop bool) {
+		collections = append(collections, collection)
+		return false
+	})
+
+	// export minted nfts
+	var nfts NFTs
+	k.IterateNFTs(ctx, func(nft NFT) (stop bool) {
+		nfts = append(nfts, nft)
+		return false
+	})
+
 	return GenesisState{
-		Params:   k.GetParamSet(ctx),
-		Tokens:   tokens,
-		Gateways: gateways,
+		Params:      k.GetParamSet(ctx),
+		Tokens:      tokens,
+		Gateways:    gateways,
+		Collections: collections,
+		NFTs:        nfts,
+
+		FrozenAccounts: frozenAccounts,
+		Whitelists:     whitelists,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:   DefaultParams(),
-		Tokens:   []FungibleToken{},
-		Gateways: []Gateway{},
+		Params:      DefaultParams(),
+		Tokens:      []FungibleToken{},
+		Gateways:    []Gateway{},
+		Collections: []Collection{},
+		NFTs:        []NFT{},
+
+		FrozenAccounts: []TokenAccounts{},
+		Whitelists:     []TokenAccounts{},
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisStateForTest() GenesisState {
 	return GenesisState{
-		Params:   DefaultParamsForTest(),
-		Tokens:   []FungibleToken{},
-		Gateways: []Gateway{},
+		Params:      DefaultParamsForTest(),
+		Tokens:      []FungibleToken{},
+		Gateways:    []Gateway{},
+		Collections: []Collection{},
+		NFTs:        []NFT{},
+
+		FrozenAccounts: []TokenAccounts{},
+		Whitelists:     []TokenAccounts{},
 	}
 }
 
@@ -83,6 +149,26 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Tokens.Validate(); err != nil {
 		return err
 	}
+	// validate collections
+	if err := data.Collections.Validate(); err != nil {
+		return err
+	}
+	// validate nfts
+	if err := data.NFTs.Validate(); err != nil {
+		return err
+	}
+	// validate frozen accounts
+	for _, accounts := range data.FrozenAccounts {
+		if err := accounts.Validate(); err != nil {
+			return err
+		}
+	}
+	// validate whitelisted accounts
+	for _, accounts := range data.Whitelists {
+		if err := accounts.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -23,8 +23,24 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgTransferGatewayOwner(ctx, k, msg)
 		case MsgMintToken:
 			return handleMsgMintToken(ctx, k, msg)
+		case MsgBurnToken:
+			return handleMsgBurnToken(ctx, k, msg)
+		case MsgFreezeToken:
+			return handleMsgFreezeToken(ctx, k, msg)
+		case MsgUnfreezeToken:
+			return handleMsgUnfreezeToken(ctx, k, msg)
+		case MsgEditWhitelist:
+			return handleMsgEditWhitelist(ctx, k, msg)
 		case MsgTransferTokenOwner:
 			return handleMsgTransferTokenOwner(ctx, k, msg)
+		case MsgMintNFT:
+			return handleMsgMintNFT(ctx, k, msg)
+		case MsgTransferNFT:
+			return handleMsgTransferNFT(ctx, k, msg)
+		case MsgEditNFT:
+			return handleMsgEditNFT(ctx, k, msg)
+		case MsgBurnNFT:
+			return handleMsgBurnNFT(ctx, k, msg)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in asset module").Result()
 		}
@@ -40,25 +56,14 @@ func handleIssueToken(ctx sdk.Context, k Keeper, msg MsgIssueToken) sdk.Result {
 	case FUNGIBLE:
 		decimal := int(msg.Decimal)
 		token = NewFungibleToken(msg.Source, msg.Gateway, msg.Symbol, msg.Name, msg.Decimal, msg.CanonicalSymbol, msg.MinUnitAlias, sdk.NewIntWithDecimal(int64(msg.InitialSupply), decimal), sdk.NewIntWithDecimal(int64(msg.MaxSupply), decimal), msg.Mintable, msg.Owner)
+	case NON_FUNGIBLE:
+		return handleIssueCollection(ctx, k, msg)
 	default:
 		return ErrInvalidAssetFamily(DefaultCodespace, fmt.Sprintf("invalid asset family type %s", msg.Family)).Result()
 	}
 
-	switch msg.Source {
-	case NATIVE:
-		// handle fee for native token
-		if err := TokenIssueFeeHandler(ctx, k, msg.Owner, msg.Symbol); err != nil {
-			return err.Result()
-		}
-		break
-	case GATEWAY:
-		// handle fee for gateway token
-		if err := GatewayTokenIssueFeeHandler(ctx, k, msg.Owner, msg.Symbol); err != nil {
-			return err.Result()
-		}
-		break
-	default:
-		break
+	if err := handleIssueFee(ctx, k, msg); err != nil {
+		return err.Result()
 	}
 
 	tags, err := k.IssueToken(ctx, token)
@@ -71,6 +76,38 @@ func handleIssueToken(ctx sdk.Context, k Keeper, msg MsgIssueToken) sdk.Result {
 	}
 }
 
+// handleIssueCollection handles MsgIssueToken of the non-fungible family
+func handleIssueCollection(ctx sdk.Context, k Keeper, msg MsgIssueToken) sdk.Result {
+	collection := NewCollection(msg.Source, msg.Gateway, msg.Symbol, msg.Name, msg.MaxSupply, msg.Mintable, msg.Owner)
+
+	if err := handleIssueFee(ctx, k, msg); err != nil {
+		return err.Result()
+	}
+
+	tags, err := k.IssueCollection(ctx, collection)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleIssueFee handles the issuance fee of MsgIssueToken
+func handleIssueFee(ctx sdk.Context, k Keeper, msg MsgIssueToken) sdk.Error {
+	switch msg.Source {
+	case NATIVE:
+		// handle fee for native token
+		return TokenIssueFeeHandler(ctx, k, msg.Owner, msg.Symbol)
+	case GATEWAY:
+		// handle fee for gateway token
+		return GatewayTokenIssueFeeHandler(ctx, k, msg.Owner, msg.Symbol)
+	default:
+		return nil
+	}
+}
+
 // handleMsgCreateGateway handles MsgCreateGateway
 func handleMsgCreateGateway(ctx sdk.Context, k Keeper, msg MsgCreateGateway) sdk.Result {
 	// handle fee
@@ -155,3 +192,99 @@ func handleMsgMintToken(ctx sdk.Context, k Keeper, msg MsgMintToken) sdk.Result
 		Tags: tags,
 	}
 }
+
+// handleMsgBurnToken handles MsgBurnToken
+func handleMsgBurnToken(ctx sdk.Context, k Keeper, msg MsgBurnToken) sdk.Result {
+	tags, err := k.BurnToken(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgFreezeToken handles MsgFreezeToken
+func handleMsgFreezeToken(ctx sdk.Context, k Keeper, msg MsgFreezeToken) sdk.Result {
+	tags, err := k.FreezeToken(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgUnfreezeToken handles MsgUnfreezeToken
+func handleMsgUnfreezeToken(ctx sdk.Context, k Keeper, msg MsgUnfreezeToken) sdk.Result {
+	tags, err := k.UnfreezeToken(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgEditWhitelist handles MsgEditWhitelist
+func handleMsgEditWhitelist(ctx sdk.Context, k Keeper, msg MsgEditWhitelist) sdk.Result {
+	tags, err := k.EditWhitelist(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgMintNFT handles MsgMintNFT
+func handleMsgMintNFT(ctx sdk.Context, k Keeper, msg MsgMintNFT) sdk.Result {
+	tags, err := k.MintNFT(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgTransferNFT handles MsgTransferNFT
+func handleMsgTransferNFT(ctx sdk.Context, k Keeper, msg MsgTransferNFT) sdk.Result {
+	tags, err := k.TransferNFT(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgEditNFT handles MsgEditNFT
+func handleMsgEditNFT(ctx sdk.Context, k Keeper, msg MsgEditNFT) sdk.Result {
+	tags, err := k.EditNFT(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgBurnNFT handles MsgBurnNFT
+func handleMsgBurnNFT(ctx sdk.Context, k Keeper, msg MsgBurnNFT) sdk.Result {
+	tags, err := k.BurnNFT(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
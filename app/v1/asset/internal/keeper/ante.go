@@ -5,6 +5,7 @@ import (
 	"github.com/irisnet/irishub/app/v1/asset/internal/types"
 
 	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -78,3 +79,39 @@ func NewAnteHandler(k Keeper) sdk.AnteHandler {
 		return newCtx, sdk.Result{}, false
 	}
 }
+
+// NewFreezeAnteHandler returns an AnteHandler that checks if the tokens transferred
+// or burnt by bank.MsgSend and bank.MsgBurn are frozen or restricted by the whitelist
+func NewFreezeAnteHandler(k Keeper) sdk.AnteHandler {
+	return func(
+		ctx sdk.Context, tx sdk.Tx, simulate bool,
+	) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		// new ctx
+		newCtx = sdk.Context{}
+
+		for _, msg := range tx.GetMsgs() {
+			switch msg := msg.(type) {
+			case bank.MsgSend:
+				for _, input := range msg.Inputs {
+					if err := k.CheckSendable(ctx, input.Address, input.Coins, true); err != nil {
+						return newCtx, err.Result(), true
+					}
+				}
+
+				for _, output := range msg.Outputs {
+					if err := k.CheckReceivable(ctx, output.Address, output.Coins); err != nil {
+						return newCtx, err.Result(), true
+					}
+				}
+
+			case bank.MsgBurn:
+				if err := k.CheckSendable(ctx, msg.Owner, msg.Coins, false); err != nil {
+					return newCtx, err.Result(), true
+				}
+			}
+		}
+
+		// continue
+		return newCtx, sdk.Result{}, false
+	}
+}
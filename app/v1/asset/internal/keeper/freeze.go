@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/asset/internal/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// FreezeToken freezes the balance of the token held by the specified account
+func (k Keeper) FreezeToken(ctx sdk.Context, msg types.MsgFreezeToken) (sdk.Tags, sdk.Error) {
+	token, err := k.getOwnedToken(ctx, msg.TokenId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.IsFrozen(ctx, token.GetUniqueID(), msg.Holder) {
+		return nil, types.ErrTokenFrozen(k.codespace, fmt.Sprintf("the token %s of %s has been frozen", msg.TokenId, msg.Holder))
+	}
+
+	k.setFrozen(ctx, token.GetUniqueID(), msg.Holder)
+
+	freezeTags := sdk.NewTags(
+		types.TagId, []byte(token.GetUniqueID()),
+		types.TagHolder, []byte(msg.Holder.String()),
+	)
+
+	return freezeTags, nil
+}
+
+// UnfreezeToken unfreezes the balance of the token held by the specified account
+func (k Keeper) UnfreezeToken(ctx sdk.Context, msg types.MsgUnfreezeToken) (sdk.Tags, sdk.Error) {
+	token, err := k.getOwnedToken(ctx, msg.TokenId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsFrozen(ctx, token.GetUniqueID(), msg.Holder) {
+		return nil, types.ErrTokenNotFrozen(k.codespace, fmt.Sprintf("the token %s of %s is not frozen", msg.TokenId, msg.Holder))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(KeyFrozenAccount(token.GetUniqueID(), msg.Holder))
+
+	unfreezeTags := sdk.NewTags(
+		types.TagId, []byte(token.GetUniqueID()),
+		types.TagHolder, []byte(msg.Holder.String()),
+	)
+
+	return unfreezeTags, nil
+}
+
+// EditWhitelist switches the whitelist mode of the token and edits the whitelisted accounts
+func (k Keeper) EditWhitelist(ctx sdk.Context, msg types.MsgEditWhitelist) (sdk.Tags, sdk.Error) {
+	token, err := k.getOwnedToken(ctx, msg.TokenId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Whitelist != types.Nil {
+		token.Whitelist = msg.Whitelist.ToBool()
+		if err := k.SetToken(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, addr := range msg.Add {
+		k.setWhitelisted(ctx, token.GetUniqueID(), addr)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range msg.Remove {
+		store.Delete(KeyWhitelistAccount(token.GetUniqueID(), addr))
+	}
+
+	editTags := sdk.NewTags(
+		types.TagId, []byte(token.GetUniqueID()),
+	)
+
+	return editTags, nil
+}
+
+// getOwnedToken returns the specified token if the given address is the owner of it
+func (k Keeper) getOwnedToken(ctx sdk.Context, tokenId string, owner sdk.AccAddress) (types.FungibleToken, sdk.Error) {
+	token, exist := k.getToken(ctx, tokenId)
+	if !exist {
+		return token, types.ErrAssetNotExists(k.codespace, fmt.Sprintf("token %s does not exist", tokenId))
+	}
+
+	if token.Source == types.GATEWAY {
+		gateway, _ := k.GetGateway(ctx, token.Gateway)
+		token.Owner = gateway.Owner
+	}
+
+	if !owner.Equals(token.Owner) {
+		return token, types.ErrInvalidOwner(k.codespace, fmt.Sprintf("the address %s is not the owner of the token %s", owner, tokenId))
+	}
+
+	return token, nil
+}
+
+// IsFrozen checks if the balance of the token held by the given account is frozen
+func (k Keeper) IsFrozen(ctx sdk.Context, tokenId string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(KeyFrozenAccount(tokenId, addr))
+}
+
+func (k Keeper) setFrozen(ctx sdk.Context, tokenId string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(addr)
+	store.Set(KeyFrozenAccount(tokenId, addr), bz)
+}
+
+// GetFrozenAccounts retrieves all the frozen accounts of the token
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, tokenId string) []sdk.AccAddress {
+	return k.getTokenAccounts(ctx, KeyFrozenAccountsSubspace(tokenId))
+}
+
+// IsWhitelisted checks if the given account is whitelisted for the token
+func (k Keeper) IsWhitelisted(ctx sdk.Context, tokenId string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(KeyWhitelistAccount(tokenId, addr))
+}
+
+func (k Keeper) setWhitelisted(ctx sdk.Context, tokenId string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(addr)
+	store.Set(KeyWhitelistAccount(tokenId, addr), bz)
+}
+
+// GetWhitelist retrieves all the whitelisted accounts of the token
+func (k Keeper) GetWhitelist(ctx sdk.Context, tokenId string) []sdk.AccAddress {
+	return k.getTokenAccounts(ctx, KeyWhitelistSubspace(tokenId))
+}
+
+func (k Keeper) getTokenAccounts(ctx sdk.Context, prefix []byte) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	accounts := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var addr sdk.AccAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &addr)
+
+		accounts = append(accounts, addr)
+	}
+
+	return accounts
+}
+
+// ImportTokenAccounts imports the frozen or whitelisted accounts of a token
+func (k Keeper) ImportTokenAccounts(ctx sdk.Context, accounts types.TokenAccounts, frozen bool) sdk.Error {
+	if !k.HasToken(ctx, accounts.TokenId) {
+		return types.ErrAssetNotExists(k.codespace, fmt.Sprintf("token %s does not exist", accounts.TokenId))
+	}
+
+	for _, addr := range accounts.Accounts {
+		if frozen {
+			k.setFrozen(ctx, accounts.TokenId, addr)
+		} else {
+			k.setWhitelisted(ctx, accounts.TokenId, addr)
+		}
+	}
+
+	return nil
+}
+
+// CheckSendable checks if the given account is allowed to send or burn the coins, which
+// fails if any coin is a token frozen for the account, or a whitelist token and the
+// account is not whitelisted. The owner of a token is never restricted
+func (k Keeper) CheckSendable(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins, checkWhitelist bool) sdk.Error {
+	for _, coin := range coins {
+		token, owner, found := k.getTokenByDenom(ctx, coin.Denom)
+		if !found || addr.Equals(owner) {
+			continue
+		}
+
+		if k.IsFrozen(ctx, token.GetUniqueID(), addr) {
+			return types.ErrTokenFrozen(k.codespace, fmt.Sprintf("the token %s of %s has been frozen", token.GetUniqueID(), addr))
+		}
+
+		if checkWhitelist && token.Whitelist && !k.IsWhitelisted(ctx, token.GetUniqueID(), addr) {
+			return types.ErrNotWhitelisted(k.codespace, fmt.Sprintf("%s is not whitelisted for the token %s", addr, token.GetUniqueID()))
+		}
+	}
+
+	return nil
+}
+
+// CheckReceivable checks if the given account is allowed to receive the coins, which
+// fails if any coin is a whitelist token and the account is not whitelisted
+func (k Keeper) CheckReceivable(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		token, owner, found := k.getTokenByDenom(ctx, coin.Denom)
+		if !found || !token.Whitelist || addr.Equals(owner) {
+			continue
+		}
+
+		if !k.IsWhitelisted(ctx, token.GetUniqueID(), addr) {
+			return types.ErrNotWhitelisted(k.codespace, fmt.Sprintf("%s is not whitelisted for the token %s", addr, token.GetUniqueID()))
+		}
+	}
+
+	return nil
+}
+
+// getTokenByDenom returns the token and its owner of the given denom
+func (k Keeper) getTokenByDenom(ctx sdk.Context, denom string) (token types.FungibleToken, owner sdk.AccAddress, found bool) {
+	if denom == sdk.IrisAtto {
+		return token, nil, false
+	}
+
+	tokenId, err := sdk.ConvertDenomToTokenId(denom)
+	if err != nil {
+		return token, nil, false
+	}
+
+	token, found = k.getToken(ctx, tokenId)
+	if !found {
+		return token, nil, false
+	}
+
+	owner = token.Owner
+	if token.Source == types.GATEWAY {
+		gateway, _ := k.GetGateway(ctx, token.Gateway)
+		owner = gateway.Owner
+	}
+
+	return token, owner, true
+}
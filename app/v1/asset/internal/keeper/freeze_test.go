@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/asset/internal/types"
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
+	"github.com/irisnet/irishub/app/v1/params"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/tests"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestFreezeToken(t *testing.T) {
+	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, paramskey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, assetKey, bk, types.DefaultCodespace, pk.Subspace(types.DefaultParamSpace))
+	keeper.Init(ctx)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	holder := sdk.AccAddress([]byte("holder"))
+	other := sdk.AccAddress([]byte("other"))
+
+	ft := types.NewFungibleToken(types.NATIVE, "", "btc", "btc", 0, "", "", sdk.NewInt(1000), sdk.NewInt(10000), true, owner)
+	_, err := keeper.IssueToken(ctx, ft)
+	require.NoError(t, err)
+
+	coins := sdk.Coins{sdk.NewCoin("btc-min", sdk.NewInt(100))}
+	_, err = bk.SendCoins(ctx, owner, holder, coins)
+	require.NoError(t, err)
+
+	// only the owner can freeze
+	_, err = keeper.FreezeToken(ctx, types.NewMsgFreezeToken("btc", other, holder))
+	require.Error(t, err)
+
+	_, err = keeper.FreezeToken(ctx, types.NewMsgFreezeToken("btc", owner, holder))
+	require.NoError(t, err)
+	require.True(t, keeper.IsFrozen(ctx, "btc", holder))
+	require.Equal(t, []sdk.AccAddress{holder}, keeper.GetFrozenAccounts(ctx, "btc"))
+
+	_, err = keeper.FreezeToken(ctx, types.NewMsgFreezeToken("btc", owner, holder))
+	require.Error(t, err)
+
+	// the frozen holder can neither send nor burn, but other denoms are not affected
+	require.Error(t, keeper.CheckSendable(ctx, holder, coins, true))
+	require.Error(t, keeper.CheckSendable(ctx, holder, coins, false))
+	require.NoError(t, keeper.CheckSendable(ctx, holder, sdk.Coins{sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(100))}, true))
+	require.NoError(t, keeper.CheckSendable(ctx, owner, coins, true))
+
+	_, err = keeper.BurnToken(ctx, types.NewMsgBurnToken("btc", holder, 10, false))
+	require.Error(t, err)
+
+	_, err = keeper.UnfreezeToken(ctx, types.NewMsgUnfreezeToken("btc", owner, holder))
+	require.NoError(t, err)
+	require.False(t, keeper.IsFrozen(ctx, "btc", holder))
+	require.NoError(t, keeper.CheckSendable(ctx, holder, coins, true))
+
+	_, err = keeper.UnfreezeToken(ctx, types.NewMsgUnfreezeToken("btc", owner, holder))
+	require.Error(t, err)
+}
+
+func TestTokenWhitelist(t *testing.T) {
+	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, paramskey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, assetKey, bk, types.DefaultCodespace, pk.Subspace(types.DefaultParamSpace))
+	keeper.Init(ctx)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	holder := sdk.AccAddress([]byte("holder"))
+	other := sdk.AccAddress([]byte("other"))
+
+	ft := types.NewFungibleToken(types.NATIVE, "", "btc", "btc", 0, "", "", sdk.NewInt(1000), sdk.NewInt(10000), true, owner)
+	_, err := keeper.IssueToken(ctx, ft)
+	require.NoError(t, err)
+
+	coins := sdk.Coins{sdk.NewCoin("btc-min", sdk.NewInt(100))}
+	_, err = bk.SendCoins(ctx, owner, holder, coins)
+	require.NoError(t, err)
+
+	_, err = keeper.EditWhitelist(ctx, types.NewMsgEditWhitelist("btc", owner, types.True, []sdk.AccAddress{holder}, nil))
+	require.NoError(t, err)
+
+	token, _ := keeper.getToken(ctx, "btc")
+	require.True(t, token.Whitelist)
+	require.Equal(t, []sdk.AccAddress{holder}, keeper.GetWhitelist(ctx, "btc"))
+
+	// the whitelisted holder can send to the owner, but not to an account out of the whitelist
+	anteHandler := NewFreezeAnteHandler(keeper)
+	send := func(from, to sdk.AccAddress) bool {
+		msg := bank.NewMsgSend([]bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(to, coins)})
+		_, res, abort := anteHandler(ctx, auth.StdTx{Msgs: []sdk.Msg{msg}}, false)
+		require.Equal(t, abort, !res.IsOK())
+		return res.IsOK()
+	}
+
+	require.True(t, send(holder, owner))
+	require.False(t, send(holder, other))
+	require.True(t, send(owner, holder))
+
+	// minting to an account out of the whitelist fails
+	_, err = keeper.MintToken(ctx, types.NewMsgMintToken("btc", owner, other, 100))
+	require.Error(t, err)
+
+	_, err = keeper.EditWhitelist(ctx, types.NewMsgEditWhitelist("btc", owner, types.False, nil, []sdk.AccAddress{holder}))
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetWhitelist(ctx, "btc"))
+	require.True(t, send(holder, other))
+}
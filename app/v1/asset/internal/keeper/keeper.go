@@ -89,6 +89,9 @@ func (k Keeper) AddToken(ctx sdk.Context, token types.FungibleToken) (types.Fung
 	if k.HasToken(ctx, tokenId) {
 		return token, nil, types.ErrAssetAlreadyExists(k.codespace, fmt.Sprintf("token already exists: %s", token.GetUniqueID()))
 	}
+	if k.HasCollection(ctx, token.GetUniqueID()) {
+		return token, nil, types.ErrAssetAlreadyExists(k.codespace, fmt.Sprintf("collection already exists: %s", token.GetUniqueID()))
+	}
 
 	var owner sdk.AccAddress
 	if token.GetSource() == types.GATEWAY {
@@ -534,15 +537,19 @@ func (k Keeper) MintToken(ctx sdk.Context, msg types.MsgMintToken) (sdk.Tags, sd
 		break
 	}
 
+	mintAcc := msg.To
+	if mintAcc.Empty() {
+		mintAcc = token.Owner
+	}
+
 	mintCoin := sdk.NewCoin(expDenom, mintAmt)
-	//add TotalSupply
-	if err := k.bk.IncreaseTotalSupply(ctx, mintCoin); err != nil {
+	if err := k.CheckReceivable(ctx, mintAcc, sdk.Coins{mintCoin}); err != nil {
 		return nil, err
 	}
 
-	mintAcc := msg.To
-	if mintAcc.Empty() {
-		mintAcc = token.Owner
+	//add TotalSupply
+	if err := k.bk.IncreaseTotalSupply(ctx, mintCoin); err != nil {
+		return nil, err
 	}
 
 	//add mintCoin to special account
@@ -554,6 +561,83 @@ func (k Keeper) MintToken(ctx sdk.Context, msg types.MsgMintToken) (sdk.Tags, sd
 	return tags, nil
 }
 
+// BurnToken burns the token from the sender's account and decreases the total supply.
+// A holder burn also lowers the max supply so that the burnt amount can never be minted again,
+// while an owner redemption keeps the max supply unchanged and hence restores the mintable amount
+func (k Keeper) BurnToken(ctx sdk.Context, msg types.MsgBurnToken) (sdk.Tags, sdk.Error) {
+	token, exist := k.getToken(ctx, msg.TokenId)
+	if !exist {
+		return nil, types.ErrAssetNotExists(k.codespace, fmt.Sprintf("token %s does not exist", msg.TokenId))
+	}
+
+	owner := token.Owner
+	if token.Source == types.GATEWAY {
+		gateway, _ := k.GetGateway(ctx, token.Gateway)
+		owner = gateway.Owner
+	}
+
+	if msg.Redeem && !msg.Sender.Equals(owner) {
+		return nil, types.ErrInvalidOwner(k.codespace, fmt.Sprintf("the address %s is not the owner of the token %s, only the owner can redeem", msg.Sender.String(), msg.TokenId))
+	}
+
+	hasIssuedAmt, found := k.bk.GetTotalSupply(ctx, token.GetDenom())
+	if !found {
+		return nil, types.ErrAssetNotExists(k.codespace, fmt.Sprintf("token denom %s does not exist", token.GetDenom()))
+	}
+
+	burnAmt := sdk.NewIntWithDecimal(int64(msg.Amount), int(token.Decimal))
+	if burnAmt.GT(hasIssuedAmt.Amount) {
+		return nil, types.ErrInsufficientCoins(k.codespace, fmt.Sprintf("the amount of burn tokens has exceeded the total supply %s", hasIssuedAmt.String()))
+	}
+
+	burnCoin := sdk.NewCoin(token.GetDenom(), burnAmt)
+	if err := k.CheckSendable(ctx, msg.Sender, sdk.Coins{burnCoin}, false); err != nil {
+		return nil, err
+	}
+
+	_, tags, err := k.bk.SubtractCoins(ctx, msg.Sender, sdk.Coins{burnCoin})
+	if err != nil {
+		return nil, err
+	}
+
+	//decrease TotalSupply
+	if err := k.bk.DecreaseTotalSupply(ctx, burnCoin); err != nil {
+		return nil, err
+	}
+
+	if !msg.Redeem {
+		token.MaxSupply = token.MaxSupply.Sub(burnAmt)
+		if err := k.SetToken(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.CoinFlowTags().AppendCoinFlowTag(ctx, msg.Sender.String(), "", burnCoin.String(), sdk.BurnTokenFlow, "")
+
+	burnTags := sdk.NewTags(
+		types.TagId, []byte(token.GetUniqueID()),
+		types.TagDenom, []byte(token.GetDenom()),
+		types.TagAmount, []byte(burnCoin.String()),
+	)
+
+	return tags.AppendTags(burnTags), nil
+}
+
+// GetTokenSupply returns the supply state of the given token
+func (k Keeper) GetTokenSupply(ctx sdk.Context, tokenId string) (types.TokenSupply, sdk.Error) {
+	token, exist := k.getToken(ctx, tokenId)
+	if !exist {
+		return types.TokenSupply{}, types.ErrAssetNotExists(k.codespace, fmt.Sprintf("token %s does not exist", tokenId))
+	}
+
+	totalSupply, found := k.bk.GetTotalSupply(ctx, token.GetDenom())
+	if !found {
+		return types.TokenSupply{}, types.ErrAssetNotExists(k.codespace, fmt.Sprintf("token denom %s does not exist", token.GetDenom()))
+	}
+
+	return types.NewTokenSupply(token, totalSupply), nil
+}
+
 // get asset params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var p types.Params
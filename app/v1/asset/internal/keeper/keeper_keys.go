@@ -9,6 +9,9 @@ import (
 var (
 	PrefixGateway = []byte("gateways:") // prefix for the gateway store
 	PrefixToken   = []byte("token:")    // prefix for the token store
+
+	PrefixCollection = []byte("collection:") // prefix for the collection store
+	PrefixNFT        = []byte("nft:")        // prefix for the nft store
 )
 
 // KeyToken returns the key of the specified token source and id
@@ -40,3 +43,67 @@ func KeyOwnerGateway(owner sdk.AccAddress, moniker string) []byte {
 func KeyGatewaysSubspace(owner sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("ownerGateways:%d:", owner))
 }
+
+// KeyCollection returns the key of the specified collection id
+func KeyCollection(collectionId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(collectionId)
+	return []byte(fmt.Sprintf("collection:%s", keyId))
+}
+
+// KeyCollectionSupply returns the key of the number of NFTs in the specified collection
+func KeyCollectionSupply(collectionId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(collectionId)
+	return []byte(fmt.Sprintf("collectionSupply:%s", keyId))
+}
+
+// KeyNFT returns the key of the specified collection id and nft id
+func KeyNFT(collectionId, tokenId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(collectionId)
+	return []byte(fmt.Sprintf("nft:%s:%s", keyId, tokenId))
+}
+
+// KeyNFTsSubspace returns the key prefix for iterating on all NFTs of a collection
+func KeyNFTsSubspace(collectionId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(collectionId)
+	return []byte(fmt.Sprintf("nft:%s:", keyId))
+}
+
+// KeyOwnerNFT returns the key of the specified owner, collection id and nft id. Intended for querying all NFTs of an owner
+func KeyOwnerNFT(owner sdk.AccAddress, collectionId, tokenId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(collectionId)
+	return []byte(fmt.Sprintf("ownerNFTs:%s:%s:%s", owner, keyId, tokenId))
+}
+
+// KeyOwnerNFTsSubspace returns the key prefix for iterating on the NFTs of an owner, optionally within a collection
+func KeyOwnerNFTsSubspace(owner sdk.AccAddress, collectionId string) []byte {
+	if len(collectionId) == 0 {
+		return []byte(fmt.Sprintf("ownerNFTs:%s:", owner))
+	}
+
+	keyId, _ := sdk.ConvertIdToTokenKeyId(collectionId)
+	return []byte(fmt.Sprintf("ownerNFTs:%s:%s:", owner, keyId))
+}
+
+// KeyFrozenAccount returns the key of the specified token id and frozen account
+func KeyFrozenAccount(tokenId string, addr sdk.AccAddress) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(tokenId)
+	return []byte(fmt.Sprintf("frozen:%s:%s", keyId, addr))
+}
+
+// KeyFrozenAccountsSubspace returns the key prefix for iterating on all frozen accounts of a token
+func KeyFrozenAccountsSubspace(tokenId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(tokenId)
+	return []byte(fmt.Sprintf("frozen:%s:", keyId))
+}
+
+// KeyWhitelistAccount returns the key of the specified token id and whitelisted account
+func KeyWhitelistAccount(tokenId string, addr sdk.AccAddress) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(tokenId)
+	return []byte(fmt.Sprintf("whitelist:%s:%s", keyId, addr))
+}
+
+// KeyWhitelistSubspace returns the key prefix for iterating on all whitelisted accounts of a token
+func KeyWhitelistSubspace(tokenId string) []byte {
+	keyId, _ := sdk.ConvertIdToTokenKeyId(tokenId)
+	return []byte(fmt.Sprintf("whitelist:%s:", keyId))
+}
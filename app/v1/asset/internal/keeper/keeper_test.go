@@ -418,6 +418,64 @@ func TestMintTokenKeeper(t *testing.T) {
 	assert.Equal(t, "2000", amt.String())
 }
 
+func TestBurnTokenKeeper(t *testing.T) {
+	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, paramskey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, assetKey, bk, types.DefaultCodespace, pk.Subspace(types.DefaultParamSpace))
+	keeper.Init(ctx)
+
+	owner := ak.NewAccountWithAddress(ctx, sdk.AccAddress([]byte("owner"))).GetAddress()
+	holder := ak.NewAccountWithAddress(ctx, sdk.AccAddress([]byte("holder"))).GetAddress()
+
+	ft := types.NewFungibleToken(types.NATIVE, "", "btc", "btc", 0, "", "satoshi", sdk.NewIntWithDecimal(1000, 0), sdk.NewIntWithDecimal(10000, 0), true, owner)
+	_, err := keeper.IssueToken(ctx, ft)
+	assert.NoError(t, err)
+
+	_, err = bk.SendCoins(ctx, owner, holder, sdk.Coins{sdk.NewCoin("btc-min", sdk.NewInt(300))})
+	assert.NoError(t, err)
+
+	// the holder can not redeem
+	_, err = keeper.BurnToken(ctx, types.NewMsgBurnToken("btc", holder, 100, true))
+	assert.Error(t, err)
+
+	// the holder can not burn more than the balance
+	_, err = keeper.BurnToken(ctx, types.NewMsgBurnToken("btc", holder, 301, false))
+	assert.Error(t, err)
+
+	// the holder burns and the max supply decreases
+	_, err = keeper.BurnToken(ctx, types.NewMsgBurnToken("btc", holder, 100, false))
+	assert.NoError(t, err)
+
+	supply, err := keeper.GetTokenSupply(ctx, "btc")
+	assert.NoError(t, err)
+	assert.Equal(t, "900", supply.TotalSupply.Amount.String())
+	assert.Equal(t, "9900", supply.MaxSupply.Amount.String())
+	assert.Equal(t, "9000", supply.Mintable.Amount.String())
+	assert.Equal(t, "200", bk.GetCoins(ctx, holder).AmountOf("btc-min").String())
+
+	totalSupply, _ := bk.GetTotalSupply(ctx, "btc-min")
+	assert.Equal(t, "900", totalSupply.Amount.String())
+
+	// the owner redeems and the max supply is kept
+	_, err = keeper.BurnToken(ctx, types.NewMsgBurnToken("btc", owner, 200, true))
+	assert.NoError(t, err)
+
+	supply, err = keeper.GetTokenSupply(ctx, "btc")
+	assert.NoError(t, err)
+	assert.Equal(t, "700", supply.TotalSupply.Amount.String())
+	assert.Equal(t, "9900", supply.MaxSupply.Amount.String())
+	assert.Equal(t, "9200", supply.Mintable.Amount.String())
+	assert.Equal(t, "500", bk.GetCoins(ctx, owner).AmountOf("btc-min").String())
+}
+
 func TestTransferOwnerKeeper(t *testing.T) {
 	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
 
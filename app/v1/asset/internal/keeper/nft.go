@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/asset/internal/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// IssueCollection issues a new non-fungible collection
+func (k Keeper) IssueCollection(ctx sdk.Context, collection types.Collection) (sdk.Tags, sdk.Error) {
+	if collection.Source == types.GATEWAY {
+		gateway, err := k.GetGateway(ctx, collection.Gateway)
+		if err != nil {
+			return nil, err
+		}
+		if !gateway.Owner.Equals(collection.Owner) {
+			return nil, types.ErrUnauthorizedIssueGatewayAsset(k.codespace,
+				fmt.Sprintf("Gateway %s collection can only be created by %s, unauthorized creator %s",
+					gateway.Moniker, gateway.Owner, collection.Owner))
+		}
+	}
+
+	collection, err := k.AddCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	createTags := sdk.NewTags(
+		types.TagId, []byte(collection.Id),
+		types.TagSource, []byte(collection.Source.String()),
+		types.TagGateway, []byte(collection.Gateway),
+		types.TagOwner, []byte(collection.Owner.String()),
+	)
+
+	return createTags, nil
+}
+
+// AddCollection saves a new collection to keystore
+func (k Keeper) AddCollection(ctx sdk.Context, collection types.Collection) (types.Collection, sdk.Error) {
+	if collection.Source != types.NATIVE && collection.Source != types.GATEWAY {
+		return collection, types.ErrInvalidAssetSource(k.codespace, fmt.Sprintf("invalid collection source type %s", collection.Source))
+	}
+
+	collection.Id = collection.GetUniqueID()
+	if k.HasToken(ctx, collection.Id) || k.HasCollection(ctx, collection.Id) {
+		return collection, types.ErrAssetAlreadyExists(k.codespace, fmt.Sprintf("asset already exists: %s", collection.Id))
+	}
+
+	if collection.Source == types.GATEWAY {
+		gateway, err := k.GetGateway(ctx, collection.Gateway)
+		if err != nil {
+			return collection, err
+		}
+		collection.Owner = gateway.Owner
+	} else {
+		collection.Gateway = ""
+	}
+
+	k.SetCollection(ctx, collection)
+	return collection, nil
+}
+
+// HasCollection checks if the given collection exists
+func (k Keeper) HasCollection(ctx sdk.Context, collectionId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(KeyCollection(collectionId))
+}
+
+// SetCollection stores the given collection. The owner of a gateway collection follows the gateway owner
+func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) {
+	if collection.Source == types.GATEWAY {
+		collection.Owner = nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(collection)
+	store.Set(KeyCollection(collection.Id), bz)
+}
+
+// GetCollection retrieves the collection of the given id, with the owner resolved
+func (k Keeper) GetCollection(ctx sdk.Context, collectionId string) (collection types.Collection, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(KeyCollection(collectionId))
+	if bz == nil {
+		return collection, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &collection)
+
+	if collection.Source == types.GATEWAY {
+		gateway, _ := k.GetGateway(ctx, collection.Gateway)
+		collection.Owner = gateway.Owner
+	}
+
+	return collection, true
+}
+
+// IterateCollections iterates through all existing collections
+func (k Keeper) IterateCollections(ctx sdk.Context, op func(collection types.Collection) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, PrefixCollection)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var collection types.Collection
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &collection)
+
+		if collection.Source == types.GATEWAY {
+			gateway, _ := k.GetGateway(ctx, collection.Gateway)
+			collection.Owner = gateway.Owner
+		}
+
+		if stop := op(collection); stop {
+			break
+		}
+	}
+}
+
+// GetCollectionSupply returns the number of NFTs in the given collection
+func (k Keeper) GetCollectionSupply(ctx sdk.Context, collectionId string) (supply uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(KeyCollectionSupply(collectionId))
+	if bz == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+	return supply
+}
+
+func (k Keeper) setCollectionSupply(ctx sdk.Context, collectionId string, supply uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(supply)
+	store.Set(KeyCollectionSupply(collectionId), bz)
+}
+
+// MintNFT mints a new NFT of the specified collection
+func (k Keeper) MintNFT(ctx sdk.Context, msg types.MsgMintNFT) (sdk.Tags, sdk.Error) {
+	collection, found := k.GetCollection(ctx, msg.CollectionId)
+	if !found {
+		return nil, types.ErrAssetNotExists(k.codespace, fmt.Sprintf("collection %s does not exist", msg.CollectionId))
+	}
+
+	if !msg.Owner.Equals(collection.Owner) {
+		return nil, types.ErrInvalidOwner(k.codespace, fmt.Sprintf("the address %s is not the owner of the collection %s", msg.Owner, msg.CollectionId))
+	}
+
+	if !collection.Mintable {
+		return nil, types.ErrAssetNotMintable(k.codespace, fmt.Sprintf("the collection %s is set to be non-mintable", msg.CollectionId))
+	}
+
+	if k.HasNFT(ctx, msg.CollectionId, msg.TokenId) {
+		return nil, types.ErrNFTAlreadyExists(k.codespace, fmt.Sprintf("nft %s already exists in collection %s", msg.TokenId, msg.CollectionId))
+	}
+
+	supply := k.GetCollectionSupply(ctx, msg.CollectionId)
+	if supply >= collection.MaxSupply {
+		return nil, types.ErrInvalidAssetMaxSupply(k.codespace, fmt.Sprintf("the number of nfts in collection %s has reached the max supply %d", msg.CollectionId, collection.MaxSupply))
+	}
+
+	to := msg.To
+	if to.Empty() {
+		to = msg.Owner
+	}
+
+	nft := types.NewNFT(msg.CollectionId, msg.TokenId, to, msg.TokenURI)
+	k.SetNFT(ctx, nft)
+	k.setCollectionSupply(ctx, msg.CollectionId, supply+1)
+
+	mintTags := sdk.NewTags(
+		types.TagCollection, []byte(nft.CollectionId),
+		types.TagNFT, []byte(nft.TokenId),
+		types.TagRecipient, []byte(to.String()),
+	)
+
+	return mintTags, nil
+}
+
+// TransferNFT transfers the specified NFT to a new owner
+func (k Keeper) TransferNFT(ctx sdk.Context, msg types.MsgTransferNFT) (sdk.Tags, sdk.Error) {
+	nft, err := k.getOwnedNFT(ctx, msg.Sender, msg.CollectionId, msg.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	// update the owner index
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(KeyOwnerNFT(nft.Owner, nft.CollectionId, nft.TokenId))
+
+	nft.Owner = msg.Recipient
+	k.SetNFT(ctx, nft)
+
+	transferTags := sdk.NewTags(
+		types.TagCollection, []byte(nft.CollectionId),
+		types.TagNFT, []byte(nft.TokenId),
+		types.TagRecipient, []byte(msg.Recipient.String()),
+	)
+
+	return transferTags, nil
+}
+
+// EditNFT edits the metadata of the specified NFT
+func (k Keeper) EditNFT(ctx sdk.Context, msg types.MsgEditNFT) (sdk.Tags, sdk.Error) {
+	nft, err := k.getOwnedNFT(ctx, msg.Owner, msg.CollectionId, msg.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	nft.TokenURI = msg.TokenURI
+	k.SetNFT(ctx, nft)
+
+	editTags := sdk.NewTags(
+		types.TagCollection, []byte(nft.CollectionId),
+		types.TagNFT, []byte(nft.TokenId),
+	)
+
+	return editTags, nil
+}
+
+// BurnNFT removes the specified NFT from its collection
+func (k Keeper) BurnNFT(ctx sdk.Context, msg types.MsgBurnNFT) (sdk.Tags, sdk.Error) {
+	nft, err := k.getOwnedNFT(ctx, msg.Owner, msg.CollectionId, msg.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(KeyNFT(nft.CollectionId, nft.TokenId))
+	store.Delete(KeyOwnerNFT(nft.Owner, nft.CollectionId, nft.TokenId))
+
+	supply := k.GetCollectionSupply(ctx, nft.CollectionId)
+	if supply > 0 {
+		k.setCollectionSupply(ctx, nft.CollectionId, supply-1)
+	}
+
+	burnTags := sdk.NewTags(
+		types.TagCollection, []byte(nft.CollectionId),
+		types.TagNFT, []byte(nft.TokenId),
+	)
+
+	return burnTags, nil
+}
+
+// getOwnedNFT retrieves the specified NFT and checks if it is owned by the given address
+func (k Keeper) getOwnedNFT(ctx sdk.Context, owner sdk.AccAddress, collectionId, tokenId string) (types.NFT, sdk.Error) {
+	nft, found := k.GetNFT(ctx, collectionId, tokenId)
+	if !found {
+		return nft, types.ErrNFTNotExists(k.codespace, fmt.Sprintf("nft %s does not exist in collection %s", tokenId, collectionId))
+	}
+
+	if !owner.Equals(nft.Owner) {
+		return nft, types.ErrInvalidOwner(k.codespace, fmt.Sprintf("the address %s is not the owner of the nft %s/%s", owner, collectionId, tokenId))
+	}
+
+	return nft, nil
+}
+
+// HasNFT checks if the given NFT exists
+func (k Keeper) HasNFT(ctx sdk.Context, collectionId, tokenId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(KeyNFT(collectionId, tokenId))
+}
+
+// SetNFT stores the given NFT and indexes it by its owner
+func (k Keeper) SetNFT(ctx sdk.Context, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(nft)
+	store.Set(KeyNFT(nft.CollectionId, nft.TokenId), bz)
+
+	bz = k.cdc.MustMarshalBinaryLengthPrefixed([]string{nft.CollectionId, nft.TokenId})
+	store.Set(KeyOwnerNFT(nft.Owner, nft.CollectionId, nft.TokenId), bz)
+}
+
+// GetNFT retrieves the specified NFT
+func (k Keeper) GetNFT(ctx sdk.Context, collectionId, tokenId string) (nft types.NFT, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(KeyNFT(collectionId, tokenId))
+	if bz == nil {
+		return nft, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nft)
+	return nft, true
+}
+
+// GetNFTs retrieves all the NFTs of the given collection
+func (k Keeper) GetNFTs(ctx sdk.Context, collectionId string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, KeyNFTsSubspace(collectionId))
+}
+
+// GetOwnerNFTs retrieves the NFT index of the given owner, optionally within a collection
+func (k Keeper) GetOwnerNFTs(ctx sdk.Context, owner sdk.AccAddress, collectionId string) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, KeyOwnerNFTsSubspace(owner, collectionId))
+}
+
+// IterateNFTs iterates through all existing NFTs
+func (k Keeper) IterateNFTs(ctx sdk.Context, op func(nft types.NFT) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, PrefixNFT)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &nft)
+
+		if stop := op(nft); stop {
+			break
+		}
+	}
+}
+
+// ImportNFT adds an NFT to an existing collection, intended for genesis
+func (k Keeper) ImportNFT(ctx sdk.Context, nft types.NFT) sdk.Error {
+	if !k.HasCollection(ctx, nft.CollectionId) {
+		return types.ErrAssetNotExists(k.codespace, fmt.Sprintf("collection %s does not exist", nft.CollectionId))
+	}
+
+	if k.HasNFT(ctx, nft.CollectionId, nft.TokenId) {
+		return types.ErrNFTAlreadyExists(k.codespace, fmt.Sprintf("nft %s already exists in collection %s", nft.TokenId, nft.CollectionId))
+	}
+
+	k.SetNFT(ctx, nft)
+	k.setCollectionSupply(ctx, nft.CollectionId, k.GetCollectionSupply(ctx, nft.CollectionId)+1)
+
+	return nil
+}
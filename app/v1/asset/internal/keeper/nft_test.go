@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/asset/internal/types"
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
+	"github.com/irisnet/irishub/app/v1/params"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/tests"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestNFTKeeper(t *testing.T) {
+	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, paramskey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, assetKey, bk, types.DefaultCodespace, pk.Subspace(types.DefaultParamSpace))
+
+	owner := sdk.AccAddress([]byte("owner"))
+	alice := sdk.AccAddress([]byte("alice"))
+
+	collection := types.NewCollection(types.NATIVE, "", "kitty", "Crypto Kitties", 2, true, owner)
+	_, err := keeper.IssueCollection(ctx, collection)
+	require.NoError(t, err)
+	require.True(t, keeper.HasCollection(ctx, "kitty"))
+
+	// the collection id is shared with fungible tokens
+	_, err = keeper.IssueCollection(ctx, collection)
+	require.Error(t, err)
+	ft := types.NewFungibleToken(types.NATIVE, "", "kitty", "kitty", 0, "", "", sdk.NewInt(1), sdk.NewInt(1), false, owner)
+	_, err = keeper.IssueToken(ctx, ft)
+	require.Error(t, err)
+
+	// only the collection owner can mint
+	_, err = keeper.MintNFT(ctx, types.NewMsgMintNFT(alice, "kitty", "kitty-1", nil, ""))
+	require.Error(t, err)
+
+	_, err = keeper.MintNFT(ctx, types.NewMsgMintNFT(owner, "kitty", "kitty-1", alice, "https://kitty/1"))
+	require.NoError(t, err)
+	_, err = keeper.MintNFT(ctx, types.NewMsgMintNFT(owner, "kitty", "kitty-1", alice, ""))
+	require.Error(t, err)
+	_, err = keeper.MintNFT(ctx, types.NewMsgMintNFT(owner, "kitty", "kitty-2", nil, ""))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), keeper.GetCollectionSupply(ctx, "kitty"))
+
+	// max supply reached
+	_, err = keeper.MintNFT(ctx, types.NewMsgMintNFT(owner, "kitty", "kitty-3", nil, ""))
+	require.Error(t, err)
+
+	nft, found := keeper.GetNFT(ctx, "kitty", "kitty-1")
+	require.True(t, found)
+	require.Equal(t, alice, nft.Owner)
+	require.Equal(t, "https://kitty/1", nft.TokenURI)
+
+	// only the nft owner can edit, transfer or burn
+	_, err = keeper.EditNFT(ctx, types.NewMsgEditNFT(owner, "kitty", "kitty-1", "https://kitty/x"))
+	require.Error(t, err)
+	_, err = keeper.EditNFT(ctx, types.NewMsgEditNFT(alice, "kitty", "kitty-1", "https://kitty/x"))
+	require.NoError(t, err)
+	nft, _ = keeper.GetNFT(ctx, "kitty", "kitty-1")
+	require.Equal(t, "https://kitty/x", nft.TokenURI)
+
+	_, err = keeper.TransferNFT(ctx, types.NewMsgTransferNFT(owner, alice, "kitty", "kitty-1"))
+	require.Error(t, err)
+	_, err = keeper.TransferNFT(ctx, types.NewMsgTransferNFT(alice, owner, "kitty", "kitty-1"))
+	require.NoError(t, err)
+
+	iter := keeper.GetOwnerNFTs(ctx, alice, "")
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	count := 0
+	iter = keeper.GetOwnerNFTs(ctx, owner, "kitty")
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	require.Equal(t, 2, count)
+
+	_, err = keeper.BurnNFT(ctx, types.NewMsgBurnNFT(alice, "kitty", "kitty-2"))
+	require.Error(t, err)
+	_, err = keeper.BurnNFT(ctx, types.NewMsgBurnNFT(owner, "kitty", "kitty-2"))
+	require.NoError(t, err)
+	require.False(t, keeper.HasNFT(ctx, "kitty", "kitty-2"))
+	require.Equal(t, uint64(1), keeper.GetCollectionSupply(ctx, "kitty"))
+
+	// a burned id can be minted again within the max supply
+	_, err = keeper.MintNFT(ctx, types.NewMsgMintNFT(owner, "kitty", "kitty-2", nil, ""))
+	require.NoError(t, err)
+}
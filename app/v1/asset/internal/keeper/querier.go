@@ -22,6 +22,20 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGateways(ctx, req, k)
 		case types.QueryFees:
 			return queryFees(ctx, path[1:], req, k)
+		case types.QuerySupply:
+			return querySupply(ctx, req, k)
+		case types.QueryFrozen:
+			return queryTokenAccounts(ctx, req, k, true)
+		case types.QueryWhitelist:
+			return queryTokenAccounts(ctx, req, k, false)
+		case types.QueryCollection:
+			return queryCollection(ctx, req, k)
+		case types.QueryCollections:
+			return queryCollections(ctx, req, k)
+		case types.QueryNFT:
+			return queryNFT(ctx, req, k)
+		case types.QueryNFTs:
+			return queryNFTs(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown asset query endpoint")
 		}
@@ -67,6 +81,51 @@ func queryToken(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	return bz, nil
 }
 
+func querySupply(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySupplyParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	supply, sdkErr := keeper.GetTokenSupply(ctx, params.TokenId)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, supply)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryTokenAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, frozen bool) ([]byte, sdk.Error) {
+	var params types.QueryTokenAccountsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	token, found := keeper.getToken(ctx, params.TokenId)
+	if !found {
+		return nil, types.ErrAssetNotExists(keeper.codespace, fmt.Sprintf("token %s does not exist", params.TokenId))
+	}
+
+	var accounts []sdk.AccAddress
+	if frozen {
+		accounts = keeper.GetFrozenAccounts(ctx, token.GetUniqueID())
+	} else {
+		accounts = keeper.GetWhitelist(ctx, token.GetUniqueID())
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewTokenAccounts(token.GetUniqueID(), accounts))
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
 func queryTokens(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryTokensParams
 	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
@@ -314,3 +373,122 @@ func queryTokenFees(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 
 	return bz, nil
 }
+
+func queryCollection(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCollectionParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	if err := types.CheckTokenID(params.Id); err != nil {
+		return nil, err
+	}
+
+	collection, found := keeper.GetCollection(ctx, params.Id)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("collection %s does not exist", params.Id))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, collection)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryCollections(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCollectionsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	collections := make(types.Collections, 0)
+	keeper.IterateCollections(ctx, func(collection types.Collection) (stop bool) {
+		if params.Owner.Empty() || params.Owner.Equals(collection.Owner) {
+			collections = append(collections, collection)
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, collections)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryNFT(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNFTParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	if err := types.CheckTokenID(params.CollectionId); err != nil {
+		return nil, err
+	}
+
+	nft, found := keeper.GetNFT(ctx, params.CollectionId, params.TokenId)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("nft %s does not exist in collection %s", params.TokenId, params.CollectionId))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, nft)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryNFTs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNFTsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	if len(params.CollectionId) > 0 {
+		if err := types.CheckTokenID(params.CollectionId); err != nil {
+			return nil, err
+		}
+	}
+
+	nfts := make(types.NFTs, 0)
+
+	if !params.Owner.Empty() {
+		// query by the owner index, optionally within a collection
+		iter := keeper.GetOwnerNFTs(ctx, params.Owner, params.CollectionId)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			var nftKey []string // [collection id, nft id]
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &nftKey)
+
+			nft, found := keeper.GetNFT(ctx, nftKey[0], nftKey[1])
+			if !found {
+				continue
+			}
+
+			nfts = append(nfts, nft)
+		}
+	} else if len(params.CollectionId) > 0 {
+		iter := keeper.GetNFTs(ctx, params.CollectionId)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			var nft types.NFT
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &nft)
+			nfts = append(nfts, nft)
+		}
+	} else {
+		return nil, sdk.ErrUnknownRequest("the collection id or owner is required for querying nfts")
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, nfts)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
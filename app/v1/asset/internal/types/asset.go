@@ -58,6 +58,7 @@ func NewBaseToken(family AssetFamily, source AssetSource, gateway string, symbol
 // FungibleToken
 type FungibleToken struct {
 	BaseToken `json:"base_token"`
+	Whitelist bool `json:"whitelist"` // whether only the whitelisted accounts can transfer the token
 }
 
 func NewFungibleToken(source AssetSource, gateway string, symbol string, name string, decimal uint8, canonicalSymbol string, minUnitAlias string, initialSupply types.Int, maxSupply types.Int, mintable bool, owner types.AccAddress) FungibleToken {
@@ -153,9 +154,10 @@ func (ft FungibleToken) String() string {
   Initial Supply:    %s
   Max Supply:        %s
   Mintable:          %v
+  Whitelist:         %v
   Owner:             %s`,
 		ft.GetUniqueID(), ft.Family, ft.Source, ft.Gateway, ft.Name, ft.Symbol, ft.CanonicalSymbol, ft.MinUnitAlias,
-		ft.Decimal, initSupply, maxSupply, ft.Mintable, owner)
+		ft.Decimal, initSupply, maxSupply, ft.Mintable, ft.Whitelist, owner)
 }
 
 func (ft FungibleToken) Sanitize() FungibleToken {
@@ -12,10 +12,20 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgEditToken{}, "irishub/asset/MsgEditToken", nil)
 	cdc.RegisterConcrete(MsgTransferGatewayOwner{}, "irishub/asset/MsgTransferGatewayOwner", nil)
 	cdc.RegisterConcrete(MsgMintToken{}, "irishub/asset/MsgMintToken", nil)
+	cdc.RegisterConcrete(MsgBurnToken{}, "irishub/asset/MsgBurnToken", nil)
+	cdc.RegisterConcrete(MsgFreezeToken{}, "irishub/asset/MsgFreezeToken", nil)
+	cdc.RegisterConcrete(MsgUnfreezeToken{}, "irishub/asset/MsgUnfreezeToken", nil)
+	cdc.RegisterConcrete(MsgEditWhitelist{}, "irishub/asset/MsgEditWhitelist", nil)
 	cdc.RegisterConcrete(MsgTransferTokenOwner{}, "irishub/asset/MsgTransferTokenOwner", nil)
+	cdc.RegisterConcrete(MsgMintNFT{}, "irishub/asset/MsgMintNFT", nil)
+	cdc.RegisterConcrete(MsgTransferNFT{}, "irishub/asset/MsgTransferNFT", nil)
+	cdc.RegisterConcrete(MsgEditNFT{}, "irishub/asset/MsgEditNFT", nil)
+	cdc.RegisterConcrete(MsgBurnNFT{}, "irishub/asset/MsgBurnNFT", nil)
 
 	cdc.RegisterConcrete(BaseToken{}, "irishub/asset/BaseToken", nil)
 	cdc.RegisterConcrete(FungibleToken{}, "irishub/asset/FungibleToken", nil)
+	cdc.RegisterConcrete(Collection{}, "irishub/asset/Collection", nil)
+	cdc.RegisterConcrete(NFT{}, "irishub/asset/NFT", nil)
 
 	cdc.RegisterConcrete(&Params{}, "irishub/asset/Params", nil)
 	cdc.RegisterConcrete(&Gateway{}, "irishub/asset/Gateway", nil)
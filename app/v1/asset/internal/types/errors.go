@@ -34,9 +34,18 @@ const (
 	CodeUnauthorizedIssueGatewayAsset sdk.CodeType = 121
 	CodeAssetNotExists                sdk.CodeType = 122
 	CodeAssetNotMintable              sdk.CodeType = 123
+	CodeInvalidCollection             sdk.CodeType = 124
+	CodeInvalidNFTId                  sdk.CodeType = 125
+	CodeInvalidTokenURI               sdk.CodeType = 126
+	CodeNFTAlreadyExists              sdk.CodeType = 127
+	CodeNFTNotExists                  sdk.CodeType = 128
 
 	CodeInsufficientCoins       sdk.CodeType = 130
 	CodeSignersMissingInContext sdk.CodeType = 131
+
+	CodeTokenFrozen    sdk.CodeType = 140
+	CodeTokenNotFrozen sdk.CodeType = 141
+	CodeNotWhitelisted sdk.CodeType = 142
 )
 
 //----------------------------------------
@@ -93,6 +102,44 @@ func ErrAssetNotMintable(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeAssetNotMintable, msg)
 }
 
+//----------------------------------------
+// NFT error constructors
+
+func ErrInvalidCollection(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCollection, msg)
+}
+
+func ErrInvalidNFTId(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidNFTId, msg)
+}
+
+func ErrInvalidTokenURI(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTokenURI, msg)
+}
+
+func ErrNFTAlreadyExists(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeNFTAlreadyExists, msg)
+}
+
+func ErrNFTNotExists(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeNFTNotExists, msg)
+}
+
+//----------------------------------------
+// Freeze error constructors
+
+func ErrTokenFrozen(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenFrozen, msg)
+}
+
+func ErrTokenNotFrozen(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenNotFrozen, msg)
+}
+
+func ErrNotWhitelisted(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotWhitelisted, msg)
+}
+
 //----------------------------------------
 // Gateway error constructors
 
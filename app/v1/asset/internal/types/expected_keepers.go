@@ -12,6 +12,10 @@ type BankKeeper interface {
 
 	IncreaseTotalSupply(ctx sdk.Context, amt sdk.Coin) sdk.Error
 
+	DecreaseTotalSupply(ctx sdk.Context, amt sdk.Coin) sdk.Error
+
+	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+
 	BurnCoins(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
@@ -11,18 +11,18 @@ import (
 type AssetFamily byte
 
 const (
-	FUNGIBLE AssetFamily = 0x00
-	//NON_FUNGIBLE AssetFamily = 0x01
+	FUNGIBLE     AssetFamily = 0x00
+	NON_FUNGIBLE AssetFamily = 0x01
 )
 
 var (
 	AssetFamilyToStringMap = map[AssetFamily]string{
-		FUNGIBLE: "fungible",
-		//NON_FUNGIBLE: "non-fungible",
+		FUNGIBLE:     "fungible",
+		NON_FUNGIBLE: "non-fungible",
 	}
 	StringToAssetFamilyMap = map[string]AssetFamily{
-		"fungible": FUNGIBLE,
-		//"non-fungible": NON_FUNGIBLE,
+		"fungible":     FUNGIBLE,
+		"non-fungible": NON_FUNGIBLE,
 	}
 )
 
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// TokenAccounts is a set of accounts recorded for a token, e.g. the frozen or whitelisted accounts
+type TokenAccounts struct {
+	TokenId  string           `json:"token_id"` // the unique id of the token
+	Accounts []sdk.AccAddress `json:"accounts"` // the recorded accounts
+}
+
+// NewTokenAccounts constructs a TokenAccounts
+func NewTokenAccounts(tokenId string, accounts []sdk.AccAddress) TokenAccounts {
+	return TokenAccounts{
+		TokenId:  tokenId,
+		Accounts: accounts,
+	}
+}
+
+// Validate checks if the TokenAccounts is valid
+func (ta TokenAccounts) Validate() sdk.Error {
+	if err := CheckTokenID(ta.TokenId); err != nil {
+		return err
+	}
+
+	for _, acc := range ta.Accounts {
+		if acc.Empty() {
+			return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("empty account recorded for token %s", ta.TokenId))
+		}
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ta TokenAccounts) String() string {
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("Token %s:", ta.TokenId))
+
+	for _, acc := range ta.Accounts {
+		out.WriteString(fmt.Sprintf("\n  %s", acc.String()))
+	}
+
+	return out.String()
+}
@@ -2,7 +2,12 @@ package types
 
 // GenesisState - all asset state that must be provided at genesis
 type GenesisState struct {
-	Params   Params    `json:"params"`   // asset params
-	Tokens   Tokens    `json:"tokens"`   // issued tokens
-	Gateways []Gateway `json:"gateways"` // created gateways
+	Params      Params      `json:"params"`      // asset params
+	Tokens      Tokens      `json:"tokens"`      // issued tokens
+	Gateways    []Gateway   `json:"gateways"`    // created gateways
+	Collections Collections `json:"collections"` // issued non-fungible collections
+	NFTs        NFTs        `json:"nfts"`        // minted non-fungible tokens
+
+	FrozenAccounts []TokenAccounts `json:"frozen_accounts"` // frozen accounts per token
+	Whitelists     []TokenAccounts `json:"whitelists"`      // whitelisted accounts per token
 }
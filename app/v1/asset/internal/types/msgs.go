@@ -33,6 +33,10 @@ var (
 	MaximumGatewayDetailsSize  = 280 // maximal limitation for the length of the gateway's details
 	MaximumGatewayWebsiteSize  = 128 // maximal limitation for the length of the gateway's website
 
+	MinimumNFTIdSize       = 1   // minimal limitation for the length of the nft's id
+	MaximumNFTIdSize       = 64  // maximal limitation for the length of the nft's id
+	MaximumNFTTokenURISize = 256 // maximal limitation for the length of the nft's token uri
+
 	IsAlphaNumeric     = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString   // only accepts alphanumeric characters
 	IsAlphaNumericDash = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`).MatchString // only accepts alphanumeric characters, _ and -
 	IsBeginWithAlpha   = regexp.MustCompile(`^[a-zA-Z].*`).MatchString
@@ -119,6 +123,21 @@ func ValidateMsgIssueToken(msg *MsgIssueToken) sdk.Error {
 		return ErrInvalidAssetFamily(DefaultCodespace, fmt.Sprintf("invalid asset family type %s", msg.Family))
 	}
 
+	if msg.Family == NON_FUNGIBLE {
+		// a non-fungible collection has no divisible supply; max supply limits the number of NFTs
+		if msg.Decimal != 0 {
+			return ErrInvalidAssetDecimal(DefaultCodespace, fmt.Sprintf("invalid collection decimal %d, must be 0 for non-fungible assets", msg.Decimal))
+		}
+		if msg.InitialSupply != 0 {
+			return ErrInvalidAssetInitSupply(DefaultCodespace, fmt.Sprintf("invalid collection initial supply %d, must be 0 for non-fungible assets", msg.InitialSupply))
+		}
+		if !msg.Mintable {
+			return ErrAssetNotMintable(DefaultCodespace, "a non-fungible collection must be mintable")
+		}
+		// ignore MinUnitAlias for non-fungible asset
+		msg.MinUnitAlias = ""
+	}
+
 	nameLen := len(msg.Name)
 	if nameLen == 0 || nameLen > MaximumAssetNameSize {
 		return ErrInvalidAssetName(DefaultCodespace, fmt.Sprintf("invalid token name %s, only accepts length (0, %d]", msg.Name, MaximumAssetNameSize))
@@ -610,6 +629,59 @@ func (msg MsgMintToken) ValidateBasic() sdk.Error {
 	return CheckTokenID(msg.TokenId)
 }
 
+// MsgBurnToken for burning the token from the sender's account
+type MsgBurnToken struct {
+	TokenId string         `json:"token_id"` // the unique id of the token
+	Sender  sdk.AccAddress `json:"sender"`   // address of the holder who burns the token
+	Amount  uint64         `json:"amount"`   // amount of burn token
+	Redeem  bool           `json:"redeem"`   // true if the owner redeems the token, which keeps the max supply unchanged
+}
+
+// NewMsgBurnToken creates a MsgBurnToken
+func NewMsgBurnToken(tokenId string, sender sdk.AccAddress, amount uint64, redeem bool) MsgBurnToken {
+	tokenId = strings.TrimSpace(tokenId)
+	return MsgBurnToken{
+		TokenId: tokenId,
+		Sender:  sender,
+		Amount:  amount,
+		Redeem:  redeem,
+	}
+}
+
+// Route implements Msg
+func (msg MsgBurnToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgBurnToken) Type() string { return "burn_token" }
+
+// GetSignBytes implements Msg
+func (msg MsgBurnToken) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgBurnToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgBurnToken) ValidateBasic() sdk.Error {
+	// check the sender
+	if len(msg.Sender) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the sender of the token must be specified"))
+	}
+
+	if msg.Amount <= 0 || msg.Amount > MaximumAssetMaxSupply {
+		return ErrInvalidAssetMaxSupply(DefaultCodespace, fmt.Sprintf("invalid token amount %d, only accepts value (0, %d]", msg.Amount, MaximumAssetMaxSupply))
+	}
+
+	return CheckTokenID(msg.TokenId)
+}
+
 // ValidateMoniker checks if the specified moniker is valid
 func ValidateMoniker(moniker string) sdk.Error {
 	// check the moniker size
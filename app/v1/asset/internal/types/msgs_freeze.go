@@ -0,0 +1,186 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var _, _, _ sdk.Msg = &MsgFreezeToken{}, &MsgUnfreezeToken{}, &MsgEditWhitelist{}
+
+// MsgFreezeToken for freezing the balance of a token held by the specified account
+type MsgFreezeToken struct {
+	TokenId string         `json:"token_id"` // the unique id of the token
+	Owner   sdk.AccAddress `json:"owner"`    // the owner of the token
+	Holder  sdk.AccAddress `json:"holder"`   // the account of which the balance is to be frozen
+}
+
+// NewMsgFreezeToken creates a MsgFreezeToken
+func NewMsgFreezeToken(tokenId string, owner, holder sdk.AccAddress) MsgFreezeToken {
+	return MsgFreezeToken{
+		TokenId: strings.TrimSpace(tokenId),
+		Owner:   owner,
+		Holder:  holder,
+	}
+}
+
+// Route implements Msg
+func (msg MsgFreezeToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgFreezeToken) Type() string { return "freeze_token" }
+
+// ValidateBasic implements Msg
+func (msg MsgFreezeToken) ValidateBasic() sdk.Error {
+	return validateFreezeMsg(msg.TokenId, msg.Owner, msg.Holder)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgFreezeToken) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgFreezeToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgUnfreezeToken for unfreezing the balance of a token held by the specified account
+type MsgUnfreezeToken struct {
+	TokenId string         `json:"token_id"` // the unique id of the token
+	Owner   sdk.AccAddress `json:"owner"`    // the owner of the token
+	Holder  sdk.AccAddress `json:"holder"`   // the account of which the balance is to be unfrozen
+}
+
+// NewMsgUnfreezeToken creates a MsgUnfreezeToken
+func NewMsgUnfreezeToken(tokenId string, owner, holder sdk.AccAddress) MsgUnfreezeToken {
+	return MsgUnfreezeToken{
+		TokenId: strings.TrimSpace(tokenId),
+		Owner:   owner,
+		Holder:  holder,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUnfreezeToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUnfreezeToken) Type() string { return "unfreeze_token" }
+
+// ValidateBasic implements Msg
+func (msg MsgUnfreezeToken) ValidateBasic() sdk.Error {
+	return validateFreezeMsg(msg.TokenId, msg.Owner, msg.Holder)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUnfreezeToken) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnfreezeToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgEditWhitelist for switching the whitelist mode of a token and editing the whitelisted accounts
+type MsgEditWhitelist struct {
+	TokenId   string           `json:"token_id"`  // the unique id of the token
+	Owner     sdk.AccAddress   `json:"owner"`     // the owner of the token
+	Whitelist Bool             `json:"whitelist"` // whether the whitelist mode is enabled
+	Add       []sdk.AccAddress `json:"add"`       // the accounts to be added to the whitelist
+	Remove    []sdk.AccAddress `json:"remove"`    // the accounts to be removed from the whitelist
+}
+
+// NewMsgEditWhitelist creates a MsgEditWhitelist
+func NewMsgEditWhitelist(tokenId string, owner sdk.AccAddress, whitelist Bool, add, remove []sdk.AccAddress) MsgEditWhitelist {
+	return MsgEditWhitelist{
+		TokenId:   strings.TrimSpace(tokenId),
+		Owner:     owner,
+		Whitelist: whitelist,
+		Add:       add,
+		Remove:    remove,
+	}
+}
+
+// Route implements Msg
+func (msg MsgEditWhitelist) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgEditWhitelist) Type() string { return "edit_whitelist" }
+
+// ValidateBasic implements Msg
+func (msg MsgEditWhitelist) ValidateBasic() sdk.Error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner of the token must be specified"))
+	}
+
+	if err := CheckTokenID(msg.TokenId); err != nil {
+		return err
+	}
+
+	if msg.Whitelist == Nil && len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return ErrNoUpdatesProvided(DefaultCodespace, fmt.Sprintf("no updated values provided"))
+	}
+
+	added := make(map[string]bool)
+	for _, addr := range msg.Add {
+		if len(addr) == 0 {
+			return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the whitelisted account must not be empty"))
+		}
+		added[addr.String()] = true
+	}
+
+	for _, addr := range msg.Remove {
+		if len(addr) == 0 {
+			return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the whitelisted account must not be empty"))
+		}
+		if added[addr.String()] {
+			return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the account %s can not be both added and removed", addr))
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgEditWhitelist) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgEditWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// validateFreezeMsg checks the common fields of MsgFreezeToken and MsgUnfreezeToken
+func validateFreezeMsg(tokenId string, owner, holder sdk.AccAddress) sdk.Error {
+	// check the owner
+	if len(owner) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner of the token must be specified"))
+	}
+
+	// check the holder
+	if len(holder) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the holder of the token must be specified"))
+	}
+
+	if owner.Equals(holder) {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner can not freeze the balance of its own"))
+	}
+
+	return CheckTokenID(tokenId)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFreezeTokenValidateBasic(t *testing.T) {
+	testData := []struct {
+		name       string
+		tokenId    string
+		owner      sdk.AccAddress
+		holder     sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty owner", "btc", emptyAddr, addr2, false},
+		{"empty holder", "btc", addr1, emptyAddr, false},
+		{"freeze self", "btc", addr1, addr1, false},
+		{"invalid token id", "p.btc", addr1, addr2, false},
+		{"basic good", "btc", addr1, addr2, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgFreezeToken(td.tokenId, td.owner, td.holder)
+		unfreezeMsg := NewMsgUnfreezeToken(td.tokenId, td.owner, td.holder)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+			require.Nil(t, unfreezeMsg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+			require.NotNil(t, unfreezeMsg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
+
+func TestMsgEditWhitelistValidateBasic(t *testing.T) {
+	testData := []struct {
+		name       string
+		owner      sdk.AccAddress
+		whitelist  Bool
+		add        []sdk.AccAddress
+		remove     []sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty owner", emptyAddr, True, nil, nil, false},
+		{"no updates", addr1, Nil, nil, nil, false},
+		{"empty account", addr1, Nil, []sdk.AccAddress{emptyAddr}, nil, false},
+		{"add and remove", addr1, Nil, []sdk.AccAddress{addr2}, []sdk.AccAddress{addr2}, false},
+		{"switch mode", addr1, True, nil, nil, true},
+		{"edit accounts", addr1, Nil, []sdk.AccAddress{addr2}, []sdk.AccAddress{addr1}, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgEditWhitelist("btc", td.owner, td.whitelist, td.add, td.remove)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var _, _, _, _ sdk.Msg = &MsgMintNFT{}, &MsgTransferNFT{}, &MsgEditNFT{}, &MsgBurnNFT{}
+
+// MsgMintNFT for minting an NFT of a collection to a specified address
+type MsgMintNFT struct {
+	Owner        sdk.AccAddress `json:"owner"`         // the owner of the collection
+	CollectionId string         `json:"collection_id"` // the id of the collection
+	TokenId      string         `json:"token_id"`      // the unique id of the NFT to be minted
+	To           sdk.AccAddress `json:"to"`            // the recipient of the NFT, default to the owner
+	TokenURI     string         `json:"token_uri"`     // the URI pointing to the metadata of the NFT
+}
+
+// NewMsgMintNFT creates a MsgMintNFT
+func NewMsgMintNFT(owner sdk.AccAddress, collectionId, tokenId string, to sdk.AccAddress, tokenURI string) MsgMintNFT {
+	return MsgMintNFT{
+		Owner:        owner,
+		CollectionId: strings.ToLower(strings.TrimSpace(collectionId)),
+		TokenId:      strings.TrimSpace(tokenId),
+		To:           to,
+		TokenURI:     strings.TrimSpace(tokenURI),
+	}
+}
+
+// Route implements Msg
+func (msg MsgMintNFT) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgMintNFT) Type() string { return "mint_nft" }
+
+// ValidateBasic implements Msg
+func (msg MsgMintNFT) ValidateBasic() sdk.Error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner of the collection must be specified"))
+	}
+
+	if err := CheckTokenID(msg.CollectionId); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTId(msg.TokenId); err != nil {
+		return err
+	}
+
+	return ValidateTokenURI(msg.TokenURI)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgMintNFT) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgMintNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTransferNFT for transferring an NFT to a new owner
+type MsgTransferNFT struct {
+	Sender       sdk.AccAddress `json:"sender"`        // the current owner of the NFT
+	Recipient    sdk.AccAddress `json:"recipient"`     // the new owner of the NFT
+	CollectionId string         `json:"collection_id"` // the id of the collection
+	TokenId      string         `json:"token_id"`      // the id of the NFT
+}
+
+// NewMsgTransferNFT creates a MsgTransferNFT
+func NewMsgTransferNFT(sender, recipient sdk.AccAddress, collectionId, tokenId string) MsgTransferNFT {
+	return MsgTransferNFT{
+		Sender:       sender,
+		Recipient:    recipient,
+		CollectionId: strings.ToLower(strings.TrimSpace(collectionId)),
+		TokenId:      strings.TrimSpace(tokenId),
+	}
+}
+
+// Route implements Msg
+func (msg MsgTransferNFT) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgTransferNFT) Type() string { return "transfer_nft" }
+
+// ValidateBasic implements Msg
+func (msg MsgTransferNFT) ValidateBasic() sdk.Error {
+	// check the sender
+	if len(msg.Sender) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner of the nft must be specified"))
+	}
+
+	// check the recipient
+	if len(msg.Recipient) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the recipient of the nft must be specified"))
+	}
+
+	if msg.Sender.Equals(msg.Recipient) {
+		return ErrInvalidToAddress(DefaultCodespace, fmt.Sprintf("the recipient must not be same as the original owner"))
+	}
+
+	if err := CheckTokenID(msg.CollectionId); err != nil {
+		return err
+	}
+
+	return ValidateNFTId(msg.TokenId)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgTransferNFT) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgEditNFT for editing the metadata of an NFT
+type MsgEditNFT struct {
+	Owner        sdk.AccAddress `json:"owner"`         // the owner of the NFT
+	CollectionId string         `json:"collection_id"` // the id of the collection
+	TokenId      string         `json:"token_id"`      // the id of the NFT
+	TokenURI     string         `json:"token_uri"`     // the new token URI
+}
+
+// NewMsgEditNFT creates a MsgEditNFT
+func NewMsgEditNFT(owner sdk.AccAddress, collectionId, tokenId, tokenURI string) MsgEditNFT {
+	return MsgEditNFT{
+		Owner:        owner,
+		CollectionId: strings.ToLower(strings.TrimSpace(collectionId)),
+		TokenId:      strings.TrimSpace(tokenId),
+		TokenURI:     strings.TrimSpace(tokenURI),
+	}
+}
+
+// Route implements Msg
+func (msg MsgEditNFT) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgEditNFT) Type() string { return "edit_nft" }
+
+// ValidateBasic implements Msg
+func (msg MsgEditNFT) ValidateBasic() sdk.Error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner of the nft must be specified"))
+	}
+
+	if err := CheckTokenID(msg.CollectionId); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTId(msg.TokenId); err != nil {
+		return err
+	}
+
+	if msg.TokenURI == DoNotModify {
+		return ErrNoUpdatesProvided(DefaultCodespace, fmt.Sprintf("no updated values provided"))
+	}
+
+	return ValidateTokenURI(msg.TokenURI)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgEditNFT) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgEditNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgBurnNFT for burning an NFT
+type MsgBurnNFT struct {
+	Owner        sdk.AccAddress `json:"owner"`         // the owner of the NFT
+	CollectionId string         `json:"collection_id"` // the id of the collection
+	TokenId      string         `json:"token_id"`      // the id of the NFT
+}
+
+// NewMsgBurnNFT creates a MsgBurnNFT
+func NewMsgBurnNFT(owner sdk.AccAddress, collectionId, tokenId string) MsgBurnNFT {
+	return MsgBurnNFT{
+		Owner:        owner,
+		CollectionId: strings.ToLower(strings.TrimSpace(collectionId)),
+		TokenId:      strings.TrimSpace(tokenId),
+	}
+}
+
+// Route implements Msg
+func (msg MsgBurnNFT) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgBurnNFT) Type() string { return "burn_nft" }
+
+// ValidateBasic implements Msg
+func (msg MsgBurnNFT) ValidateBasic() sdk.Error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return ErrInvalidAddress(DefaultCodespace, fmt.Sprintf("the owner of the nft must be specified"))
+	}
+
+	if err := CheckTokenID(msg.CollectionId); err != nil {
+		return err
+	}
+
+	return ValidateNFTId(msg.TokenId)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgBurnNFT) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
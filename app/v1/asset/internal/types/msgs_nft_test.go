@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgIssueNonFungibleToken(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+
+	msg := NewMsgIssueToken(NON_FUNGIBLE, NATIVE, "", "kitty", "", "Crypto Kitties", 0, "", 0, 0, true, owner)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg = NewMsgIssueToken(NON_FUNGIBLE, NATIVE, "", "kitty", "", "Crypto Kitties", 2, "", 0, 0, true, owner)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgIssueToken(NON_FUNGIBLE, NATIVE, "", "kitty", "", "Crypto Kitties", 0, "", 10, 0, true, owner)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = NewMsgIssueToken(NON_FUNGIBLE, NATIVE, "", "kitty", "", "Crypto Kitties", 0, "", 0, 0, false, owner)
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgMintNFTValidateBasic(t *testing.T) {
+	testData := []struct {
+		name         string
+		owner        sdk.AccAddress
+		collectionId string
+		tokenId      string
+		tokenURI     string
+		expectPass   bool
+	}{
+		{"empty owner", emptyAddr, "kitty", "kitty-1", "", false},
+		{"invalid collection", addr1, "p.k", "kitty-1", "", false},
+		{"empty nft id", addr1, "kitty", "", "", false},
+		{"invalid nft id", addr1, "kitty", "kitty/1", "", false},
+		{"too long token uri", addr1, "kitty", "kitty-1", string(make([]byte, MaximumNFTTokenURISize+1)), false},
+		{"basic good", addr1, "kitty", "kitty-1", "https://kitty/1", true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgMintNFT(td.owner, td.collectionId, td.tokenId, addr2, td.tokenURI)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
+
+func TestMsgTransferNFTValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgTransferNFT(addr1, addr2, "kitty", "kitty-1").ValidateBasic())
+	require.NotNil(t, NewMsgTransferNFT(addr1, addr1, "kitty", "kitty-1").ValidateBasic())
+	require.NotNil(t, NewMsgTransferNFT(addr1, emptyAddr, "kitty", "kitty-1").ValidateBasic())
+	require.NotNil(t, NewMsgTransferNFT(emptyAddr, addr2, "kitty", "kitty-1").ValidateBasic())
+}
+
+func TestMsgEditNFTValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgEditNFT(addr1, "kitty", "kitty-1", "https://kitty/1").ValidateBasic())
+	require.Nil(t, NewMsgEditNFT(addr1, "kitty", "kitty-1", "").ValidateBasic())
+	require.NotNil(t, NewMsgEditNFT(addr1, "kitty", "kitty-1", DoNotModify).ValidateBasic())
+	require.NotNil(t, NewMsgEditNFT(emptyAddr, "kitty", "kitty-1", "").ValidateBasic())
+}
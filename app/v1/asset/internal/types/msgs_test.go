@@ -393,6 +393,35 @@ func TestMsgMintTokenValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgBurnTokenValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		tokeId     string
+		sender     sdk.AccAddress
+		amount     uint64
+		redeem     bool
+		expectPass bool
+	}{
+		{"empty tokeId", "", addr1, 1000, false, false},
+		{"wrong tokeId", "p.btc", addr1, 1000, false, false},
+		{"native token", "iris", addr1, 1000, false, false},
+		{"empty sender", "btc", emptyAddr, 1000, false, false},
+		{"invalid amount", "btc", addr1, 0, false, false},
+		{"exceed max supply", "btc", addr1, 100000000000000, false, false},
+		{"basic good", "btc", addr1, 1000, false, true},
+		{"redeem", "x.btc", addr1, 1000, true, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgBurnToken(td.tokeId, td.sender, td.amount, td.redeem)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
 func TestMsgTransferTokenOwnerValidation(t *testing.T) {
 	testData := []struct {
 		name       string
@@ -0,0 +1,199 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Collection represents a non-fungible asset family, which groups a set of unique tokens
+type Collection struct {
+	Id        string         `json:"id"`         // the unique id of the collection, shares the namespace with fungible tokens
+	Source    AssetSource    `json:"source"`     // the source of the collection, native or gateway
+	Gateway   string         `json:"gateway"`    // the gateway moniker for a gateway collection
+	Symbol    string         `json:"symbol"`     // the symbol of the collection
+	Name      string         `json:"name"`       // the name of the collection
+	MaxSupply uint64         `json:"max_supply"` // the maximum number of NFTs in the collection
+	Mintable  bool           `json:"mintable"`   // whether new NFTs can be minted
+	Owner     sdk.AccAddress `json:"owner"`      // the owner of the collection
+}
+
+// NewCollection constructs a collection
+func NewCollection(source AssetSource, gateway, symbol, name string, maxSupply uint64, mintable bool, owner sdk.AccAddress) Collection {
+	if maxSupply == 0 {
+		maxSupply = MaximumAssetMaxSupply
+	}
+
+	collection := Collection{
+		Source:    source,
+		Gateway:   strings.ToLower(strings.TrimSpace(gateway)),
+		Symbol:    strings.ToLower(strings.TrimSpace(symbol)),
+		Name:      strings.TrimSpace(name),
+		MaxSupply: maxSupply,
+		Mintable:  mintable,
+		Owner:     owner,
+	}
+
+	collection.Id = collection.GetUniqueID()
+	return collection
+}
+
+// GetUniqueID returns the unique id of the collection
+func (c Collection) GetUniqueID() string {
+	switch c.Source {
+	case NATIVE:
+		return strings.ToLower(c.Symbol)
+	case GATEWAY:
+		return strings.ToLower(fmt.Sprintf("%s.%s", c.Gateway, c.Symbol))
+	default:
+		return ""
+	}
+}
+
+// Validate checks if a collection is valid
+func (c Collection) Validate() sdk.Error {
+	msg := NewMsgIssueToken(NON_FUNGIBLE, c.Source, c.Gateway, c.Symbol, "", c.Name, 0, "", 0, c.MaxSupply, c.Mintable, c.Owner)
+	if err := ValidateMsgIssueToken(&msg); err != nil {
+		return err
+	}
+
+	if c.Source == EXTERNAL {
+		return ErrInvalidAssetSource(DefaultCodespace, "a collection can not be external")
+	}
+
+	if c.Id != c.GetUniqueID() {
+		return ErrInvalidCollection(DefaultCodespace, fmt.Sprintf("invalid collection id %s, expected %s", c.Id, c.GetUniqueID()))
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (c Collection) String() string {
+	owner := ""
+	if !c.Owner.Empty() {
+		owner = c.Owner.String()
+	}
+
+	return fmt.Sprintf(`Collection %s:
+  Source:            %s
+  Gateway:           %s
+  Name:              %s
+  Symbol:            %s
+  Max Supply:        %d
+  Mintable:          %v
+  Owner:             %s`,
+		c.Id, c.Source, c.Gateway, c.Name, c.Symbol, c.MaxSupply, c.Mintable, owner)
+}
+
+// Collections is a set of collections
+type Collections []Collection
+
+// String implements fmt.Stringer
+func (cs Collections) String() string {
+	if len(cs) == 0 {
+		return "[]"
+	}
+
+	out := ""
+	for _, c := range cs {
+		out += fmt.Sprintf("%v \n", c.String())
+	}
+	return out[:len(out)-1]
+}
+
+// Validate checks if all the collections are valid
+func (cs Collections) Validate() sdk.Error {
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NFT represents a unique token of a collection
+type NFT struct {
+	CollectionId string         `json:"collection_id"` // the id of the collection to which the NFT belongs
+	TokenId      string         `json:"token_id"`      // the unique id of the NFT within the collection
+	Owner        sdk.AccAddress `json:"owner"`         // the owner of the NFT
+	TokenURI     string         `json:"token_uri"`     // the URI pointing to the metadata of the NFT
+}
+
+// NewNFT constructs an NFT
+func NewNFT(collectionId, tokenId string, owner sdk.AccAddress, tokenURI string) NFT {
+	return NFT{
+		CollectionId: strings.ToLower(strings.TrimSpace(collectionId)),
+		TokenId:      strings.TrimSpace(tokenId),
+		Owner:        owner,
+		TokenURI:     strings.TrimSpace(tokenURI),
+	}
+}
+
+// Validate checks if an NFT is valid
+func (nft NFT) Validate() sdk.Error {
+	if err := CheckTokenID(nft.CollectionId); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTId(nft.TokenId); err != nil {
+		return err
+	}
+
+	if nft.Owner.Empty() {
+		return ErrInvalidAddress(DefaultCodespace, "the owner of the nft must be specified")
+	}
+
+	return ValidateTokenURI(nft.TokenURI)
+}
+
+// String implements fmt.Stringer
+func (nft NFT) String() string {
+	return fmt.Sprintf(`NFT %s/%s:
+  Owner:             %s
+  Token URI:         %s`,
+		nft.CollectionId, nft.TokenId, nft.Owner, nft.TokenURI)
+}
+
+// NFTs is a set of NFTs
+type NFTs []NFT
+
+// String implements fmt.Stringer
+func (nfts NFTs) String() string {
+	if len(nfts) == 0 {
+		return "[]"
+	}
+
+	out := ""
+	for _, nft := range nfts {
+		out += fmt.Sprintf("%v \n", nft.String())
+	}
+	return out[:len(out)-1]
+}
+
+// Validate checks if all the NFTs are valid
+func (nfts NFTs) Validate() sdk.Error {
+	for _, nft := range nfts {
+		if err := nft.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateNFTId checks if the given NFT id is valid
+func ValidateNFTId(tokenId string) sdk.Error {
+	if len(tokenId) < MinimumNFTIdSize || len(tokenId) > MaximumNFTIdSize || !IsAlphaNumericDash(tokenId) {
+		return ErrInvalidNFTId(DefaultCodespace, fmt.Sprintf("invalid nft id %s, only accepts alphanumeric characters, _ and -, length [%d, %d]", tokenId, MinimumNFTIdSize, MaximumNFTIdSize))
+	}
+	return nil
+}
+
+// ValidateTokenURI checks if the given token URI is valid
+func ValidateTokenURI(tokenURI string) sdk.Error {
+	if len(tokenURI) > MaximumNFTTokenURISize {
+		return ErrInvalidTokenURI(DefaultCodespace, fmt.Sprintf("the length of the token uri must be between [0,%d]", MaximumNFTTokenURISize))
+	}
+	return nil
+}
@@ -8,11 +8,19 @@ import (
 )
 
 const (
-	QueryToken    = "token"
-	QueryTokens   = "tokens"
-	QueryGateway  = "gateway"
-	QueryGateways = "gateways"
-	QueryFees     = "fees"
+	QueryToken     = "token"
+	QueryTokens    = "tokens"
+	QueryGateway   = "gateway"
+	QueryGateways  = "gateways"
+	QueryFees      = "fees"
+	QuerySupply    = "supply"
+	QueryFrozen    = "frozen"
+	QueryWhitelist = "whitelist"
+
+	QueryCollection  = "collection"
+	QueryCollections = "collections"
+	QueryNFT         = "nft"
+	QueryNFTs        = "nfts"
 )
 
 // QueryTokenParams is the query parameters for 'custom/asset/tokens/{id}'
@@ -20,6 +28,16 @@ type QueryTokenParams struct {
 	TokenId string
 }
 
+// QuerySupplyParams is the query parameters for 'custom/asset/supply'
+type QuerySupplyParams struct {
+	TokenId string
+}
+
+// QueryTokenAccountsParams is the query parameters for 'custom/asset/frozen' and 'custom/asset/whitelist'
+type QueryTokenAccountsParams struct {
+	TokenId string
+}
+
 // QueryTokensParams is the query parameters for 'custom/asset/tokens'
 type QueryTokensParams struct {
 	Source  string
@@ -47,6 +65,28 @@ type QueryTokenFeesParams struct {
 	ID string
 }
 
+// QueryCollectionParams is the query parameters for 'custom/asset/collection'
+type QueryCollectionParams struct {
+	Id string
+}
+
+// QueryCollectionsParams is the query parameters for 'custom/asset/collections'
+type QueryCollectionsParams struct {
+	Owner sdk.AccAddress
+}
+
+// QueryNFTParams is the query parameters for 'custom/asset/nft'
+type QueryNFTParams struct {
+	CollectionId string
+	TokenId      string
+}
+
+// QueryNFTsParams is the query parameters for 'custom/asset/nfts'
+type QueryNFTsParams struct {
+	CollectionId string
+	Owner        sdk.AccAddress
+}
+
 // GatewayFeeOutput is for the gateway fee query output
 type GatewayFeeOutput struct {
 	Exist bool     `json:"exist"` // indicate if the gateway has existed
@@ -114,3 +154,47 @@ func (tfo TokenFeesOutput) HumanString(converter sdk.CoinsConverter) string {
 
 	return out.String()
 }
+
+// TokenSupply is for the token supply query output
+type TokenSupply struct {
+	TokenId     string   `json:"token_id"`     // the unique id of the token
+	TotalSupply sdk.Coin `json:"total_supply"` // the current total supply tracked by bank
+	MaxSupply   sdk.Coin `json:"max_supply"`   // the max supply recorded on the token
+	Mintable    sdk.Coin `json:"mintable"`     // the amount which can still be minted
+}
+
+// NewTokenSupply constructs a TokenSupply
+func NewTokenSupply(token FungibleToken, totalSupply sdk.Coin) TokenSupply {
+	mintable := sdk.NewCoin(token.GetDenom(), sdk.ZeroInt())
+	if token.Mintable && token.MaxSupply.GT(totalSupply.Amount) {
+		mintable.Amount = token.MaxSupply.Sub(totalSupply.Amount)
+	}
+
+	return TokenSupply{
+		TokenId:     token.GetUniqueID(),
+		TotalSupply: totalSupply,
+		MaxSupply:   sdk.NewCoin(token.GetDenom(), token.MaxSupply),
+		Mintable:    mintable,
+	}
+}
+
+// String implements stringer
+func (ts TokenSupply) String() string {
+	return fmt.Sprintf(`Supply of token %s:
+  TotalSupply: %s
+  MaxSupply:   %s
+  Mintable:    %s`,
+		ts.TokenId, ts.TotalSupply.String(), ts.MaxSupply.String(), ts.Mintable.String())
+}
+
+// HumanString implements human
+func (ts TokenSupply) HumanString(converter sdk.CoinsConverter) string {
+	return fmt.Sprintf(`Supply of token %s:
+  TotalSupply: %s
+  MaxSupply:   %s
+  Mintable:    %s`,
+		ts.TokenId,
+		converter.ToMainUnit(sdk.Coins{ts.TotalSupply}),
+		converter.ToMainUnit(sdk.Coins{ts.MaxSupply}),
+		converter.ToMainUnit(sdk.Coins{ts.Mintable}))
+}
@@ -15,4 +15,10 @@ var (
 	TagOwner   = "token-owner"
 	TagGateway = "token-gateway"
 	TagSource  = "token-source"
+	TagAmount  = "token-amount"
+	TagHolder  = "token-holder"
+
+	TagCollection = "collection-id"
+	TagNFT        = "nft-id"
+	TagRecipient  = "nft-recipient"
 )
@@ -0,0 +1,62 @@
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// TokenSupplyInvariant checks that the total supply of every token tracked by bank
+// equals the sum of the token across all accounts except the burned coins account,
+// and does not exceed the max supply
+func TokenSupplyInvariant(k Keeper, mapper auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+
+		defer func() {
+			if r := recover(); r != nil {
+				switch rType := r.(type) {
+				case error:
+					err = rType
+				default:
+					err = errors.New(string(debug.Stack()))
+				}
+			}
+		}()
+
+		totalCoins := sdk.Coins{}
+		mapper.IterateAccounts(ctx, func(acc auth.Account) bool {
+			// coins sent to the burned account have been removed from the total supply
+			if !acc.GetAddress().Equals(auth.BurnedCoinsAccAddr) {
+				totalCoins = totalCoins.Add(acc.GetCoins())
+			}
+			return false
+		})
+
+		k.IterateTokens(ctx, func(token FungibleToken) (stop bool) {
+			supply, sdkErr := k.GetTokenSupply(ctx, token.GetUniqueID())
+			if sdkErr != nil {
+				err = fmt.Errorf("failed to get the supply of token %s: %s", token.GetUniqueID(), sdkErr.Error())
+				return true
+			}
+
+			if held := totalCoins.AmountOf(token.GetDenom()); !held.Equal(supply.TotalSupply.Amount) {
+				err = fmt.Errorf("total supply of token %s is %s, but the sum of all accounts is %s",
+					token.GetUniqueID(), supply.TotalSupply.Amount, held)
+				return true
+			}
+
+			if supply.TotalSupply.Amount.GT(supply.MaxSupply.Amount) {
+				err = fmt.Errorf("total supply of token %s is %s, which exceeds the max supply %s",
+					token.GetUniqueID(), supply.TotalSupply.Amount, supply.MaxSupply.Amount)
+				return true
+			}
+
+			return false
+		})
+
+		return err
+	}
+}
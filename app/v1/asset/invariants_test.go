@@ -0,0 +1,52 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
+	"github.com/irisnet/irishub/app/v1/params"
+	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/tests"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestTokenSupplyInvariant(t *testing.T) {
+	ms, accountKey, assetKey, paramskey, paramsTkey := tests.SetupMultiStore()
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	paramsKeeper := params.NewKeeper(cdc, paramskey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, assetKey, bk, DefaultCodespace, paramsKeeper.Subspace(DefaultParamSpace))
+	keeper.Init(ctx)
+
+	owner := ak.NewAccountWithAddress(ctx, []byte("owner")).GetAddress()
+	token := NewFungibleToken(NATIVE, "", "btc", "btc", 0, "", "", sdk.NewInt(1000), sdk.NewInt(10000), true, owner)
+	_, err := keeper.IssueToken(ctx, token)
+	require.NoError(t, err)
+
+	invariant := TokenSupplyInvariant(keeper, ak)
+	require.NoError(t, invariant(ctx))
+
+	_, err = keeper.BurnToken(ctx, NewMsgBurnToken("btc", owner, 400, false))
+	require.NoError(t, err)
+	require.NoError(t, invariant(ctx))
+
+	// bank.MsgBurn moves the coins to the burned account and decreases the total supply
+	_, err = bk.BurnCoins(ctx, owner, sdk.Coins{sdk.NewCoin("btc-min", sdk.NewInt(100))})
+	require.NoError(t, err)
+	require.NoError(t, invariant(ctx))
+
+	// removing coins without decreasing the total supply breaks the invariant
+	_, _, err = bk.SubtractCoins(ctx, owner, sdk.Coins{sdk.NewCoin("btc-min", sdk.NewInt(100))})
+	require.NoError(t, err)
+	require.Error(t, invariant(ctx))
+}
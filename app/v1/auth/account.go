@@ -191,5 +191,8 @@ func (acc *BaseAccount) SetMemoRegexp(regexp string) {
 func RegisterBaseAccount(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Account)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "irishub/bank/BaseAccount", nil)
+	cdc.RegisterInterface((*VestingAccount)(nil), nil)
+	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "irishub/bank/ContinuousVestingAccount", nil)
+	cdc.RegisterConcrete(&DelayedVestingAccount{}, "irishub/bank/DelayedVestingAccount", nil)
 	codec.RegisterCrypto(cdc)
 }
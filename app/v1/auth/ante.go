@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
@@ -93,7 +94,7 @@ func NewAnteHandler(am AccountKeeper, fck FeeKeeper) sdk.AnteHandler {
 		// first sig pays the fees
 		if !stdTx.Fee.Amount.IsZero() {
 			// signerAccs[0] is the fee payer
-			signerAccs[0], res = deductFees(signerAccs[0], stdTx.Fee)
+			signerAccs[0], res = deductFees(ctx.BlockHeader().Time, signerAccs[0], stdTx.Fee)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -262,16 +263,23 @@ func consumeMultisignatureVerificationGas(meter sdk.GasMeter,
 // Deduct the fee from the account.
 // We could use the CoinKeeper (in addition to the AccountKeeper,
 // because the CoinKeeper doesn't give us accounts), but it seems easier to do this.
-func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
+func deductFees(blockTime time.Time, acc Account, fee StdFee) (Account, sdk.Result) {
 	coins := acc.GetCoins()
 	feeAmount := fee.Amount
 
-	newCoins, hasNeg := coins.SafeSub(feeAmount)
-	if hasNeg {
-		errMsg := fmt.Sprintf("account balance [%s] is not enough to cover fee [%s]", coins, feeAmount)
+	// vesting coins can not be used to pay fees
+	spendableCoins := coins
+	if vacc, ok := acc.(VestingAccount); ok {
+		spendableCoins = vacc.SpendableCoins(blockTime)
+	}
+
+	if _, hasNeg := spendableCoins.SafeSub(feeAmount); hasNeg {
+		errMsg := fmt.Sprintf("account balance [%s] is not enough to cover fee [%s]", spendableCoins, feeAmount)
 		return nil, sdk.ErrInsufficientFunds(errMsg).Result()
 	}
 
+	newCoins := coins.Sub(feeAmount)
+
 	err := acc.SetCoins(newCoins)
 	if err != nil {
 		// Handle w/ #870
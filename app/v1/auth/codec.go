@@ -8,6 +8,9 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Account)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "irishub/bank/Account", nil)
+	cdc.RegisterInterface((*VestingAccount)(nil), nil)
+	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "irishub/bank/ContinuousVestingAccount", nil)
+	cdc.RegisterConcrete(&DelayedVestingAccount{}, "irishub/bank/DelayedVestingAccount", nil)
 	cdc.RegisterConcrete(StdTx{}, "irishub/bank/StdTx", nil)
 	cdc.RegisterConcrete(&Params{}, "irishub/Auth/Params", nil)
 }
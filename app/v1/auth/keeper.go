@@ -23,6 +23,8 @@ var (
 	ServiceRequestCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("serviceRequestCoins")))
 	CommunityTaxCoinsAccAddr   = sdk.AccAddress(crypto.AddressHash([]byte("communityTaxCoins")))
 	ServiceTaxCoinsAccAddr     = sdk.AccAddress(crypto.AddressHash([]byte("serviceTaxCoins")))
+	RandRequestCoinsAccAddr    = sdk.AccAddress(crypto.AddressHash([]byte("randRequestCoins")))
+	GovModuleAccAddr           = sdk.AccAddress(crypto.AddressHash([]byte("govModule")))
 )
 
 // This AccountKeeper encodes/decodes accounts using the
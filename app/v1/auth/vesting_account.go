@@ -0,0 +1,393 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// VestingAccount defines an account type that vests coins via a vesting schedule.
+type VestingAccount interface {
+	Account
+
+	// Calculates the amount of coins that can be sent to other accounts given
+	// the current time.
+	SpendableCoins(blockTime time.Time) sdk.Coins
+	// Performs delegation accounting.
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	// Performs undelegation accounting.
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+
+	// Checks the vesting schedule of the account.
+	Validate() error
+}
+
+//-----------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount implements the common bookkeeping of all vesting accounts.
+// The concrete vesting accounts only define how the original vesting coins are
+// released over time.
+type BaseVestingAccount struct {
+	*BaseAccount
+
+	OriginalVesting  sdk.Coins `json:"original_vesting"`  // coins in account upon initialization
+	DelegatedFree    sdk.Coins `json:"delegated_free"`    // coins that are vested and delegated
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // coins that vesting and delegated
+	EndTime          int64     `json:"end_time"`          // when the coins become unlocked
+}
+
+// spendableCoins returns all the spendable coins for a vesting account given a
+// set of vesting coins.
+//
+// CONTRACT: The account's coins, delegated vesting coins, vestingCoins must be
+// sorted.
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendableCoins sdk.Coins
+	bc := bva.GetCoins()
+
+	for _, coin := range bc {
+		baseAmt := coin.Amount
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		// compute min((BC + DV) - V, BC) per the specification
+		min := sdk.MinInt(baseAmt.Add(delVestingAmt).Sub(vestingAmt), baseAmt)
+		if !min.IsPositive() {
+			continue
+		}
+
+		spendableCoins = spendableCoins.Add(sdk.Coins{sdk.NewCoin(coin.Denom, min)})
+	}
+
+	return spendableCoins
+}
+
+// trackDelegation tracks a delegation amount for any given vesting account type
+// given the amount of coins currently vesting. It must be called before the
+// delegated coins are subtracted from the account.
+//
+// CONTRACT: The account's coins, delegation coins, vesting coins, and delegated
+// vesting coins must be sorted.
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	bc := bva.GetCoins()
+
+	for _, coin := range amount {
+		if coin.Amount.IsZero() {
+			continue
+		}
+		baseAmt := bc.AmountOf(coin.Denom)
+		if baseAmt.LT(coin.Amount) {
+			panic(fmt.Sprintf("delegation attempt with insufficient funds: %s", coin))
+		}
+
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		// compute x and y per the specification, where:
+		// X := min(max(V - DV, 0), D)
+		// Y := D - X
+		x := vestingAmt.Sub(delVestingAmt)
+		if x.IsNegative() {
+			x = sdk.ZeroInt()
+		}
+		x = sdk.MinInt(x, coin.Amount)
+		y := coin.Amount.Sub(x)
+
+		if !x.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Add(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if !y.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+	}
+}
+
+// TrackUndelegation tracks an undelegation amount by setting the necessary
+// values by which delegated free and delegated vesting need to decrease. The
+// undelegated coins are added to the account by the caller. Delegated free
+// coins are released first, since slashing may have burnt part of the
+// delegation.
+//
+// CONTRACT: The account's coins and undelegation coins must be sorted.
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		if coin.Amount.IsZero() {
+			continue
+		}
+
+		delegatedFree := bva.DelegatedFree.AmountOf(coin.Denom)
+		delegatedVesting := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		// compute x and y per the specification, where:
+		// X := min(DF, D)
+		// Y := min(DV, D - X)
+		x := sdk.MinInt(delegatedFree, coin.Amount)
+		y := sdk.MinInt(delegatedVesting, coin.Amount.Sub(x))
+
+		if !x.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Sub(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if !y.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+	}
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// Validate checks the vesting schedule of the account
+func (bva BaseVestingAccount) Validate() error {
+	if bva.BaseAccount == nil {
+		return errors.New("base account cannot be empty")
+	}
+	if !bva.OriginalVesting.IsValid() || bva.OriginalVesting.IsZero() {
+		return fmt.Errorf("invalid original vesting coins [%s]", bva.OriginalVesting)
+	}
+	if !bva.DelegatedFree.IsValid() || !bva.DelegatedVesting.IsValid() {
+		return fmt.Errorf("invalid delegated coins [%s] [%s]", bva.DelegatedFree, bva.DelegatedVesting)
+	}
+	if !bva.OriginalVesting.IsAllGTE(bva.DelegatedVesting) {
+		return fmt.Errorf("delegated vesting coins [%s] exceed original vesting coins [%s]", bva.DelegatedVesting, bva.OriginalVesting)
+	}
+	// the delegated coins have been subtracted from the account coins
+	totalCoins := bva.GetCoins().Add(bva.DelegatedFree).Add(bva.DelegatedVesting)
+	if !totalCoins.IsAllGTE(bva.OriginalVesting) {
+		return fmt.Errorf("original vesting coins [%s] exceed account coins [%s]", bva.OriginalVesting, totalCoins)
+	}
+	return nil
+}
+
+func (bva BaseVestingAccount) scheduleString(vestingType, startTime string, converter func(sdk.Coins) string) string {
+	return fmt.Sprintf(`
+  Vesting Type:       %s
+  Start Time:         %s
+  End Time:           %s
+  Original Vesting:   %s
+  Delegated Free:     %s
+  Delegated Vesting:  %s`,
+		vestingType,
+		startTime,
+		formatVestingTime(bva.EndTime),
+		converter(bva.OriginalVesting),
+		converter(bva.DelegatedFree),
+		converter(bva.DelegatedVesting),
+	)
+}
+
+func formatVestingTime(t int64) string {
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
+
+//-----------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ VestingAccount = (*ContinuousVestingAccount)(nil)
+
+// ContinuousVestingAccount implements the VestingAccount interface. It
+// continuously vests by unlocking coins linearly with respect to time.
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime int64 `json:"start_time"` // when the coins start to vest
+}
+
+// NewContinuousVestingAccount returns a new ContinuousVestingAccount which vests
+// all the coins of the given base account between startTime and endTime
+func NewContinuousVestingAccount(baseAcc *BaseAccount, startTime, endTime int64) *ContinuousVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         endTime,
+	}
+
+	return &ContinuousVestingAccount{
+		StartTime:          startTime,
+		BaseVestingAccount: baseVestingAcc,
+	}
+}
+
+// String implements fmt.Stringer
+func (cva ContinuousVestingAccount) String() string {
+	return cva.BaseAccount.String() + cva.scheduleString("continuous", formatVestingTime(cva.StartTime), sdk.Coins.String)
+}
+
+// String implements human.Stringer
+func (cva ContinuousVestingAccount) HumanString(converter sdk.CoinsConverter) string {
+	return cva.BaseAccount.HumanString(converter) + cva.scheduleString("continuous", formatVestingTime(cva.StartTime), converter.ToMainUnit)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - cva.StartTime
+	y := cva.EndTime - cva.StartTime
+	s := sdk.NewDec(x).Quo(sdk.NewDec(y))
+
+	for _, ovc := range cva.OriginalVesting {
+		vestedAmt := s.MulInt(ovc.Amount).TruncateInt()
+		if !vestedAmt.IsPositive() {
+			continue
+		}
+		vestedCoins = vestedCoins.Add(sdk.Coins{sdk.NewCoin(ovc.Denom, vestedAmt)})
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// continuous vesting account.
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting and delegated free. The delegated
+// coins are subtracted from the account by the caller afterwards.
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a continuous vesting
+// account.
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Validate checks the vesting schedule of the account
+func (cva ContinuousVestingAccount) Validate() error {
+	if cva.BaseVestingAccount == nil {
+		return errors.New("base vesting account cannot be empty")
+	}
+	if cva.StartTime >= cva.EndTime {
+		return fmt.Errorf("vesting start time [%d] must be before end time [%d]", cva.StartTime, cva.EndTime)
+	}
+	return cva.BaseVestingAccount.Validate()
+}
+
+//-----------------------------------------------------------
+// DelayedVestingAccount
+
+var _ VestingAccount = (*DelayedVestingAccount)(nil)
+
+// DelayedVestingAccount implements the VestingAccount interface. It vests all
+// coins after a specific time, but non prior. In other words, it keeps them
+// locked until a specified time.
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccount returns a DelayedVestingAccount which unlocks all the
+// coins of the given base account at endTime
+func NewDelayedVestingAccount(baseAcc *BaseAccount, endTime int64) *DelayedVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         endTime,
+	}
+
+	return &DelayedVestingAccount{baseVestingAcc}
+}
+
+// String implements fmt.Stringer
+func (dva DelayedVestingAccount) String() string {
+	return dva.BaseAccount.String() + dva.scheduleString("delayed", "-", sdk.Coins.String)
+}
+
+// String implements human.Stringer
+func (dva DelayedVestingAccount) HumanString(converter sdk.CoinsConverter) string {
+	return dva.BaseAccount.HumanString(converter) + dva.scheduleString("delayed", "-", converter.ToMainUnit)
+}
+
+// GetVestedCoins returns the total amount of vested coins for a delayed vesting
+// account. All coins are only vested once the schedule has elapsed.
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+
+	return nil
+}
+
+// GetVestingCoins returns the total number of vesting coins for a delayed
+// vesting account.
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins for a delayed
+// vesting account.
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting and delegated free. The delegated
+// coins are subtracted from the account by the caller afterwards.
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns zero since a delayed vesting account has no start time.
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// Validate checks the vesting schedule of the account
+func (dva DelayedVestingAccount) Validate() error {
+	if dva.BaseVestingAccount == nil {
+		return errors.New("base vesting account cannot be empty")
+	}
+	if dva.EndTime <= 0 {
+		return fmt.Errorf("invalid vesting end time [%d]", dva.EndTime)
+	}
+	return dva.BaseVestingAccount.Validate()
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var (
+	stakeDenom = sdk.IrisAtto
+	feeDenom   = "fee-min"
+)
+
+func newVestingBaseAccount(coins sdk.Coins) *BaseAccount {
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(coins)
+	return &bacc
+}
+
+func TestGetVestedCoinsContVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	cva := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+
+	// require no coins vested in the very beginning of the vesting schedule
+	vestedCoins := cva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = cva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+
+	// require 50% of coins vested
+	vestedCoins = cva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 100% of coins vested
+	vestedCoins = cva.GetVestedCoins(now.Add(48 * time.Hour))
+	require.Equal(t, origCoins, vestedCoins)
+}
+
+func TestSpendableCoinsContVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	cva := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+
+	// require that there exist no spendable coins in the beginning of the
+	// vesting schedule
+	spendableCoins := cva.SpendableCoins(now)
+	require.Nil(t, spendableCoins)
+
+	// require that all original coins are spendable at the end of the vesting
+	// schedule
+	spendableCoins = cva.SpendableCoins(endTime)
+	require.Equal(t, origCoins, spendableCoins)
+
+	// require that all vested coins (50%) are spendable
+	spendableCoins = cva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, spendableCoins)
+
+	// receive some coins
+	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	cva.SetCoins(cva.GetCoins().Add(recvAmt))
+
+	// require that all vested coins (50%) are spendable plus any received
+	spendableCoins = cva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 100)}, spendableCoins)
+}
+
+func TestTrackDelegationContVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	// require the ability to delegate all vesting coins
+	cva := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now, origCoins)
+	require.Equal(t, origCoins, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	// require the ability to delegate all vested coins
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.TrackDelegation(endTime, origCoins)
+	require.Nil(t, cva.DelegatedVesting)
+	require.Equal(t, origCoins, cva.DelegatedFree)
+
+	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	cva.SetCoins(cva.GetCoins().Sub(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// require no modifications when delegation amount is zero or not enough funds
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	require.Panics(t, func() {
+		cva.TrackDelegation(endTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+	})
+	require.Nil(t, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+}
+
+func TestTrackUndelegationContVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	// require the ability to undelegate all vesting coins
+	cva := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now, origCoins)
+	cva.TrackUndelegation(origCoins)
+	require.Nil(t, cva.DelegatedFree)
+	require.Nil(t, cva.DelegatedVesting)
+
+	// require the ability to undelegate all vested coins
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.TrackDelegation(endTime, origCoins)
+	cva.TrackUndelegation(origCoins)
+	require.Nil(t, cva.DelegatedFree)
+	require.Nil(t, cva.DelegatedVesting)
+
+	// vest 50% and delegate to two validators
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	cva.SetCoins(cva.GetCoins().Sub(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+
+	// undelegate from one validator that got slashed 50%, the delegated free
+	// coins are released first
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+
+	// undelegate from the other validator that did not get slashed
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Nil(t, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+}
+
+func TestGetVestedCoinsDelVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	dva := NewDelayedVestingAccount(newVestingBaseAccount(origCoins), endTime.Unix())
+
+	// require no coins are vested until schedule maturation
+	vestedCoins := dva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	vestedCoins = dva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Nil(t, vestedCoins)
+
+	// require all coins be vested at schedule maturation
+	vestedCoins = dva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+}
+
+func TestSpendableCoinsDelVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	dva := NewDelayedVestingAccount(newVestingBaseAccount(origCoins), endTime.Unix())
+
+	// require that no coins are spendable before schedule maturation
+	spendableCoins := dva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Nil(t, spendableCoins)
+
+	// require that all coins are spendable after maturation
+	spendableCoins = dva.SpendableCoins(endTime)
+	require.Equal(t, origCoins, spendableCoins)
+
+	// receive some coins
+	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	dva.SetCoins(dva.GetCoins().Add(recvAmt))
+
+	// require that only received coins are spendable before maturation
+	spendableCoins = dva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Equal(t, recvAmt, spendableCoins)
+
+	// delegate some coins, which are tracked as delegated vesting first and
+	// leave the received coins spendable
+	dva.TrackDelegation(now.Add(12*time.Hour), recvAmt)
+	dva.SetCoins(dva.GetCoins().Sub(recvAmt))
+	require.Equal(t, recvAmt, dva.DelegatedVesting)
+	require.Nil(t, dva.DelegatedFree)
+	require.Equal(t, recvAmt, dva.SpendableCoins(now.Add(12*time.Hour)))
+}
+
+func TestValidateVestingAcc(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	cva := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	require.Nil(t, cva.Validate())
+
+	// the start time must be before the end time
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), endTime.Unix(), now.Unix())
+	require.NotNil(t, cva.Validate())
+
+	// the original vesting coins can not exceed the account coins
+	cva = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	cva.SetCoins(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.NotNil(t, cva.Validate())
+
+	// delegated coins are counted in
+	cva.DelegatedVesting = sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	require.Nil(t, cva.Validate())
+
+	dva := NewDelayedVestingAccount(newVestingBaseAccount(origCoins), endTime.Unix())
+	require.Nil(t, dva.Validate())
+
+	dva = NewDelayedVestingAccount(newVestingBaseAccount(sdk.Coins{}), endTime.Unix())
+	require.NotNil(t, dva.Validate())
+}
+
+func TestVestingAccountCodec(t *testing.T) {
+	cdc := codec.New()
+	RegisterBaseAccount(cdc)
+
+	now := time.Now()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	var acc Account = NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), now.Add(time.Hour).Unix())
+	bz := cdc.MustMarshalBinaryBare(acc)
+
+	var decoded Account
+	cdc.MustUnmarshalBinaryBare(bz, &decoded)
+	require.Equal(t, acc, decoded)
+
+	vacc, ok := decoded.(VestingAccount)
+	require.True(t, ok)
+	require.Equal(t, origCoins, vacc.GetOriginalVesting())
+}
@@ -28,6 +28,8 @@ type Keeper interface {
 	SendKeeper
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 	BurnCoins(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
 	SetMemoRegexp(ctx sdk.Context, fromAddr sdk.AccAddress, regexp string) (sdk.Tags, sdk.Error)
 	IncreaseLoosenToken(ctx sdk.Context, amt sdk.Coins)
@@ -93,6 +95,22 @@ func (keeper BaseKeeper) AddCoins(
 	return addCoins(ctx, keeper.am, addr, amt)
 }
 
+// DelegateCoins subtracts the bonded amt from the coins at the addr, tracking
+// the delegated vesting coins if the account is a vesting account.
+func (keeper BaseKeeper) DelegateCoins(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+	return delegateCoins(ctx, keeper.am, addr, amt)
+}
+
+// UndelegateCoins adds the unbonded amt to the coins at the addr, releasing
+// the delegated vesting coins if the account is a vesting account.
+func (keeper BaseKeeper) UndelegateCoins(
+	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Tags, sdk.Error) {
+	return undelegateCoins(ctx, keeper.am, addr, amt)
+}
+
 // SendCoins moves coins from one account to another
 func (keeper BaseKeeper) SendCoins(
 	ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins,
@@ -338,17 +356,31 @@ func hasCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 	return getCoins(ctx, am, addr).IsAllGTE(amt)
 }
 
+// getSpendableCoins returns the coins at the addr together with the part of
+// them which is not locked by a vesting schedule.
+func getSpendableCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress) (coins, spendableCoins sdk.Coins) {
+	ctx.GasMeter().ConsumeGas(costGetCoins, "getCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.Coins{}, sdk.Coins{}
+	}
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		return acc.GetCoins(), vacc.SpendableCoins(ctx.BlockHeader().Time)
+	}
+	return acc.GetCoins(), acc.GetCoins()
+}
+
 // SubtractCoins subtracts amt from the coins at the addr.
 func subtractCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
 	if !amt.IsValid() {
 		panic(fmt.Sprintf("invalid coins [%s]", amt))
 	}
 	ctx.GasMeter().ConsumeGas(costSubtractCoins, "subtractCoins")
-	oldCoins := getCoins(ctx, am, addr)
-	newCoins, hasNeg := oldCoins.SafeSub(amt)
-	if hasNeg {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("subtracting [%s] from [%s] yields negative coin(s)", amt, oldCoins))
+	oldCoins, spendableCoins := getSpendableCoins(ctx, am, addr)
+	if _, hasNeg := spendableCoins.SafeSub(amt); hasNeg {
+		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("subtracting [%s] from [%s] yields negative coin(s)", amt, spendableCoins))
 	}
+	newCoins := oldCoins.Sub(amt)
 	err := setCoins(ctx, am, addr, newCoins)
 	tags := sdk.NewTags("sender", []byte(addr.String()))
 	return newCoins, tags, err
@@ -381,6 +413,59 @@ func addCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 	return newCoins, tags, err
 }
 
+// delegateCoins subtracts the bonded amt from the coins at the addr. Vesting
+// coins can be delegated, so the whole balance is checked instead of the
+// spendable coins.
+func delegateCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	if !amt.IsValid() {
+		panic(fmt.Sprintf("invalid coins [%s]", amt))
+	}
+	ctx.GasMeter().ConsumeGas(costSubtractCoins, "subtractCoins")
+	oldCoins := getCoins(ctx, am, addr)
+	newCoins, hasNeg := oldCoins.SafeSub(amt)
+	if hasNeg {
+		return nil, sdk.ErrInsufficientCoins(fmt.Sprintf("delegating [%s] from [%s] yields negative coin(s)", amt, oldCoins))
+	}
+
+	ctx.GasMeter().ConsumeGas(costSetCoins, "setCoins")
+	acc := am.GetAccount(ctx, addr)
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	}
+	if err := acc.SetCoins(newCoins); err != nil {
+		panic(err)
+	}
+	am.SetAccount(ctx, acc)
+
+	tags := sdk.NewTags("sender", []byte(addr.String()))
+	return tags, nil
+}
+
+// undelegateCoins adds the unbonded amt to the coins at the addr
+func undelegateCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	if !amt.IsValid() {
+		panic(fmt.Sprintf("invalid coins [%s]", amt))
+	}
+	ctx.GasMeter().ConsumeGas(costAddCoins, "addCoins")
+	ctx.GasMeter().ConsumeGas(costGetCoins, "getCoins")
+	ctx.GasMeter().ConsumeGas(costSetCoins, "setCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = am.NewAccountWithAddress(ctx, addr)
+	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+	}
+	if err := acc.SetCoins(acc.GetCoins().Add(amt)); err != nil {
+		panic(err)
+	}
+	am.SetAccount(ctx, acc)
+
+	tags := sdk.NewTags("recipient", []byte(addr.String()))
+	return tags, nil
+}
+
 // SendCoins moves coins from one account to another
 // NOTE: Make sure to revert state changes from tx on error
 func sendCoins(ctx sdk.Context, am auth.AccountKeeper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
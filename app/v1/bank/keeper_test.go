@@ -2,6 +2,7 @@ package bank
 
 import (
 	"testing"
+	"time"
 
 	"github.com/irisnet/irishub/app/v1/auth"
 	codec "github.com/irisnet/irishub/codec"
@@ -209,3 +210,108 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foo-min", 15)}))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("bar-min", 5)}))
 }
+
+func TestVestingAccountSend(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: now}, false, log.NewNopLogger())
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	bankKeeper := NewBaseKeeper(cdc, accountKeeper)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin("foo-min", 100)}
+	sendCoins := sdk.Coins{sdk.NewInt64Coin("foo-min", 50)}
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	bacc := auth.NewBaseAccountWithAddress(addr1)
+	bacc.SetCoins(origCoins)
+	vacc := auth.NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	accountKeeper.SetAccount(ctx, vacc)
+
+	// require that no coins be sendable at the beginning of the vesting schedule
+	_, err := bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins)
+	require.Error(t, err)
+
+	// receive some coins
+	bankKeeper.AddCoins(ctx, addr1, sendCoins)
+
+	// require that only the received coins are sendable
+	_, err = bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins.Add(sdk.Coins{sdk.NewInt64Coin("foo-min", 1)}))
+	require.Error(t, err)
+	_, err = bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr1).IsEqual(origCoins))
+
+	// require that all vested coins are sendable after half of the schedule
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now.Add(12 * time.Hour)})
+	_, err = bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr1).IsEqual(sendCoins))
+	require.True(t, bankKeeper.GetCoins(ctx, addr2).IsEqual(origCoins))
+
+	_, _, err = bankKeeper.SubtractCoins(ctx, addr1, sdk.Coins{sdk.NewInt64Coin("foo-min", 1)})
+	require.Error(t, err)
+}
+
+func TestVestingAccountDelegation(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: now}, false, log.NewNopLogger())
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	bankKeeper := NewBaseKeeper(cdc, accountKeeper)
+
+	origCoins := sdk.Coins{sdk.NewInt64Coin("foo-min", 100)}
+	delCoins := sdk.Coins{sdk.NewInt64Coin("foo-min", 50)}
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	bacc := auth.NewBaseAccountWithAddress(addr1)
+	bacc.SetCoins(origCoins)
+	accountKeeper.SetAccount(ctx, auth.NewDelayedVestingAccount(&bacc, endTime.Unix()))
+
+	// require the ability for a vesting account to delegate vesting coins
+	_, err := bankKeeper.DelegateCoins(ctx, addr1, delCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr1).IsEqual(delCoins))
+
+	vacc := accountKeeper.GetAccount(ctx, addr1).(auth.VestingAccount)
+	require.True(t, vacc.GetDelegatedVesting().IsEqual(delCoins))
+	require.True(t, vacc.GetDelegatedFree().IsZero())
+
+	// require that delegating more than the account holds fails
+	_, err = bankKeeper.DelegateCoins(ctx, addr1, origCoins)
+	require.Error(t, err)
+
+	// require that the undelegated coins are locked again
+	_, err = bankKeeper.UndelegateCoins(ctx, addr1, delCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr1).IsEqual(origCoins))
+
+	vacc = accountKeeper.GetAccount(ctx, addr1).(auth.VestingAccount)
+	require.True(t, vacc.GetDelegatedVesting().IsZero())
+
+	_, err = bankKeeper.SendCoins(ctx, addr1, addr2, delCoins)
+	require.Error(t, err)
+
+	// require that a base account delegates and undelegates as usual
+	_, err = bankKeeper.DelegateCoins(ctx, addr2, delCoins)
+	require.Error(t, err)
+	bankKeeper.AddCoins(ctx, addr2, origCoins)
+	_, err = bankKeeper.DelegateCoins(ctx, addr2, delCoins)
+	require.NoError(t, err)
+	_, err = bankKeeper.UndelegateCoins(ctx, addr2, delCoins)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr2).IsEqual(origCoins))
+}
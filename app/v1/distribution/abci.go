@@ -9,7 +9,7 @@ import (
 )
 
 // set the proposer for determining distribution during endblock
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) (tags sdk.Tags) {
 	ctx = ctx.WithLogger(ctx.Logger().With("handler", "beginBlock").With("module", "iris/distribution"))
 	if ctx.BlockHeight() > 1 {
 		previousPercentPrecommitVotes := getPreviousPercentPrecommitVotes(req)
@@ -17,9 +17,12 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		previousProposer := k.GetPreviousProposerConsAddr(ctx)
 		k.AllocateTokens(ctx, previousPercentPrecommitVotes, previousProposer)
 	}
+	k.PayBudgetStreams(ctx)
+	tags = k.RestakeRewards(ctx)
 
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+	return tags
 }
 
 // percent precommit votes for the previous block
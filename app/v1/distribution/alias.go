@@ -23,6 +23,13 @@ type (
 	MsgWithdrawDelegatorRewardsAll = types.MsgWithdrawDelegatorRewardsAll
 	MsgWithdrawDelegatorReward     = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorRewardsAll = types.MsgWithdrawValidatorRewardsAll
+	MsgCancelBudgetStream          = types.MsgCancelBudgetStream
+	BudgetStream                   = types.BudgetStream
+	BudgetStreamOutput             = keeper.BudgetStreamOutput
+	BudgetStreamOutputs            = keeper.BudgetStreamOutputs
+	MsgSetRestake                  = types.MsgSetRestake
+	MsgRemoveRestake               = types.MsgRemoveRestake
+	RestakeConfig                  = types.RestakeConfig
 
 	GenesisState = types.GenesisState
 
@@ -30,6 +37,7 @@ type (
 	StakeKeeper         = types.StakeKeeper
 	BankKeeper          = types.BankKeeper
 	FeeCollectionKeeper = types.FeeKeeper
+	GuardianKeeper      = types.GuardianKeeper
 )
 
 var (
@@ -60,12 +68,18 @@ var (
 	NewMsgWithdrawDelegatorRewardsAll = types.NewMsgWithdrawDelegatorRewardsAll
 	NewMsgWithdrawDelegatorReward     = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorRewardsAll = types.NewMsgWithdrawValidatorRewardsAll
+	NewMsgCancelBudgetStream          = types.NewMsgCancelBudgetStream
+	ValidateBudgetStream              = types.ValidateBudgetStream
+	NewMsgSetRestake                  = types.NewMsgSetRestake
+	NewMsgRemoveRestake               = types.NewMsgRemoveRestake
+	ValidateRestakeConfig             = types.ValidateRestakeConfig
 
 	NewQuerier                       = keeper.NewQuerier
 	NewQueryDelegatorParams          = keeper.NewQueryDelegatorParams
 	NewQueryDelegationDistInfoParams = keeper.NewQueryDelegationDistInfoParams
 	NewQueryValidatorDistInfoParams  = keeper.NewQueryValidatorDistInfoParams
 	NewQueryRewardsParams            = keeper.NewQueryRewardsParams
+	NewQueryBudgetStreamsParams      = keeper.NewQueryBudgetStreamsParams
 
 	NewTotalAccum = types.NewTotalAccum
 )
@@ -81,6 +95,8 @@ const (
 	QueryAllDelegationDistInfo = keeper.QueryAllDelegationDistInfo
 	QueryValidatorDistInfo     = keeper.QueryValidatorDistInfo
 	QueryRewards               = keeper.QueryRewards
+	QueryBudgetStreams         = keeper.QueryBudgetStreams
+	QueryRestakeConfig         = keeper.QueryRestakeConfig
 )
 
 var (
@@ -88,10 +104,21 @@ var (
 	ErrNilWithdrawAddr  = types.ErrNilWithdrawAddr
 	ErrNilValidatorAddr = types.ErrNilValidatorAddr
 
+	ErrInvalidBudgetStream = types.ErrInvalidBudgetStream
+	ErrUnknownBudgetStream = types.ErrUnknownBudgetStream
+	ErrUnauthorizedCancel  = types.ErrUnauthorizedCancel
+
+	ErrInvalidRestakeConfig = types.ErrInvalidRestakeConfig
+	ErrNoRestakeConfig      = types.ErrNoRestakeConfig
+
 	ActionModifyWithdrawAddress       = tags.ActionModifyWithdrawAddress
 	ActionWithdrawDelegatorRewardsAll = tags.ActionWithdrawDelegatorRewardsAll
 	ActionWithdrawDelegatorReward     = tags.ActionWithdrawDelegatorReward
 	ActionWithdrawValidatorRewardsAll = tags.ActionWithdrawValidatorRewardsAll
+	ActionCancelBudgetStream          = tags.ActionCancelBudgetStream
+	ActionSetRestake                  = tags.ActionSetRestake
+	ActionRemoveRestake               = tags.ActionRemoveRestake
+	ActionRestake                     = tags.ActionRestake
 
 	TagAction    = tags.Action
 	TagValidator = tags.Validator
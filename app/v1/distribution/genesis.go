@@ -29,6 +29,31 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetDelegatorWithdrawAddr(ctx, dw.DelegatorAddr, dw.WithdrawAddr)
 	}
 	keeper.SetPreviousProposerConsAddr(ctx, data.PreviousProposer)
+
+	var nextStreamID uint64 = 1
+	for _, stream := range data.BudgetStreams {
+		if err := types.ValidateBudgetStream(stream.Recipient, stream.Total, stream.StartHeight, stream.EndHeight, stream.TrancheInterval); err != nil {
+			panic(err.Error())
+		}
+		if !stream.Total.IsAllGTE(stream.Paid) {
+			panic(fmt.Sprintf("paid amount(%s) of budget stream %d exceeds its total(%s)", stream.Paid, stream.ID, stream.Total))
+		}
+		keeper.SetBudgetStream(ctx, stream)
+		if stream.ID >= nextStreamID {
+			nextStreamID = stream.ID + 1
+		}
+	}
+	keeper.SetNextBudgetStreamID(ctx, nextStreamID)
+
+	for _, config := range data.RestakeConfigs {
+		if config.DelegatorAddr.Empty() {
+			panic("delegator address of restake config is empty")
+		}
+		if err := types.ValidateRestakeConfig(config.Validators, config.MinReward); err != nil {
+			panic(err.Error())
+		}
+		keeper.SetRestakeConfig(ctx, config)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper. The
@@ -40,5 +65,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	ddis := keeper.GetAllDelegationDistInfos(ctx)
 	dwis := keeper.GetAllDelegatorWithdrawInfos(ctx)
 	pp := keeper.GetPreviousProposerConsAddr(ctx)
-	return NewGenesisState(params, feePool, vdis, ddis, dwis, pp)
+	streams := keeper.GetAllBudgetStreams(ctx)
+	restakes := keeper.GetAllRestakeConfigs(ctx)
+	return NewGenesisState(params, feePool, vdis, ddis, dwis, pp, streams, restakes)
 }
@@ -1,6 +1,8 @@
 package distribution
 
 import (
+	"strconv"
+
 	"github.com/irisnet/irishub/app/v1/distribution/keeper"
 	"github.com/irisnet/irishub/app/v1/distribution/tags"
 	"github.com/irisnet/irishub/app/v1/distribution/types"
@@ -19,6 +21,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 		case types.MsgWithdrawValidatorRewardsAll:
 			return handleMsgWithdrawValidatorRewardsAll(ctx, msg, k)
+		case types.MsgCancelBudgetStream:
+			return handleMsgCancelBudgetStream(ctx, msg, k)
+		case types.MsgSetRestake:
+			return handleMsgSetRestake(ctx, msg, k)
+		case types.MsgRemoveRestake:
+			return handleMsgRemoveRestake(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in distribution module").Result()
 		}
@@ -95,3 +103,39 @@ func handleMsgWithdrawValidatorRewardsAll(ctx sdk.Context, msg types.MsgWithdraw
 		Tags: resultTags,
 	}
 }
+
+func handleMsgCancelBudgetStream(ctx sdk.Context, msg types.MsgCancelBudgetStream, k keeper.Keeper) sdk.Result {
+	stream, err := k.CancelBudgetStream(ctx, msg.StreamID, msg.Canceller)
+	if err != nil {
+		return err.Result()
+	}
+	resultTags := sdk.NewTags(
+		tags.StreamID, []byte(strconv.FormatUint(stream.ID, 10)),
+		tags.Recipient, []byte(stream.Recipient.String()),
+	)
+	return sdk.Result{
+		Tags: resultTags,
+	}
+}
+
+func handleMsgSetRestake(ctx sdk.Context, msg types.MsgSetRestake, k keeper.Keeper) sdk.Result {
+	k.SetRestake(ctx, msg.DelegatorAddr, msg.Validators, msg.MinReward)
+	resultTags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: resultTags,
+	}
+}
+
+func handleMsgRemoveRestake(ctx sdk.Context, msg types.MsgRemoveRestake, k keeper.Keeper) sdk.Result {
+	if err := k.RemoveRestake(ctx, msg.DelegatorAddr); err != nil {
+		return err.Result()
+	}
+	resultTags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: resultTags,
+	}
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/distribution/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// get a budget stream
+func (k Keeper) GetBudgetStream(ctx sdk.Context, id uint64) (stream types.BudgetStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetBudgetStreamKey(id))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &stream)
+	return stream, true
+}
+
+// set a budget stream
+func (k Keeper) SetBudgetStream(ctx sdk.Context, stream types.BudgetStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(GetBudgetStreamKey(stream.ID), b)
+}
+
+// remove a budget stream
+func (k Keeper) RemoveBudgetStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetBudgetStreamKey(id))
+}
+
+// iterate over all the active budget streams in the order of their ids
+func (k Keeper) IterateBudgetStreams(ctx sdk.Context, fn func(stream types.BudgetStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, BudgetStreamKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.BudgetStream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stream)
+		if fn(stream) {
+			break
+		}
+	}
+}
+
+// get all the active budget streams
+func (k Keeper) GetAllBudgetStreams(ctx sdk.Context) (streams []types.BudgetStream) {
+	k.IterateBudgetStreams(ctx, func(stream types.BudgetStream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// get the id of the next budget stream
+func (k Keeper) GetNextBudgetStreamID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(NextBudgetStreamIDKey)
+	if b == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &id)
+	return id
+}
+
+// set the id of the next budget stream
+func (k Keeper) SetNextBudgetStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	store.Set(NextBudgetStreamIDKey, b)
+}
+
+// create a budget stream funded by the community tax pool
+func (k Keeper) CreateBudgetStream(ctx sdk.Context, proposalID uint64, recipient sdk.AccAddress, total sdk.Coins,
+	startHeight, endHeight, trancheInterval int64) (types.BudgetStream, sdk.Error) {
+
+	if err := types.ValidateBudgetStream(recipient, total, startHeight, endHeight, trancheInterval); err != nil {
+		return types.BudgetStream{}, err
+	}
+
+	id := k.GetNextBudgetStreamID(ctx)
+	stream := types.NewBudgetStream(id, proposalID, recipient, total, startHeight, endHeight, trancheInterval)
+	k.SetBudgetStream(ctx, stream)
+	k.SetNextBudgetStreamID(ctx, id+1)
+
+	ctx.Logger().Info("Create budget stream", "id", id, "proposal_id", proposalID, "recipient", recipient.String(),
+		"total", total.String(), "start_height", startHeight, "end_height", endHeight, "tranche_interval", trancheInterval)
+	return stream, nil
+}
+
+// cancel an active budget stream, the unpaid amount stays in the community tax pool.
+// Only the trustees and the gov module account are allowed to cancel a stream
+func (k Keeper) CancelBudgetStream(ctx sdk.Context, id uint64, canceller sdk.AccAddress) (types.BudgetStream, sdk.Error) {
+	if !canceller.Equals(auth.GovModuleAccAddr) {
+		if _, found := k.gk.GetTrustee(ctx, canceller); !found {
+			return types.BudgetStream{}, types.ErrUnauthorizedCancel(k.codespace, canceller)
+		}
+	}
+
+	stream, found := k.GetBudgetStream(ctx, id)
+	if !found {
+		return types.BudgetStream{}, types.ErrUnknownBudgetStream(k.codespace, id)
+	}
+	k.RemoveBudgetStream(ctx, id)
+
+	ctx.Logger().Info("Cancel budget stream", "id", id, "canceller", canceller.String(), "remaining", stream.Remaining().String())
+	return stream, nil
+}
+
+// pay the amount due of all the active budget streams from the community tax pool,
+// the streams which have been fully paid are removed
+func (k Keeper) PayBudgetStreams(ctx sdk.Context) {
+	logger := ctx.Logger()
+	height := ctx.BlockHeight()
+
+	var streams []types.BudgetStream
+	k.IterateBudgetStreams(ctx, func(stream types.BudgetStream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+
+	for _, stream := range streams {
+		due := stream.DueAt(height)
+		if due.IsZero() {
+			continue
+		}
+
+		// the payout is postponed until the pool holds enough funds, the amount
+		// due keeps accumulating meanwhile
+		taxCoins := k.bankKeeper.GetCoins(ctx, auth.CommunityTaxCoinsAccAddr)
+		if !taxCoins.IsAllGTE(due) {
+			logger.Info("Insufficient community tax fund for budget stream", "id", stream.ID,
+				"due", due.String(), "community_tax_fund", taxCoins.String())
+			continue
+		}
+
+		if _, err := k.bankKeeper.SendCoins(ctx, auth.CommunityTaxCoinsAccAddr, stream.Recipient, due); err != nil {
+			panic(err)
+		}
+		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, "", stream.Recipient.String(), due.String(), sdk.CommunityTaxUseFlow, "")
+
+		stream.Paid = stream.Paid.Add(due)
+		if stream.IsFinished() {
+			k.RemoveBudgetStream(ctx, stream.ID)
+			logger.Info("Budget stream finished", "id", stream.ID, "recipient", stream.Recipient.String(), "paid", stream.Paid.String())
+			continue
+		}
+		k.SetBudgetStream(ctx, stream)
+		logger.Info("Pay budget stream", "id", stream.ID, "recipient", stream.Recipient.String(),
+			"amount", due.String(), "remaining", stream.Remaining().String())
+	}
+}
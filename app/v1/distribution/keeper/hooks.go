@@ -49,6 +49,17 @@ func (k Keeper) onValidatorPowerDidChange(ctx sdk.Context, valAddr sdk.ValAddres
 	}
 }
 
+// Track the previous proposer by its new consensus address once its consensus key is rotated
+func (k Keeper) onValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(ProposerKey) {
+		return
+	}
+	if k.GetPreviousProposerConsAddr(ctx).Equals(oldConsAddr) {
+		k.SetPreviousProposerConsAddr(ctx, newConsAddr)
+	}
+}
+
 // Withdrawal all validator distribution rewards and cleanup the distribution record
 func (k Keeper) onValidatorRemoved(ctx sdk.Context, valAddr sdk.ValAddress) {
 	k.RemoveValidatorDistInfo(ctx, valAddr)
@@ -135,3 +146,6 @@ func (h Hooks) OnValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk
 func (h Hooks) OnValidatorPowerDidChange(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
 	h.k.onValidatorPowerDidChange(ctx, valAddr)
 }
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, _ sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	h.k.onValidatorConsPubKeyRotated(ctx, oldConsAddr, newConsAddr)
+}
@@ -16,6 +16,7 @@ type Keeper struct {
 	bankKeeper  types.BankKeeper
 	stakeKeeper types.StakeKeeper
 	feeKeeper   types.FeeKeeper
+	gk          types.GuardianKeeper
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -24,7 +25,7 @@ type Keeper struct {
 }
 
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ck types.BankKeeper,
-	sk types.StakeKeeper, fk types.FeeKeeper, codespace sdk.CodespaceType, metrics *Metrics) Keeper {
+	sk types.StakeKeeper, fk types.FeeKeeper, gk types.GuardianKeeper, codespace sdk.CodespaceType, metrics *Metrics) Keeper {
 
 	keeper := Keeper{
 		storeKey:    key,
@@ -33,12 +34,19 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, c
 		bankKeeper:  ck,
 		stakeKeeper: sk,
 		feeKeeper:   fk,
+		gk:          gk,
 		codespace:   codespace,
 		metrics:     metrics,
 	}
 	return keeper
 }
 
+// WithMetrics returns a copy of the keeper reporting to the metrics
+func (k Keeper) WithMetrics(metrics *Metrics) Keeper {
+	k.metrics = metrics
+	return k
+}
+
 //______________________________________________________________________
 
 // get the global fee pool distribution info
@@ -11,6 +11,10 @@ var (
 	DelegationDistInfoKey    = []byte{0x02} // prefix for each key to a delegation distribution
 	DelegatorWithdrawInfoKey = []byte{0x03} // prefix for each key to a delegator withdraw info
 	ProposerKey              = []byte{0x04} // key for storing the proposer operator address
+	BudgetStreamKey          = []byte{0x05} // prefix for each key to a budget stream
+	NextBudgetStreamIDKey    = []byte{0x06} // key for the id of the next budget stream
+	RestakeConfigKey         = []byte{0x07} // prefix for each key to a delegator restake config
+	RestakeCursorKey         = []byte{0x08} // key for the last delegator whose restake config was processed
 )
 
 const (
@@ -48,3 +52,15 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	}
 	return sdk.AccAddress(addr)
 }
+
+// gets the key for the budget stream with the given id
+// VALUE: distribution/types.BudgetStream
+func GetBudgetStreamKey(id uint64) []byte {
+	return append(BudgetStreamKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the key for the restake config of a delegator
+// VALUE: distribution/types.RestakeConfig
+func GetRestakeConfigKey(delAddr sdk.AccAddress) []byte {
+	return append(RestakeConfigKey, delAddr.Bytes()...)
+}
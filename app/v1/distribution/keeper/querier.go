@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/irisnet/irishub/app/v1/distribution/types"
 	"github.com/irisnet/irishub/codec"
@@ -17,6 +18,8 @@ const (
 	QueryAllDelegationDistInfo = "all_delegation_dist_info"
 	QueryValidatorDistInfo     = "validator_dist_info"
 	QueryRewards               = "rewards"
+	QueryBudgetStreams         = "budget_streams"
+	QueryRestakeConfig         = "restake_config"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -37,13 +40,19 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryRewards:
 			return queryRewards(ctx, path[1:], req, k)
 
+		case QueryBudgetStreams:
+			return queryBudgetStreams(ctx, path[1:], req, k)
+
+		case QueryRestakeConfig:
+			return queryRestakeConfig(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
 	}
 }
 
-// params for query 'custom/distr/delegation_dist_info', 'custom/distr/all_delegation_dist_info' and 'withdraw_addr'
+// params for query 'custom/distr/delegation_dist_info', 'custom/distr/all_delegation_dist_info', 'withdraw_addr' and 'restake_config'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
 }
@@ -281,3 +290,134 @@ func (ct CommunityTax) String() string {
 	return fmt.Sprintf(`Amount:  %s`,
 		ct.Amount.MainUnitString())
 }
+
+// params for query 'custom/distr/budget_streams', an empty recipient lists all the streams
+type QueryBudgetStreamsParams struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// creates a new instance of QueryBudgetStreamsParams
+func NewQueryBudgetStreamsParams(recipient sdk.AccAddress) QueryBudgetStreamsParams {
+	return QueryBudgetStreamsParams{
+		Recipient: recipient,
+	}
+}
+
+type BudgetStreamOutput struct {
+	ID              uint64         `json:"id"`
+	ProposalID      uint64         `json:"proposal_id"`
+	Recipient       sdk.AccAddress `json:"recipient"`
+	Total           sdk.Coins      `json:"total"`
+	Paid            sdk.Coins      `json:"paid"`
+	Remaining       sdk.Coins      `json:"remaining"`
+	StartHeight     int64          `json:"start_height"`
+	EndHeight       int64          `json:"end_height"`
+	TrancheInterval int64          `json:"tranche_interval"`
+}
+
+func NewBudgetStreamOutput(stream types.BudgetStream) BudgetStreamOutput {
+	return BudgetStreamOutput{
+		ID:              stream.ID,
+		ProposalID:      stream.ProposalID,
+		Recipient:       stream.Recipient,
+		Total:           stream.Total,
+		Paid:            stream.Paid,
+		Remaining:       stream.Remaining(),
+		StartHeight:     stream.StartHeight,
+		EndHeight:       stream.EndHeight,
+		TrancheInterval: stream.TrancheInterval,
+	}
+}
+
+func (bo BudgetStreamOutput) String() string {
+	return fmt.Sprintf(`BudgetStream %d:
+  Proposal ID:       %d
+  Recipient:         %s
+  Total:             %s
+  Paid:              %s
+  Remaining:         %s
+  Start Height:      %d
+  End Height:        %d
+  Tranche Interval:  %d`,
+		bo.ID, bo.ProposalID, bo.Recipient, bo.Total, bo.Paid, bo.Remaining,
+		bo.StartHeight, bo.EndHeight, bo.TrancheInterval)
+}
+
+func (bo BudgetStreamOutput) HumanString(converter sdk.CoinsConverter) string {
+	return fmt.Sprintf(`BudgetStream %d:
+  Proposal ID:       %d
+  Recipient:         %s
+  Total:             %s
+  Paid:              %s
+  Remaining:         %s
+  Start Height:      %d
+  End Height:        %d
+  Tranche Interval:  %d`,
+		bo.ID, bo.ProposalID, bo.Recipient, converter.ToMainUnit(bo.Total), converter.ToMainUnit(bo.Paid),
+		converter.ToMainUnit(bo.Remaining), bo.StartHeight, bo.EndHeight, bo.TrancheInterval)
+}
+
+type BudgetStreamOutputs []BudgetStreamOutput
+
+func (bos BudgetStreamOutputs) String() string {
+	if len(bos) == 0 {
+		return "[]"
+	}
+	var streams []string
+	for _, bo := range bos {
+		streams = append(streams, bo.String())
+	}
+	return strings.Join(streams, "\n")
+}
+
+func (bos BudgetStreamOutputs) HumanString(converter sdk.CoinsConverter) string {
+	if len(bos) == 0 {
+		return "[]"
+	}
+	var streams []string
+	for _, bo := range bos {
+		streams = append(streams, bo.HumanString(converter))
+	}
+	return strings.Join(streams, "\n")
+}
+
+func queryBudgetStreams(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBudgetStreamsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	streams := BudgetStreamOutputs{}
+	k.IterateBudgetStreams(ctx, func(stream types.BudgetStream) bool {
+		if params.Recipient.Empty() || stream.Recipient.Equals(params.Recipient) {
+			streams = append(streams, NewBudgetStreamOutput(stream))
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, streams)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryRestakeConfig(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	config, found := k.GetRestakeConfig(ctx, params.DelegatorAddress)
+	if !found {
+		return nil, types.ErrNoRestakeConfig(types.DefaultCodespace, params.DelegatorAddress)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, config)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/irisnet/irishub/app/v1/distribution/tags"
+	"github.com/irisnet/irishub/app/v1/distribution/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// maximum number of restake configs processed per block
+const RestakeBatchSize = 20
+
+// get the restake config of a delegator
+func (k Keeper) GetRestakeConfig(ctx sdk.Context, delAddr sdk.AccAddress) (config types.RestakeConfig, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetRestakeConfigKey(delAddr))
+	if b == nil {
+		return config, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &config)
+	return config, true
+}
+
+// set the restake config of a delegator
+func (k Keeper) SetRestakeConfig(ctx sdk.Context, config types.RestakeConfig) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(config)
+	store.Set(GetRestakeConfigKey(config.DelegatorAddr), b)
+}
+
+// remove the restake config of a delegator
+func (k Keeper) RemoveRestakeConfig(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetRestakeConfigKey(delAddr))
+}
+
+// get all the restake configs, used during genesis dump
+func (k Keeper) GetAllRestakeConfigs(ctx sdk.Context) []types.RestakeConfig {
+	return k.getRestakeConfigs(ctx, RestakeConfigKey, sdk.PrefixEndBytes(RestakeConfigKey), 0)
+}
+
+// get the restake configs stored in [start, end), at most limit of them unless limit is 0
+func (k Keeper) getRestakeConfigs(ctx sdk.Context, start, end []byte, limit int) (configs []types.RestakeConfig) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && (limit == 0 || len(configs) < limit); iterator.Next() {
+		var config types.RestakeConfig
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &config)
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+// opt in the restaking of the delegator's rewards, or update its setting
+func (k Keeper) SetRestake(ctx sdk.Context, delAddr sdk.AccAddress, validators []sdk.ValAddress, minReward sdk.Int) types.RestakeConfig {
+	config := types.NewRestakeConfig(delAddr, validators, minReward)
+	if existing, found := k.GetRestakeConfig(ctx, delAddr); found {
+		config.LastRunHeight = existing.LastRunHeight
+	}
+	k.SetRestakeConfig(ctx, config)
+	return config
+}
+
+// opt out the restaking of the delegator's rewards
+func (k Keeper) RemoveRestake(ctx sdk.Context, delAddr sdk.AccAddress) sdk.Error {
+	if _, found := k.GetRestakeConfig(ctx, delAddr); !found {
+		return types.ErrNoRestakeConfig(k.codespace, delAddr)
+	}
+	k.RemoveRestakeConfig(ctx, delAddr)
+	return nil
+}
+
+// restake the rewards of the next batch of restake configs, resuming after the
+// last processed delegator and wrapping around the end of the store
+func (k Keeper) RestakeRewards(ctx sdk.Context) (resTags sdk.Tags) {
+	store := ctx.KVStore(k.storeKey)
+	start := RestakeConfigKey
+	if last := store.Get(RestakeCursorKey); last != nil {
+		start = append(GetRestakeConfigKey(sdk.AccAddress(last)), 0x00)
+	}
+
+	configs := k.getRestakeConfigs(ctx, start, sdk.PrefixEndBytes(RestakeConfigKey), RestakeBatchSize)
+	if len(configs) < RestakeBatchSize && len(start) > len(RestakeConfigKey) {
+		configs = append(configs, k.getRestakeConfigs(ctx, RestakeConfigKey, start, RestakeBatchSize-len(configs))...)
+	}
+	if len(configs) == 0 {
+		return nil
+	}
+
+	for _, config := range configs {
+		resTags = resTags.AppendTags(k.restake(ctx, config))
+		config.LastRunHeight = ctx.BlockHeight()
+		k.SetRestakeConfig(ctx, config)
+	}
+	store.Set(RestakeCursorKey, configs[len(configs)-1].DelegatorAddr.Bytes())
+	return resTags
+}
+
+// withdraw and delegate again the rewards of the delegations selected by the config
+func (k Keeper) restake(ctx sdk.Context, config types.RestakeConfig) (resTags sdk.Tags) {
+	logger := ctx.Logger()
+	delAddr := config.DelegatorAddr
+
+	var valAddrs []sdk.ValAddress
+	k.stakeKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del sdk.Delegation) (stop bool) {
+		if config.Includes(del.GetValidatorAddr()) {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+		}
+		return false
+	})
+
+	for _, valAddr := range valAddrs {
+		validator, found := k.stakeKeeper.GetValidator(ctx, valAddr)
+		if !found || validator.Jailed || !k.HasDelegationDistInfo(ctx, delAddr, valAddr) {
+			continue
+		}
+
+		// the rewards are withdrawn in a cache, discarded unless they are delegated again
+		cacheCtx, write := ctx.CacheContext()
+		reward := k.withdrawRestakeReward(cacheCtx, delAddr, valAddr)
+		if !reward.Amount.IsPositive() || reward.Amount.LT(config.MinReward) {
+			continue
+		}
+		if err := k.stakeKeeper.CheckValidatorBondedRatio(cacheCtx, validator, sdk.NewDecFromInt(reward.Amount), sdk.ZeroDec()); err != nil {
+			logger.Info("Skip restaking", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err.Error())
+			continue
+		}
+		if _, err := k.stakeKeeper.Delegate(cacheCtx, delAddr, reward, validator, true); err != nil {
+			logger.Info("Restaking failed", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err.Error())
+			continue
+		}
+		write()
+
+		logger.Info("Restake rewards", "delegator", delAddr.String(), "validator", valAddr.String(), "amount", reward.String())
+		resTags = resTags.AppendTags(sdk.NewTags(
+			tags.Action, tags.ActionRestake,
+			tags.Delegator, []byte(delAddr.String()),
+			tags.Validator, []byte(valAddr.String()),
+			tags.Reward, []byte(reward.String()),
+		))
+	}
+	return resTags
+}
+
+// withdraw the rewards of a delegation, the bond denom reward is paid to the delegator
+// to be delegated again while the other rewards are paid to the withdraw address as usual
+func (k Keeper) withdrawRestakeReward(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Coin {
+	feePool, valInfo, delInfo, withdraw := k.withdrawDelegationReward(ctx, delAddr, valAddr)
+	k.SetValidatorDistInfo(ctx, valInfo)
+	k.SetDelegationDistInfo(ctx, delInfo)
+
+	coins, change := withdraw.TruncateDecimal()
+	feePool.ValPool = feePool.ValPool.Plus(change)
+	k.SetFeePool(ctx, feePool)
+
+	bondDenom := k.stakeKeeper.BondDenom()
+	reward := sdk.NewCoin(bondDenom, coins.AmountOf(bondDenom))
+	if _, _, err := k.bankKeeper.AddCoins(ctx, delAddr, sdk.Coins{reward}); err != nil {
+		panic(err)
+	}
+	if others := coins.Sub(sdk.Coins{reward}); !others.IsZero() {
+		if _, _, err := k.bankKeeper.AddCoins(ctx, k.GetDelegatorWithdrawAddr(ctx, delAddr), others); err != nil {
+			panic(err)
+		}
+	}
+	return reward
+}
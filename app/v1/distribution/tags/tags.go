@@ -10,10 +10,16 @@ var (
 	ActionWithdrawDelegatorRewardsAll = []byte("withdraw-delegator-rewards-all")
 	ActionWithdrawDelegatorReward     = []byte("withdraw-delegator-reward")
 	ActionWithdrawValidatorRewardsAll = []byte("withdraw-validator-rewards-all")
+	ActionCancelBudgetStream          = []byte("cancel-budget-stream")
+	ActionSetRestake                  = []byte("set-restake")
+	ActionRemoveRestake               = []byte("remove-restake")
+	ActionRestake                     = []byte("restake")
 
 	Action       = sdk.TagAction
 	Validator    = sdk.TagSrcValidator
 	Delegator    = sdk.TagDelegator
 	Reward       = sdk.TagReward
 	WithdrawAddr = sdk.TagWithdrawAddr
+	StreamID     = "stream-id"
+	Recipient    = "recipient"
 )
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayBudgetStreams(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, sdk.NewInt(0))
+
+	pool := ak.NewAccountWithAddress(ctx, auth.CommunityTaxCoinsAccAddr)
+	pool.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(600))))
+	ak.SetAccount(ctx, pool)
+
+	recipient := sdk.AccAddress([]byte("recipient"))
+	total := sdk.NewCoins(sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(1000)))
+	stream, err := keeper.CreateBudgetStream(ctx, 1, recipient, total, 10, 20, 5)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), stream.ID)
+	require.Equal(t, uint64(2), keeper.GetNextBudgetStreamID(ctx))
+
+	// nothing is due before the first tranche
+	keeper.PayBudgetStreams(ctx.WithBlockHeight(14))
+	require.True(t, ak.GetAccount(ctx, recipient) == nil)
+
+	keeper.PayBudgetStreams(ctx.WithBlockHeight(15))
+	require.Equal(t, sdk.NewInt(500), ak.GetAccount(ctx, recipient).GetCoins().AmountOf(sdk.IrisAtto))
+
+	// the final tranche is postponed until the pool is refilled
+	keeper.PayBudgetStreams(ctx.WithBlockHeight(20))
+	require.Equal(t, sdk.NewInt(500), ak.GetAccount(ctx, recipient).GetCoins().AmountOf(sdk.IrisAtto))
+	stream, found := keeper.GetBudgetStream(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(500), stream.Remaining().AmountOf(sdk.IrisAtto))
+
+	pool = ak.GetAccount(ctx, auth.CommunityTaxCoinsAccAddr)
+	pool.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(600))))
+	ak.SetAccount(ctx, pool)
+	keeper.PayBudgetStreams(ctx.WithBlockHeight(21))
+	require.Equal(t, sdk.NewInt(1000), ak.GetAccount(ctx, recipient).GetCoins().AmountOf(sdk.IrisAtto))
+	require.Equal(t, sdk.NewInt(100), ak.GetAccount(ctx, auth.CommunityTaxCoinsAccAddr).GetCoins().AmountOf(sdk.IrisAtto))
+
+	// finished streams are removed
+	_, found = keeper.GetBudgetStream(ctx, 1)
+	require.False(t, found)
+}
+
+func TestCancelBudgetStream(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, sdk.NewInt(0))
+
+	recipient := sdk.AccAddress([]byte("recipient"))
+	total := sdk.NewCoins(sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(1000)))
+	stream, err := keeper.CreateBudgetStream(ctx, 1, recipient, total, 10, 20, 1)
+	require.Nil(t, err)
+
+	// only the trustees and the gov module account can cancel a stream
+	_, err = keeper.CancelBudgetStream(ctx, stream.ID, recipient)
+	require.NotNil(t, err)
+	_, err = keeper.CancelBudgetStream(ctx, stream.ID+1, auth.GovModuleAccAddr)
+	require.NotNil(t, err)
+
+	_, err = keeper.CancelBudgetStream(ctx, stream.ID, auth.GovModuleAccAddr)
+	require.Nil(t, err)
+	require.Empty(t, keeper.GetAllBudgetStreams(ctx))
+}
@@ -131,7 +131,7 @@ func TestQueries(t *testing.T) {
 	sh := stake.NewHandler(sk)
 	comm := stake.NewCommissionMsg(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	msg := stake.NewMsgCreateValidator(valOpAddr1, valConsPk1,
-		sdk.NewCoin(stake.BondDenom, initCoins), stake.Description{}, comm)
+		sdk.NewCoin(stake.BondDenom, initCoins), sdk.OneInt(), stake.Description{}, comm)
 	require.True(t, sh(ctx, msg).IsOK())
 	stake.EndBlocker(ctx, sk)
 	rewards := getQueriedRewards(t, ctx, cdc, querier, sdk.AccAddress(valOpAddr1))
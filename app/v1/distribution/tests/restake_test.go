@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/stake"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestakeRewards(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, sdk.NewIntWithDecimal(100, 18), sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.BondDenom()
+
+	//first make a validator
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, sdk.NewIntWithDecimal(10, 18))
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// delegate
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, sdk.NewIntWithDecimal(10, 18))
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+
+	// allocate 100 denom of fees
+	feeInputs := sdk.NewIntWithDecimal(100, 18)
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
+	keeper.AllocateTokens(ctx, sdk.OneDec(), valConsAddr1)
+	ctx = ctx.WithBlockHeight(1)
+	sk.SetLastTotalPower(ctx, sdk.NewInt(10))
+	sk.SetLastValidatorPower(ctx, valOpAddr1, sdk.NewInt(10))
+
+	// the rewards below the threshold are not restaked
+	keeper.SetRestake(ctx, delAddr1, nil, sdk.NewIntWithDecimal(60, 18))
+	tags := keeper.RestakeRewards(ctx)
+	require.Empty(t, tags)
+	config, found := keeper.GetRestakeConfig(ctx, delAddr1)
+	require.True(t, found)
+	require.Equal(t, int64(1), config.LastRunHeight)
+
+	// the rewards of the unselected validators are not restaked
+	keeper.SetRestake(ctx, delAddr1, []sdk.ValAddress{valOpAddr2}, sdk.ZeroInt())
+	tags = keeper.RestakeRewards(ctx)
+	require.Empty(t, tags)
+
+	// the rewards are delegated again, the balance is unchanged
+	ctx = ctx.WithBlockHeight(2)
+	keeper.SetRestake(ctx, delAddr1, []sdk.ValAddress{valOpAddr1}, sdk.NewIntWithDecimal(10, 18))
+	tags = keeper.RestakeRewards(ctx)
+	require.NotEmpty(t, tags)
+	amt := accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom)
+	require.Equal(t, sdk.NewIntWithDecimal(90, 18), amt)
+
+	validator, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+	delegation, found := sk.GetDelegation(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	tokens := delegation.Shares.Mul(validator.DelegatorShareExRate()).TruncateInt()
+	require.True(sdk.IntEq(t, sdk.NewIntWithDecimal(60, 18), tokens)) // 10 + 100 tokens * 10/20
+
+	config, found = keeper.GetRestakeConfig(ctx, delAddr1)
+	require.True(t, found)
+	require.Equal(t, int64(2), config.LastRunHeight)
+
+	// opting out removes the config
+	require.Nil(t, keeper.RemoveRestake(ctx, delAddr1))
+	_, found = keeper.GetRestakeConfig(ctx, delAddr1)
+	require.False(t, found)
+	require.NotNil(t, keeper.RemoveRestake(ctx, delAddr1))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/stake"
 	"github.com/irisnet/irishub/codec"
+	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/store"
 	sdk "github.com/irisnet/irishub/types"
 
@@ -91,6 +92,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initCoins sdk.Int,
 	keyFeeCollection := sdk.NewKVStoreKey("fee")
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	keyGuardian := sdk.NewKVStoreKey("guardian")
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -102,6 +104,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initCoins sdk.Int,
 	ms.MountStoreWithDB(keyFeeCollection, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGuardian, sdk.StoreTypeIAVL, db)
 
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
@@ -127,7 +130,8 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initCoins sdk.Int,
 	}
 
 	fck := DummyFeeCollectionKeeper{}
-	keeper := distr.NewKeeper(cdc, keyDistr, pk.Subspace(distr.DefaultParamspace), ck, sk, fck, types.DefaultCodespace, distr.NopMetrics())
+	gk := guardian.NewKeeper(cdc, keyGuardian, guardian.DefaultCodespace)
+	keeper := distr.NewKeeper(cdc, keyDistr, pk.Subspace(distr.DefaultParamspace), ck, sk, fck, gk, types.DefaultCodespace, distr.NopMetrics())
 
 	// set the distribution hooks on staking
 	sk.SetHooks(keeper.Hooks())
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// funding stream paid from the community tax pool, created by a passed
+// community budget proposal
+type BudgetStream struct {
+	ID              uint64         `json:"id"`
+	ProposalID      uint64         `json:"proposal_id"`
+	Recipient       sdk.AccAddress `json:"recipient"`
+	Total           sdk.Coins      `json:"total"`
+	Paid            sdk.Coins      `json:"paid"`
+	StartHeight     int64          `json:"start_height"`
+	EndHeight       int64          `json:"end_height"`
+	TrancheInterval int64          `json:"tranche_interval"` // number of blocks between two payouts, 1 means paid every block
+}
+
+func NewBudgetStream(id, proposalID uint64, recipient sdk.AccAddress, total sdk.Coins,
+	startHeight, endHeight, trancheInterval int64) BudgetStream {
+
+	return BudgetStream{
+		ID:              id,
+		ProposalID:      proposalID,
+		Recipient:       recipient,
+		Total:           total,
+		Paid:            sdk.Coins{},
+		StartHeight:     startHeight,
+		EndHeight:       endHeight,
+		TrancheInterval: trancheInterval,
+	}
+}
+
+// the amount which has not been paid yet
+func (bs BudgetStream) Remaining() sdk.Coins {
+	return bs.Total.Sub(bs.Paid)
+}
+
+// the amount released to the recipient by the given height, the stream
+// releases Total linearly between StartHeight and EndHeight in tranches
+func (bs BudgetStream) ReleasedAt(height int64) sdk.Coins {
+	if height < bs.StartHeight {
+		return sdk.Coins{}
+	}
+	if height >= bs.EndHeight {
+		return bs.Total
+	}
+
+	elapsed := (height - bs.StartHeight) / bs.TrancheInterval * bs.TrancheInterval
+	duration := bs.EndHeight - bs.StartHeight
+	var released sdk.Coins
+	for _, coin := range bs.Total {
+		amount := coin.Amount.MulRaw(elapsed).DivRaw(duration)
+		if amount.IsPositive() {
+			released = append(released, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return released
+}
+
+// the amount which should be paid at the given height
+func (bs BudgetStream) DueAt(height int64) sdk.Coins {
+	due, _ := bs.ReleasedAt(height).SafeSub(bs.Paid)
+	return due
+}
+
+func (bs BudgetStream) IsFinished() bool {
+	return bs.Paid.IsAllGTE(bs.Total)
+}
+
+func (bs BudgetStream) String() string {
+	return fmt.Sprintf(`BudgetStream %d:
+  Proposal ID:       %d
+  Recipient:         %s
+  Total:             %s
+  Paid:              %s
+  Remaining:         %s
+  Start Height:      %d
+  End Height:        %d
+  Tranche Interval:  %d`,
+		bs.ID, bs.ProposalID, bs.Recipient, bs.Total, bs.Paid, bs.Remaining(),
+		bs.StartHeight, bs.EndHeight, bs.TrancheInterval)
+}
+
+// validate the schedule and amount of a budget stream
+func ValidateBudgetStream(recipient sdk.AccAddress, total sdk.Coins, startHeight, endHeight, trancheInterval int64) sdk.Error {
+	if recipient.Empty() {
+		return ErrInvalidBudgetStream(DefaultCodespace, "recipient is empty")
+	}
+	if !total.IsValid() || total.IsZero() {
+		return ErrInvalidBudgetStream(DefaultCodespace, fmt.Sprintf("invalid budget amount %s", total))
+	}
+	if startHeight <= 0 || endHeight <= startHeight {
+		return ErrInvalidBudgetStream(DefaultCodespace, fmt.Sprintf("end height %d must be greater than start height %d which must be positive", endHeight, startHeight))
+	}
+	if trancheInterval <= 0 || trancheInterval > endHeight-startHeight {
+		return ErrInvalidBudgetStream(DefaultCodespace, fmt.Sprintf("tranche interval %d must be in (0, %d]", trancheInterval, endHeight-startHeight))
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetStreamReleasedAt(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient"))
+	total := sdk.NewCoins(sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(1000)))
+
+	// paid every block
+	stream := NewBudgetStream(1, 1, recipient, total, 100, 200, 1)
+	require.True(t, stream.ReleasedAt(99).IsZero())
+	require.True(t, stream.ReleasedAt(100).IsZero())
+	require.Equal(t, sdk.NewInt(10), stream.ReleasedAt(101).AmountOf(sdk.IrisAtto))
+	require.Equal(t, sdk.NewInt(500), stream.ReleasedAt(150).AmountOf(sdk.IrisAtto))
+	require.Equal(t, total, stream.ReleasedAt(200))
+	require.Equal(t, total, stream.ReleasedAt(300))
+
+	// paid in tranches of 30 blocks
+	stream = NewBudgetStream(1, 1, recipient, total, 100, 200, 30)
+	require.True(t, stream.ReleasedAt(129).IsZero())
+	require.Equal(t, sdk.NewInt(300), stream.ReleasedAt(130).AmountOf(sdk.IrisAtto))
+	require.Equal(t, sdk.NewInt(300), stream.ReleasedAt(159).AmountOf(sdk.IrisAtto))
+	require.Equal(t, sdk.NewInt(900), stream.ReleasedAt(199).AmountOf(sdk.IrisAtto))
+	require.Equal(t, total, stream.ReleasedAt(200))
+
+	// the amount already paid is not due anymore
+	stream.Paid = stream.ReleasedAt(130)
+	require.True(t, stream.DueAt(159).IsZero())
+	require.Equal(t, sdk.NewInt(300), stream.DueAt(160).AmountOf(sdk.IrisAtto))
+	require.Equal(t, sdk.NewInt(700), stream.Remaining().AmountOf(sdk.IrisAtto))
+	require.False(t, stream.IsFinished())
+	stream.Paid = total
+	require.True(t, stream.IsFinished())
+}
+
+func TestValidateBudgetStream(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient"))
+	total := sdk.NewCoins(sdk.NewCoin(sdk.IrisAtto, sdk.NewInt(1000)))
+
+	require.Nil(t, ValidateBudgetStream(recipient, total, 100, 200, 100))
+	require.NotNil(t, ValidateBudgetStream(nil, total, 100, 200, 1))
+	require.NotNil(t, ValidateBudgetStream(recipient, sdk.Coins{}, 100, 200, 1))
+	require.NotNil(t, ValidateBudgetStream(recipient, total, 0, 200, 1))
+	require.NotNil(t, ValidateBudgetStream(recipient, total, 200, 200, 1))
+	require.NotNil(t, ValidateBudgetStream(recipient, total, 100, 200, 0))
+	require.NotNil(t, ValidateBudgetStream(recipient, total, 100, 200, 101))
+}
@@ -10,9 +10,14 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "irishub/distr/MsgWithdrawDelegationReward", nil)
 	cdc.RegisterConcrete(MsgWithdrawValidatorRewardsAll{}, "irishub/distr/MsgWithdrawValidatorRewardsAll", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "irishub/distr/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgCancelBudgetStream{}, "irishub/distr/MsgCancelBudgetStream", nil)
+	cdc.RegisterConcrete(MsgSetRestake{}, "irishub/distr/MsgSetRestake", nil)
+	cdc.RegisterConcrete(MsgRemoveRestake{}, "irishub/distr/MsgRemoveRestake", nil)
 
 	cdc.RegisterConcrete(DelegationDistInfo{}, "irishub/distr/DelegationDistInfo", nil)
 	cdc.RegisterConcrete(FeePool{}, "irishub/distr/FeePool", nil)
+	cdc.RegisterConcrete(BudgetStream{}, "irishub/distr/BudgetStream", nil)
+	cdc.RegisterConcrete(RestakeConfig{}, "irishub/distr/RestakeConfig", nil)
 
 	cdc.RegisterConcrete(&Params{}, "irishub/distr/Params", nil)
 }
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -11,6 +13,11 @@ const (
 	DefaultCodespace       sdk.CodespaceType = "distr"
 	CodeInvalidInput       CodeType          = 103
 	CodeNoDistributionInfo CodeType          = 104
+	CodeInvalidBudget      CodeType          = 105
+	CodeUnknownBudget      CodeType          = 106
+	CodeUnauthorized       CodeType          = 107
+	CodeInvalidRestake     CodeType          = 108
+	CodeUnknownRestake     CodeType          = 109
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -28,3 +35,18 @@ func ErrNoDelegationDistInfo(codespace sdk.CodespaceType) sdk.Error {
 func ErrNoValidatorDistInfo(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeNoDistributionInfo, "no validator distribution info")
 }
+func ErrInvalidBudgetStream(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidBudget, msg)
+}
+func ErrUnknownBudgetStream(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownBudget, fmt.Sprintf("budget stream %d does not exist", id))
+}
+func ErrUnauthorizedCancel(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, fmt.Sprintf("%s is neither a trustee nor the gov module account", addr))
+}
+func ErrInvalidRestakeConfig(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRestake, msg)
+}
+func ErrNoRestakeConfig(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownRestake, fmt.Sprintf("%s has no restake config", addr))
+}
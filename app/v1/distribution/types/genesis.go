@@ -19,10 +19,13 @@ type GenesisState struct {
 	DelegationDistInfos    []DelegationDistInfo    `json:"delegator_dist_infos"`
 	DelegatorWithdrawInfos []DelegatorWithdrawInfo `json:"delegator_withdraw_infos"`
 	PreviousProposer       sdk.ConsAddress         `json:"previous_proposer"`
+	BudgetStreams          []BudgetStream          `json:"budget_streams"`
+	RestakeConfigs         []RestakeConfig         `json:"restake_configs"`
 }
 
 func NewGenesisState(params Params, feePool FeePool, vdis []ValidatorDistInfo,
-	ddis []DelegationDistInfo, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress) GenesisState {
+	ddis []DelegationDistInfo, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, streams []BudgetStream,
+	restakes []RestakeConfig) GenesisState {
 
 	return GenesisState{
 		Params:                 params,
@@ -31,6 +34,8 @@ func NewGenesisState(params Params, feePool FeePool, vdis []ValidatorDistInfo,
 		DelegationDistInfos:    ddis,
 		DelegatorWithdrawInfos: dwis,
 		PreviousProposer:       pp,
+		BudgetStreams:          streams,
+		RestakeConfigs:         restakes,
 	}
 }
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/irisnet/irishub/app/v1/stake/types"
+	"github.com/irisnet/irishub/modules/guardian"
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -16,6 +17,10 @@ type StakeKeeper interface {
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Int
 	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) []types.Delegation
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator types.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Coin, validator types.Validator, subtractAccount bool) (sdk.Dec, sdk.Error)
+	CheckValidatorBondedRatio(ctx sdk.Context, validator types.Validator, tokens, leavingBondedTokens sdk.Dec) sdk.Error
+	BondDenom() string
 }
 
 // expected coin keeper
@@ -32,3 +37,8 @@ type FeeKeeper interface {
 	GetCollectedFees(ctx sdk.Context) sdk.Coins
 	ClearCollectedFees(ctx sdk.Context)
 }
+
+// expected guardian keeper
+type GuardianKeeper interface {
+	GetTrustee(ctx sdk.Context, addr sdk.AccAddress) (guardian.Guardian, bool)
+}
@@ -11,6 +11,8 @@ const MsgRoute = "distr"
 // Verify interface at compile time
 var _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorRewardsAll{}
 var _, _ sdk.Msg = &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorRewardsAll{}
+var _ sdk.Msg = &MsgCancelBudgetStream{}
+var _, _ sdk.Msg = &MsgSetRestake{}, &MsgRemoveRestake{}
 
 //______________________________________________________________________
 
@@ -173,3 +175,127 @@ func (msg MsgWithdrawValidatorRewardsAll) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// msg struct for cancelling an active budget stream, signed by a trustee
+// or by the gov module account through a passed proposal
+type MsgCancelBudgetStream struct {
+	StreamID  uint64         `json:"stream_id"`
+	Canceller sdk.AccAddress `json:"canceller"`
+}
+
+func NewMsgCancelBudgetStream(streamID uint64, canceller sdk.AccAddress) MsgCancelBudgetStream {
+	return MsgCancelBudgetStream{
+		StreamID:  streamID,
+		Canceller: canceller,
+	}
+}
+
+func (msg MsgCancelBudgetStream) Route() string { return MsgRoute }
+func (msg MsgCancelBudgetStream) Type() string  { return "cancel_budget_stream" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgCancelBudgetStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Canceller}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelBudgetStream) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgCancelBudgetStream) ValidateBasic() sdk.Error {
+	if msg.Canceller.Empty() {
+		return sdk.ErrInvalidAddress("canceller is empty")
+	}
+	if msg.StreamID == 0 {
+		return ErrInvalidBudgetStream(DefaultCodespace, "stream id must be positive")
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// msg struct for opting in the restaking of the delegator's rewards, or updating its setting
+type MsgSetRestake struct {
+	DelegatorAddr sdk.AccAddress   `json:"delegator_addr"`
+	Validators    []sdk.ValAddress `json:"validators"`
+	MinReward     sdk.Int          `json:"min_reward"`
+}
+
+func NewMsgSetRestake(delAddr sdk.AccAddress, validators []sdk.ValAddress, minReward sdk.Int) MsgSetRestake {
+	return MsgSetRestake{
+		DelegatorAddr: delAddr,
+		Validators:    validators,
+		MinReward:     minReward,
+	}
+}
+
+func (msg MsgSetRestake) Route() string { return MsgRoute }
+func (msg MsgSetRestake) Type() string  { return "set_restake" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetRestake) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSetRestake) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return ValidateRestakeConfig(msg.Validators, msg.MinReward)
+}
+
+//______________________________________________________________________
+
+// msg struct for opting out the restaking of the delegator's rewards
+type MsgRemoveRestake struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+}
+
+func NewMsgRemoveRestake(delAddr sdk.AccAddress) MsgRemoveRestake {
+	return MsgRemoveRestake{
+		DelegatorAddr: delAddr,
+	}
+}
+
+func (msg MsgRemoveRestake) Route() string { return MsgRoute }
+func (msg MsgRemoveRestake) Type() string  { return "remove_restake" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgRemoveRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRemoveRestake) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRemoveRestake) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
@@ -67,3 +67,29 @@ func TestMsgWithdrawValidatorRewardsAll(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetRestake
+func TestMsgSetRestake(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validators    []sdk.ValAddress
+		minReward     sdk.Int
+		expectPass    bool
+	}{
+		{delAddr1, nil, sdk.ZeroInt(), true},
+		{delAddr1, []sdk.ValAddress{valAddr1, valAddr2}, sdk.NewInt(10), true},
+		{emptyDelAddr, nil, sdk.ZeroInt(), false},
+		{delAddr1, []sdk.ValAddress{valAddr1, valAddr1}, sdk.ZeroInt(), false},
+		{delAddr1, []sdk.ValAddress{emptyValAddr}, sdk.ZeroInt(), false},
+		{delAddr1, nil, sdk.NewInt(-1), false},
+		{delAddr1, nil, sdk.Int{}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetRestake(tc.delegatorAddr, tc.validators, tc.minReward)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
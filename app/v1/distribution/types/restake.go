@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// maximum number of validators a restake config can select
+const MaxRestakeValidators = 16
+
+// opt-in setting of a delegator to delegate its rewards again automatically,
+// the delegations are restaked by the begin blocker in bounded batches
+type RestakeConfig struct {
+	DelegatorAddr sdk.AccAddress   `json:"delegator_addr"`
+	Validators    []sdk.ValAddress `json:"validators"`      // the delegations to restake, all of them if empty
+	MinReward     sdk.Int          `json:"min_reward"`      // minimum reward of a delegation, in the bond denom, to restake it
+	LastRunHeight int64            `json:"last_run_height"` // height of the last processing, 0 if never processed
+}
+
+func NewRestakeConfig(delAddr sdk.AccAddress, validators []sdk.ValAddress, minReward sdk.Int) RestakeConfig {
+	return RestakeConfig{
+		DelegatorAddr: delAddr,
+		Validators:    validators,
+		MinReward:     minReward,
+	}
+}
+
+// whether the delegation to the validator is restaked
+func (rc RestakeConfig) Includes(valAddr sdk.ValAddress) bool {
+	if len(rc.Validators) == 0 {
+		return true
+	}
+	for _, addr := range rc.Validators {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rc RestakeConfig) String() string {
+	validators := "all"
+	if len(rc.Validators) > 0 {
+		var addrs []string
+		for _, addr := range rc.Validators {
+			addrs = append(addrs, addr.String())
+		}
+		validators = strings.Join(addrs, ", ")
+	}
+	return fmt.Sprintf(`Restake Config:
+  Delegator:        %s
+  Validators:       %s
+  Min Reward:       %s
+  Last Run Height:  %d`,
+		rc.DelegatorAddr, validators, rc.MinReward.String(), rc.LastRunHeight)
+}
+
+// validate the validator selection and the threshold of a restake config
+func ValidateRestakeConfig(validators []sdk.ValAddress, minReward sdk.Int) sdk.Error {
+	if len(validators) > MaxRestakeValidators {
+		return ErrInvalidRestakeConfig(DefaultCodespace, fmt.Sprintf("at most %d validators can be selected", MaxRestakeValidators))
+	}
+	seen := make(map[string]bool)
+	for _, addr := range validators {
+		if addr.Empty() {
+			return ErrInvalidRestakeConfig(DefaultCodespace, "validator address is empty")
+		}
+		if seen[addr.String()] {
+			return ErrInvalidRestakeConfig(DefaultCodespace, fmt.Sprintf("duplicate validator %s", addr))
+		}
+		seen[addr.String()] = true
+	}
+	if minReward == (sdk.Int{}) || minReward.IsNegative() {
+		return ErrInvalidRestakeConfig(DefaultCodespace, "min reward must not be negative")
+	}
+	return nil
+}
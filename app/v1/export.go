@@ -9,6 +9,7 @@ import (
 	distr "github.com/irisnet/irishub/app/v1/distribution"
 	"github.com/irisnet/irishub/app/v1/gov"
 	"github.com/irisnet/irishub/app/v1/mint"
+	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/rand"
 	"github.com/irisnet/irishub/app/v1/service"
 	"github.com/irisnet/irishub/app/v1/slashing"
@@ -41,17 +42,7 @@ func (p *ProtocolV1) ExportAppStateAndValidators(ctx sdk.Context, forZeroHeight
 		if acc.Coins == nil {
 			continue
 		}
-		var coinsString []string
-		for _, coin := range acc.Coins {
-			coinsString = append(coinsString, coin.String())
-		}
-		fileAccounts = append(fileAccounts,
-			GenesisFileAccount{
-				Address:       acc.Address,
-				Coins:         coinsString,
-				Sequence:      acc.Sequence,
-				AccountNumber: acc.AccountNumber,
-			})
+		fileAccounts = append(fileAccounts, NewGenesisFileAccountI(acc))
 	}
 
 	genState := NewGenesisFileState(
@@ -67,6 +58,7 @@ func (p *ProtocolV1) ExportAppStateAndValidators(ctx sdk.Context, forZeroHeight
 		slashing.ExportGenesis(ctx, p.slashingKeeper),
 		asset.ExportGenesis(ctx, p.assetKeeper),
 		rand.ExportGenesis(ctx, p.randKeeper),
+		params.ExportGenesis(ctx, p.paramsKeeper),
 	)
 	appState, err = codec.MarshalJSONIndent(p.cdc, genState)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	distr "github.com/irisnet/irishub/app/v1/distribution"
 	"github.com/irisnet/irishub/app/v1/gov"
 	"github.com/irisnet/irishub/app/v1/mint"
+	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/rand"
 	"github.com/irisnet/irishub/app/v1/service"
 	"github.com/irisnet/irishub/app/v1/slashing"
@@ -41,12 +42,14 @@ type GenesisState struct {
 	GuardianData guardian.GenesisState `json:"guardian"`
 	AssetData    asset.GenesisState    `json:"asset"`
 	RandData     rand.GenesisState     `json:"rand"`
+	ParamsData   params.GenesisState   `json:"params"`
 	GenTxs       []json.RawMessage     `json:"gentxs"`
 }
 
 func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState, stakeData stake.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState, upgradeData upgrade.GenesisState, serviceData service.GenesisState,
-	guardianData guardian.GenesisState, slashingData slashing.GenesisState, assetData asset.GenesisState, randData rand.GenesisState) GenesisState {
+	guardianData guardian.GenesisState, slashingData slashing.GenesisState, assetData asset.GenesisState, randData rand.GenesisState,
+	paramsData params.GenesisState) GenesisState {
 
 	return GenesisState{
 		Accounts:     accounts,
@@ -61,6 +64,7 @@ func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState, stak
 		SlashingData: slashingData,
 		AssetData:    assetData,
 		RandData:     randData,
+		ParamsData:   paramsData,
 	}
 }
 
@@ -70,6 +74,13 @@ type GenesisAccount struct {
 	Coins         sdk.Coins      `json:"coins"`
 	Sequence      uint64         `json:"sequence_number"`
 	AccountNumber uint64         `json:"account_number"`
+
+	// vesting account fields
+	OriginalVesting  sdk.Coins `json:"original_vesting,omitempty"`  // total vesting coins upon initialization
+	DelegatedFree    sdk.Coins `json:"delegated_free,omitempty"`    // delegated vested coins at time of delegation
+	DelegatedVesting sdk.Coins `json:"delegated_vesting,omitempty"` // delegated vesting coins at time of delegation
+	StartTime        int64     `json:"start_time,omitempty"`        // vesting start time (UNIX Epoch time)
+	EndTime          int64     `json:"end_time,omitempty"`          // vesting end time (UNIX Epoch time)
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -82,22 +93,56 @@ func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
 }
 
 func NewGenesisAccountI(acc auth.Account) GenesisAccount {
-	return GenesisAccount{
+	gacc := GenesisAccount{
 		Address:       acc.GetAddress(),
 		Coins:         acc.GetCoins(),
 		AccountNumber: acc.GetAccountNumber(),
 		Sequence:      acc.GetSequence(),
 	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		gacc.OriginalVesting = vacc.GetOriginalVesting()
+		gacc.DelegatedFree = vacc.GetDelegatedFree()
+		gacc.DelegatedVesting = vacc.GetDelegatedVesting()
+		gacc.StartTime = vacc.GetStartTime()
+		gacc.EndTime = vacc.GetEndTime()
+	}
+
+	return gacc
 }
 
-// convert GenesisAccount to auth.BaseAccount
-func (ga *GenesisAccount) ToAccount() (acc *auth.BaseAccount) {
-	return &auth.BaseAccount{
+// convert GenesisAccount to auth.Account, a vesting account is created if the
+// genesis account has original vesting coins
+func (ga *GenesisAccount) ToAccount() auth.Account {
+	bacc := &auth.BaseAccount{
 		Address:       ga.Address,
 		Coins:         ga.Coins.Sort(),
 		AccountNumber: ga.AccountNumber,
 		Sequence:      ga.Sequence,
 	}
+
+	if ga.OriginalVesting.IsZero() {
+		return bacc
+	}
+
+	baseVestingAcc := &auth.BaseVestingAccount{
+		BaseAccount:      bacc,
+		OriginalVesting:  ga.OriginalVesting.Sort(),
+		DelegatedFree:    ga.DelegatedFree.Sort(),
+		DelegatedVesting: ga.DelegatedVesting.Sort(),
+		EndTime:          ga.EndTime,
+	}
+
+	if ga.StartTime != 0 {
+		return &auth.ContinuousVestingAccount{
+			BaseVestingAccount: baseVestingAcc,
+			StartTime:          ga.StartTime,
+		}
+	}
+
+	return &auth.DelayedVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+	}
 }
 
 // Create the core parameters for genesis initialization for iris
@@ -145,6 +190,10 @@ func IrisValidateGenesisState(genesisState GenesisState) (err error) {
 	if err != nil {
 		return
 	}
+	err = params.ValidateGenesis(genesisState.ParamsData)
+	if err != nil {
+		return
+	}
 	// skip stakeData validation as genesis is created from txs
 	if len(genesisState.GenTxs) > 0 {
 		return nil
@@ -153,6 +202,7 @@ func IrisValidateGenesisState(genesisState GenesisState) (err error) {
 }
 
 // Ensures that there are no duplicate accounts in the genesis state,
+// and that the vesting schedules of the vesting accounts are valid
 func validateGenesisStateAccounts(accs []GenesisAccount) (err error) {
 	addrMap := make(map[string]bool, len(accs))
 	for i := 0; i < len(accs); i++ {
@@ -162,10 +212,29 @@ func validateGenesisStateAccounts(accs []GenesisAccount) (err error) {
 			return fmt.Errorf("Duplicate account in genesis state: Address %v", acc.Address)
 		}
 		addrMap[strAddr] = true
+
+		if err = validateGenesisVestingAccount(acc); err != nil {
+			return err
+		}
 	}
 	return
 }
 
+func validateGenesisVestingAccount(acc GenesisAccount) error {
+	if acc.OriginalVesting.IsZero() {
+		if acc.StartTime != 0 || acc.EndTime != 0 {
+			return fmt.Errorf("Vesting schedule without original vesting coins in genesis state: Address %v", acc.Address)
+		}
+		return nil
+	}
+
+	vacc := acc.ToAccount().(auth.VestingAccount)
+	if err := vacc.Validate(); err != nil {
+		return fmt.Errorf("Invalid vesting account in genesis state: Address %v, %s", acc.Address, err)
+	}
+	return nil
+}
+
 // IrisAppGenState but with JSON
 func IrisAppGenStateJSON(cdc *codec.Codec, genDoc tmtypes.GenesisDoc, appGenTxs []json.RawMessage) (
 	appState json.RawMessage, err error) {
@@ -316,14 +385,43 @@ func convertToMinDenomCoins(coinStrArray []string) sdk.Coins {
 	return accountCoins
 }
 
+// convert string array into min-denom vesting coins, which can be empty
+func convertToMinDenomVestingCoins(coinStrArray []string) sdk.Coins {
+	if len(coinStrArray) == 0 {
+		return nil
+	}
+
+	var vestingCoins sdk.Coins
+	for _, coin := range convertToMinDenomCoins(coinStrArray) {
+		if !coin.IsZero() {
+			vestingCoins = append(vestingCoins, coin)
+		}
+	}
+	return vestingCoins.Sort()
+}
+
+// convert coins into string array
+func convertToCoinStrings(coins sdk.Coins) []string {
+	var coinStrArray []string
+	for _, coin := range coins {
+		coinStrArray = append(coinStrArray, coin.String())
+	}
+	return coinStrArray
+}
+
 func convertToGenesisState(genesisFileState GenesisFileState) GenesisState {
 	var genesisAccounts []GenesisAccount
 	for _, gacc := range genesisFileState.Accounts {
 		acc := GenesisAccount{
-			Address:       gacc.Address,
-			Coins:         convertToMinDenomCoins(gacc.Coins),
-			AccountNumber: gacc.AccountNumber,
-			Sequence:      gacc.Sequence,
+			Address:          gacc.Address,
+			Coins:            convertToMinDenomCoins(gacc.Coins),
+			AccountNumber:    gacc.AccountNumber,
+			Sequence:         gacc.Sequence,
+			OriginalVesting:  convertToMinDenomVestingCoins(gacc.OriginalVesting),
+			DelegatedFree:    convertToMinDenomVestingCoins(gacc.DelegatedFree),
+			DelegatedVesting: convertToMinDenomVestingCoins(gacc.DelegatedVesting),
+			StartTime:        gacc.StartTime,
+			EndTime:          gacc.EndTime,
 		}
 		genesisAccounts = append(genesisAccounts, acc)
 	}
@@ -340,6 +438,7 @@ func convertToGenesisState(genesisFileState GenesisFileState) GenesisState {
 		GuardianData: genesisFileState.GuardianData,
 		AssetData:    genesisFileState.AssetData,
 		RandData:     genesisFileState.RandData,
+		ParamsData:   genesisFileState.ParamsData,
 		GenTxs:       genesisFileState.GenTxs,
 	}
 }
@@ -357,6 +456,7 @@ type GenesisFileState struct {
 	GuardianData guardian.GenesisState `json:"guardian"`
 	AssetData    asset.GenesisState    `json:"asset"`
 	RandData     rand.GenesisState     `json:"rand"`
+	ParamsData   params.GenesisState   `json:"params"`
 	GenTxs       []json.RawMessage     `json:"gentxs"`
 }
 
@@ -365,24 +465,44 @@ type GenesisFileAccount struct {
 	Coins         []string       `json:"coins"`
 	Sequence      uint64         `json:"sequence_number"`
 	AccountNumber uint64         `json:"account_number"`
+
+	// vesting account fields
+	OriginalVesting  []string `json:"original_vesting,omitempty"`
+	DelegatedFree    []string `json:"delegated_free,omitempty"`
+	DelegatedVesting []string `json:"delegated_vesting,omitempty"`
+	StartTime        int64    `json:"start_time,omitempty"`
+	EndTime          int64    `json:"end_time,omitempty"`
 }
 
 func NewGenesisFileAccount(acc *auth.BaseAccount) GenesisFileAccount {
-	var coins []string
-	for _, coin := range acc.Coins {
-		coins = append(coins, coin.String())
-	}
 	return GenesisFileAccount{
 		Address:       acc.Address,
-		Coins:         coins,
+		Coins:         convertToCoinStrings(acc.Coins),
 		AccountNumber: acc.AccountNumber,
 		Sequence:      acc.Sequence,
 	}
 }
 
+// NewGenesisFileAccountI converts a GenesisAccount, which may hold a vesting
+// schedule, into a GenesisFileAccount
+func NewGenesisFileAccountI(acc GenesisAccount) GenesisFileAccount {
+	return GenesisFileAccount{
+		Address:          acc.Address,
+		Coins:            convertToCoinStrings(acc.Coins),
+		Sequence:         acc.Sequence,
+		AccountNumber:    acc.AccountNumber,
+		OriginalVesting:  convertToCoinStrings(acc.OriginalVesting),
+		DelegatedFree:    convertToCoinStrings(acc.DelegatedFree),
+		DelegatedVesting: convertToCoinStrings(acc.DelegatedVesting),
+		StartTime:        acc.StartTime,
+		EndTime:          acc.EndTime,
+	}
+}
+
 func NewGenesisFileState(accounts []GenesisFileAccount, authData auth.GenesisState, stakeData stake.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState, upgradeData upgrade.GenesisState, serviceData service.GenesisState,
-	guardianData guardian.GenesisState, slashingData slashing.GenesisState, assetData asset.GenesisState, randData rand.GenesisState) GenesisFileState {
+	guardianData guardian.GenesisState, slashingData slashing.GenesisState, assetData asset.GenesisState, randData rand.GenesisState,
+	paramsData params.GenesisState) GenesisFileState {
 
 	return GenesisFileState{
 		Accounts:     accounts,
@@ -397,6 +517,7 @@ func NewGenesisFileState(accounts []GenesisFileAccount, authData auth.GenesisSta
 		SlashingData: slashingData,
 		AssetData:    assetData,
 		RandData:     randData,
+		ParamsData:   paramsData,
 	}
 }
 
@@ -415,6 +536,7 @@ func NewDefaultGenesisFileState() GenesisFileState {
 		SlashingData: slashing.DefaultGenesisState(),
 		AssetData:    asset.DefaultGenesisState(),
 		RandData:     rand.DefaultGenesisState(),
+		ParamsData:   params.DefaultGenesisState(),
 		GenTxs:       nil,
 	}
 }
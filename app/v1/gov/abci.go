@@ -26,7 +26,8 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(inactiveIterator.Value(), &proposalID)
 		inactiveProposal := keeper.GetProposal(ctx, proposalID)
 		keeper.SubProposalNum(ctx, inactiveProposal.GetProposalLevel())
-		keeper.DeleteDeposits(ctx, proposalID)
+		keeper.SubProposalKindNum(ctx, inactiveProposal.GetProposalType())
+		resTags = resTags.AppendTags(keeper.settleDeposits(ctx, keeper.GetRefundOutcome(ctx, inactiveProposal, DROP, EmptyTallyResult())))
 		keeper.DeleteProposal(ctx, proposalID)
 
 		resTags = resTags.AppendTag(tags.Action, tags.ActionProposalDropped)
@@ -34,7 +35,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 
 		keeper.RemoveFromInactiveProposalQueue(ctx, inactiveProposal.GetDepositEndTime(), inactiveProposal.GetProposalID())
 		ctx.Logger().Info("Proposal didn't meet minimum deposit; deleted", "ProposalID",
-			inactiveProposal.GetProposalID(), "MinDeposit", keeper.GetDepositProcedure(ctx, inactiveProposal.GetProposalLevel()).MinDeposit,
+			inactiveProposal.GetProposalID(), "MinDeposit", keeper.GetProposalDepositProcedure(ctx, inactiveProposal).MinDeposit,
 			"ActualDeposit", inactiveProposal.GetTotalDeposit(),
 		)
 	}
@@ -46,32 +47,61 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
 		activeProposal := keeper.GetProposal(ctx, proposalID)
 		result, tallyResults, votingVals := tally(ctx, keeper, activeProposal)
+		resTags = resTags.AppendTags(endVotingPeriod(ctx, keeper, activeProposal, result, tallyResults, votingVals, false))
+	}
 
-		var action []byte
-		if result == PASS {
-			keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(2)
-			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
-			activeProposal.SetStatus(StatusPassed)
-			action = tags.ActionProposalPassed
-			activeProposal.Execute(ctx, keeper)
-		} else if result == REJECT {
-			keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(3)
-			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
-			activeProposal.SetStatus(StatusRejected)
-			action = tags.ActionProposalRejected
-		} else if result == REJECTVETO {
-			keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(3)
-			keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
-			activeProposal.SetStatus(StatusRejected)
-			action = tags.ActionProposalRejected
+	// the proposals in voting period which have been approved by a supermajority
+	// of the system voting power pass without waiting for the end of the voting period,
+	// only the proposals voted in this block are tallied again
+	for _, activeProposal := range keeper.popVotedProposals(ctx) {
+		result, tallyResults, votingVals := tally(ctx, keeper, activeProposal)
+		if result != PASS || !tallyResults.SystemVotingPower.IsPositive() ||
+			tallyResults.Yes.Quo(tallyResults.SystemVotingPower).LT(keeper.GetExpeditedThreshold(ctx)) {
+			continue
 		}
-		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.GetVotingEndTime(), activeProposal.GetProposalID())
-		activeProposal.SetTallyResult(tallyResults)
-		keeper.SetProposal(ctx, activeProposal)
-		ctx.Logger().Info("Proposal tallied", "ProposalID", activeProposal.GetProposalID(), "result", result, "tallyResults", tallyResults)
-		resTags = resTags.AppendTag(tags.Action, action)
-		resTags = resTags.AppendTag(tags.ProposalID, []byte(string(proposalID)))
+		resTags = resTags.AppendTags(endVotingPeriod(ctx, keeper, activeProposal, result, tallyResults, votingVals, true))
+	}
+	return resTags
+}
+
+// applies the tally result of a proposal and closes its voting period. The validators which
+// have not voted are slashed, unless the voting period is ended early by an expedited pass
+func endVotingPeriod(ctx sdk.Context, keeper Keeper, activeProposal Proposal, result ProposalResult,
+	tallyResults TallyResult, votingVals map[string]bool, expedited bool) (resTags sdk.Tags) {
 
+	proposalID := activeProposal.GetProposalID()
+	// the deposits are refunded or burned by the refund policy
+	resTags = resTags.AppendTags(keeper.settleDeposits(ctx, keeper.GetRefundOutcome(ctx, activeProposal, result, tallyResults)))
+
+	var action []byte
+	if result == PASS {
+		keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(2)
+		activeProposal.SetStatus(StatusPassed)
+		action = tags.ActionProposalPassed
+		activeProposal.Execute(ctx, keeper)
+	} else if result == REJECT {
+		keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(3)
+		activeProposal.SetStatus(StatusRejected)
+		action = tags.ActionProposalRejected
+	} else if result == REJECTVETO {
+		keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(3)
+		activeProposal.SetStatus(StatusRejected)
+		action = tags.ActionProposalRejected
+	}
+	keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.GetVotingEndTime(), activeProposal.GetProposalID())
+	if expedited {
+		activeProposal.SetVotingEndTime(ctx.BlockHeader().Time)
+	}
+	activeProposal.SetTallyResult(tallyResults)
+	keeper.SetProposal(ctx, activeProposal)
+	ctx.Logger().Info("Proposal tallied", "ProposalID", activeProposal.GetProposalID(), "result", result, "tallyResults", tallyResults, "expedited", expedited)
+	resTags = resTags.AppendTag(tags.Action, action)
+	resTags = resTags.AppendTag(tags.ProposalID, []byte(string(proposalID)))
+	if expedited {
+		resTags = resTags.AppendTag(tags.Expedited, []byte(strconv.FormatUint(proposalID, 10)))
+	}
+
+	if !expedited {
 		for _, valAddr := range keeper.GetValidatorSet(ctx, proposalID) {
 			if _, ok := votingVals[valAddr.String()]; !ok {
 				val := keeper.ds.GetValidatorSet().Validator(ctx, valAddr)
@@ -84,9 +114,10 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 				}
 			}
 		}
-
-		keeper.SubProposalNum(ctx, activeProposal.GetProposalLevel())
-		keeper.DeleteValidatorSet(ctx, activeProposal.GetProposalID())
 	}
+
+	keeper.SubProposalNum(ctx, activeProposal.GetProposalLevel())
+	keeper.SubProposalKindNum(ctx, activeProposal.GetProposalType())
+	keeper.DeleteValidatorSet(ctx, activeProposal.GetProposalID())
 	return resTags
 }
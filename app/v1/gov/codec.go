@@ -10,8 +10,15 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitCommunityTaxUsageProposal{}, "irishub/gov/MsgSubmitCommunityTaxUsageProposal", nil)
 	cdc.RegisterConcrete(MsgSubmitSoftwareUpgradeProposal{}, "irishub/gov/MsgSubmitSoftwareUpgradeProposal", nil)
 	cdc.RegisterConcrete(MsgSubmitTokenAdditionProposal{}, "irishub/gov/MsgSubmitTokenAdditionProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitMsgExecutionProposal{}, "irishub/gov/MsgSubmitMsgExecutionProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitCommunityBudgetProposal{}, "irishub/gov/MsgSubmitCommunityBudgetProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitGuardianProposal{}, "irishub/gov/MsgSubmitGuardianProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "irishub/gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "irishub/gov/MsgVote", nil)
+	cdc.RegisterConcrete(MsgWeightedVote{}, "irishub/gov/MsgWeightedVote", nil)
+	cdc.RegisterConcrete(MsgSetRepresentative{}, "irishub/gov/MsgSetRepresentative", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, "irishub/gov/MsgCancelProposal", nil)
+	cdc.RegisterConcrete(MsgAmendProposal{}, "irishub/gov/MsgAmendProposal", nil)
 
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&BasicProposal{}, "irishub/gov/BasicProposal", nil)
@@ -21,6 +28,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "irishub/gov/SoftwareUpgradeProposal", nil)
 	cdc.RegisterConcrete(&SystemHaltProposal{}, "irishub/gov/SystemHaltProposal", nil)
 	cdc.RegisterConcrete(&CommunityTaxUsageProposal{}, "irishub/gov/CommunityTaxUsageProposal", nil)
+	cdc.RegisterConcrete(&MsgExecutionProposal{}, "irishub/gov/MsgExecutionProposal", nil)
+	cdc.RegisterConcrete(&CommunityBudgetProposal{}, "irishub/gov/CommunityBudgetProposal", nil)
+	cdc.RegisterConcrete(&GuardianProposal{}, "irishub/gov/GuardianProposal", nil)
 	cdc.RegisterConcrete(&Vote{}, "irishub/gov/Vote", nil)
 	cdc.RegisterConcrete(&GovParams{}, "irishub/gov/Params", nil)
 }
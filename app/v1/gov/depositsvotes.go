@@ -3,6 +3,7 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/pkg/errors"
@@ -10,13 +11,25 @@ import (
 
 // Vote
 type Vote struct {
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	ProposalID uint64         `json:"proposal_id"` //  proposalID of the proposal
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	ProposalID uint64              `json:"proposal_id"` //  proposalID of the proposal
+	Option     VoteOption          `json:"option"`      //  option from OptionSet chosen by the voter, the one with the largest weight for a weighted vote
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options of a split vote, empty if the voter chose a single option
+}
+
+// the weighted options of the vote, a single option vote counts with its full weight
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 {
+		return WeightedVoteOptions{NewWeightedVoteOption(v.Option, sdk.OneDec())}
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("Voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	if len(v.Options) == 0 {
+		return fmt.Sprintf("Voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	}
+	return fmt.Sprintf("Voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
 }
 
 // Votes is a collection of Vote
@@ -25,14 +38,15 @@ type Votes []Vote
 func (v Votes) String() string {
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
 
 // Returns whether 2 votes are equal
 func (voteA Vote) Equals(voteB Vote) bool {
-	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option
+	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option &&
+		voteA.Options.Equals(voteB.Options)
 }
 
 // Returns whether a vote is empty
@@ -191,3 +205,262 @@ func (vo VoteOption) Format(s fmt.State, verb rune) {
 		s.Write([]byte(fmt.Sprintf("%v", byte(vo))))
 	}
 }
+
+// WeightedVoteOption is one option of a split vote along with the fraction of
+// the voting power cast for it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	Weight sdk.Dec    `json:"weight"`
+}
+
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight.String())
+}
+
+// WeightedVoteOptions is the set of options of a split vote
+type WeightedVoteOptions []WeightedVoteOption
+
+// Parses the options of a split vote, e.g. "Yes=0.6,No=0.4"
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, optionStr := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(optionStr), "=")
+		if len(fields) != 2 {
+			return nil, errors.Errorf("'%s' is not a valid weighted vote option, expected <option>=<weight>", optionStr)
+		}
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// Is a valid split vote, the options are distinct with positive weights summing up to 1
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+	totalWeight := sdk.ZeroDec()
+	seen := make(map[VoteOption]bool)
+	for _, o := range options {
+		if !ValidVoteOption(o.Option) || seen[o.Option] {
+			return false
+		}
+		if o.Weight.IsNil() || !o.Weight.IsPositive() {
+			return false
+		}
+		seen[o.Option] = true
+		totalWeight = totalWeight.Add(o.Weight)
+	}
+	return totalWeight.Equal(sdk.OneDec())
+}
+
+// the option with the largest weight, the first one wins a tie
+func (options WeightedVoteOptions) PrimaryOption() VoteOption {
+	primary := OptionEmpty
+	maxWeight := sdk.ZeroDec()
+	for _, o := range options {
+		if o.Weight.GT(maxWeight) {
+			primary, maxWeight = o.Option, o.Weight
+		}
+	}
+	return primary
+}
+
+// Returns whether 2 sets of weighted options are equal
+func (options WeightedVoteOptions) Equals(other WeightedVoteOptions) bool {
+	if len(options) != len(other) {
+		return false
+	}
+	for i := range options {
+		if options[i].Option != other[i].Option || !options[i].Weight.Equal(other[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+func (options WeightedVoteOptions) String() string {
+	var strs []string
+	for _, o := range options {
+		strs = append(strs, o.String())
+	}
+	return strings.Join(strs, ",")
+}
+
+//-----------------------------------------------------------
+// Refund policy
+
+// RefundTier is the share of the deposit refunded to the proposer of a rejected
+// proposal whose participation reaches Participation
+type RefundTier struct {
+	Participation sdk.Dec `json:"participation"`
+	RefundRate    sdk.Dec `json:"refund_rate"`
+}
+
+// RefundTiers are sorted by participation, the highest tier reached applies
+type RefundTiers []RefundTier
+
+func (tiers RefundTiers) String() string {
+	var out []string
+	for _, tier := range tiers {
+		out = append(out, fmt.Sprintf("%s:%s", tier.Participation.String(), tier.RefundRate.String()))
+	}
+	return strings.Join(out, "|")
+}
+
+// the refund rate of the highest tier reached by the participation, 0 if none is reached
+func (tiers RefundTiers) RefundRate(participation sdk.Dec) sdk.Dec {
+	rate := sdk.ZeroDec()
+	for _, tier := range tiers {
+		if participation.LT(tier.Participation) {
+			break
+		}
+		rate = tier.RefundRate
+	}
+	return rate
+}
+
+// RefundPolicy decides the shares of the deposits refunded when a proposal is rejected or dropped.
+// The passed proposals, and the rejected ones if there is no participation tier, burn a share of
+// the deposits equal to 20% of the minimum deposit, split among the depositors
+type RefundPolicy struct {
+	ParticipationTiers  RefundTiers `json:"participation_tiers"`   //  Refund rates of the proposer of a rejected proposal by participation
+	VetoBurnRate        sdk.Dec     `json:"veto_burn_rate"`        //  Share of the deposits burned when a proposal is vetoed
+	SupporterRefundRate sdk.Dec     `json:"supporter_refund_rate"` //  Minimum refund rate of the depositors other than the proposer
+}
+
+// the format is "tiers=<participation>:<rate>|<participation>:<rate>;veto_burn_rate=<rate>;supporter_refund_rate=<rate>",
+// eg. "tiers=0:0.5|0.25:0.8|0.5:1;veto_burn_rate=1;supporter_refund_rate=0.5"
+func (rp RefundPolicy) String() string {
+	return fmt.Sprintf("tiers=%s;veto_burn_rate=%s;supporter_refund_rate=%s",
+		rp.ParticipationTiers, rp.VetoBurnRate.String(), rp.SupporterRefundRate.String())
+}
+
+// ParseRefundPolicy parses the policy from the format of RefundPolicy.String, the omitted rules
+// are the ones of the default policy
+func ParseRefundPolicy(str string) (RefundPolicy, error) {
+	rp := DefaultRefundPolicy()
+	for _, field := range strings.Split(str, ";") {
+		if len(strings.TrimSpace(field)) == 0 {
+			continue
+		}
+		nameValue := strings.Split(strings.TrimSpace(field), "=")
+		if len(nameValue) != 2 {
+			return rp, fmt.Errorf("%s is not a valid refund rule, expected <rule>=<value>", field)
+		}
+
+		var err error
+		switch value := nameValue[1]; nameValue[0] {
+		case "tiers":
+			rp.ParticipationTiers = RefundTiers{}
+			if len(value) == 0 {
+				continue
+			}
+			for _, item := range strings.Split(value, "|") {
+				tierStr := strings.Split(item, ":")
+				if len(tierStr) != 2 {
+					return rp, fmt.Errorf("%s is not a valid refund tier, expected <participation>:<rate>", item)
+				}
+				var tier RefundTier
+				if tier.Participation, err = sdk.NewDecFromStr(tierStr[0]); err != nil {
+					return rp, fmt.Errorf("invalid participation %s", tierStr[0])
+				}
+				if tier.RefundRate, err = sdk.NewDecFromStr(tierStr[1]); err != nil {
+					return rp, fmt.Errorf("invalid refund rate %s", tierStr[1])
+				}
+				rp.ParticipationTiers = append(rp.ParticipationTiers, tier)
+			}
+		case "veto_burn_rate":
+			if rp.VetoBurnRate, err = sdk.NewDecFromStr(value); err != nil {
+				return rp, fmt.Errorf("invalid veto_burn_rate %s", value)
+			}
+		case "supporter_refund_rate":
+			if rp.SupporterRefundRate, err = sdk.NewDecFromStr(value); err != nil {
+				return rp, fmt.Errorf("invalid supporter_refund_rate %s", value)
+			}
+		default:
+			return rp, fmt.Errorf("unknown refund rule %s", nameValue[0])
+		}
+	}
+	return rp, nil
+}
+
+// the default policy keeps the refunds of the passed and rejected proposals and burns
+// all the deposits of the vetoed and dropped proposals
+func DefaultRefundPolicy() RefundPolicy {
+	return RefundPolicy{
+		ParticipationTiers:  RefundTiers{},
+		VetoBurnRate:        sdk.OneDec(),
+		SupporterRefundRate: sdk.ZeroDec(),
+	}
+}
+
+func isValidRate(rate sdk.Dec) bool {
+	return !rate.IsNil() && !rate.IsNegative() && rate.LTE(sdk.OneDec())
+}
+
+// the shares of the deposits refunded to the proposer and to the other depositors,
+// legacy is true if the deposits are refunded by the rule of the passed proposals
+func (rp RefundPolicy) refundRates(result ProposalResult, participation sdk.Dec) (proposerRate, supporterRate sdk.Dec, legacy bool) {
+	switch {
+	case result == PASS || (result == REJECT && len(rp.ParticipationTiers) == 0):
+		return sdk.OneDec(), sdk.OneDec(), true
+	case result == REJECT:
+		proposerRate = rp.ParticipationTiers.RefundRate(participation)
+	case result == REJECTVETO:
+		proposerRate = sdk.OneDec().Sub(rp.VetoBurnRate)
+	default:
+		proposerRate = sdk.ZeroDec()
+	}
+
+	supporterRate = proposerRate
+	if supporterRate.LT(rp.SupporterRefundRate) {
+		supporterRate = rp.SupporterRefundRate
+	}
+	return proposerRate, supporterRate, false
+}
+
+// DepositRefund is the settlement of a deposit when its proposal ends
+type DepositRefund struct {
+	Depositor sdk.AccAddress `json:"depositor"`
+	Deposit   sdk.Coins      `json:"deposit"`
+	Refund    sdk.Coins      `json:"refund"`
+	Burn      sdk.Coins      `json:"burn"`
+}
+
+func (dr DepositRefund) String() string {
+	return fmt.Sprintf("Deposit by %s: %s, refund %s, burn %s", dr.Depositor, dr.Deposit.String(), dr.Refund.String(), dr.Burn.String())
+}
+
+// RefundOutcome is the settlement of the deposits on a proposal for a result
+type RefundOutcome struct {
+	ProposalID    uint64          `json:"proposal_id"`
+	Result        ProposalResult  `json:"result"`
+	Participation sdk.Dec         `json:"participation"`
+	Refunds       []DepositRefund `json:"refunds"`
+}
+
+func (ro RefundOutcome) String() string {
+	out := fmt.Sprintf(`Refund Outcome of Proposal %d:
+  Result:         %s
+  Participation:  %s
+  Refunds:`, ro.ProposalID, ro.Result, ro.Participation.String())
+	for _, refund := range ro.Refunds {
+		out += fmt.Sprintf("\n    %s", refund)
+	}
+	return out
+}
@@ -40,6 +40,12 @@ const (
 	CodeInvalidUpgradeParams         sdk.CodeType = 28
 	CodeEmptyParam                   sdk.CodeType = 29
 	CodeInvalidParamNum              sdk.CodeType = 30
+	CodeInvalidExecutionMsg          sdk.CodeType = 31
+	CodeInvalidRepresentative        sdk.CodeType = 32
+	CodeNotProposer                  sdk.CodeType = 33
+	CodeInvalidAmendment             sdk.CodeType = 34
+	CodeInvalidGuardianChange        sdk.CodeType = 35
+	CodeInvalidParamSchedule         sdk.CodeType = 36
 )
 
 //----------------------------------------
@@ -162,3 +168,31 @@ func ErrInvalidUpgradeThreshold(codespace sdk.CodespaceType, Threshold sdk.Dec)
 func ErrNotEnoughInitialDeposit(codespace sdk.CodespaceType, initialDeposit sdk.Coins, minDeposit sdk.Coins) sdk.Error {
 	return sdk.NewError(codespace, CodeNotEnoughInitialDeposit, fmt.Sprintf("Initial Deposit [%s] is less than minInitialDeposit [%s]", initialDeposit.String(), minDeposit.String()))
 }
+
+func ErrInvalidExecutionMsg(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExecutionMsg, msg)
+}
+
+func ErrInvalidRepresentative(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRepresentative, msg)
+}
+
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' is not a valid weighted vote, the distinct options should have positive weights summing up to 1", options))
+}
+
+func ErrNotProposer(codespace sdk.CodespaceType, address sdk.AccAddress, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotProposer, fmt.Sprintf("Address %s is not the proposer of the proposal %d", address, proposalID))
+}
+
+func ErrInvalidAmendment(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAmendment, msg)
+}
+
+func ErrInvalidGuardianChange(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGuardianChange, msg)
+}
+
+func ErrInvalidParamSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamSchedule, msg)
+}
@@ -10,3 +10,9 @@ type AssetKeeper interface {
 	IssueToken(ctx sdk.Context, token exported.FungibleToken) (sdk.Tags, sdk.Error)
 	HasToken(ctx sdk.Context, tokenId string) bool
 }
+
+// Router expected protocol router, which routes the messages of the passed
+// MsgExecution proposals to their handlers
+type Router interface {
+	Route(path string) (h sdk.Handler)
+}
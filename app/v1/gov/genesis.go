@@ -8,7 +8,8 @@ const StartingProposalID = 1
 
 // GenesisState - all gov state that must be provided at genesis
 type GenesisState struct {
-	Params GovParams `json:"params"` // inflation params
+	Params          GovParams        `json:"params"`          // inflation params
+	Representatives []Representation `json:"representatives"` // voting representatives of the delegators
 }
 
 func NewGenesisState(systemHaltPeriod int64, params GovParams) GenesisState {
@@ -40,13 +41,20 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 
 	k.SetSystemHaltHeight(ctx, -1)
 	k.SetParamSet(ctx, data.Params)
+
+	for _, r := range data.Representatives {
+		if err := k.SetRepresentative(ctx, r.Delegator, r.Representative); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis - output genesis parameters
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 
 	return GenesisState{
-		Params: k.GetParamSet(ctx),
+		Params:          k.GetParamSet(ctx),
+		Representatives: k.GetAllRepresentations(ctx),
 	}
 }
 
@@ -1,5 +1,11 @@
 package gov
 
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
 //-----------------------------------------------------------
 // ProposalLevel
 
@@ -26,3 +32,55 @@ func (p ProposalLevel) string() string {
 		return " "
 	}
 }
+
+// String to ProposalLevel byte, the names are the prefixes of the level parameters
+func ProposalLevelFromString(str string) (ProposalLevel, error) {
+	switch str {
+	case CRITICAL:
+		return ProposalLevelCritical, nil
+	case IMPORTANT:
+		return ProposalLevelImportant, nil
+	case NORMAL:
+		return ProposalLevelNormal, nil
+	default:
+		return ProposalLevelNil, errors.Errorf("'%s' is not a valid proposal level", str)
+	}
+}
+
+// is defined ProposalLevel?
+func ValidProposalLevel(p ProposalLevel) bool {
+	return p == ProposalLevelCritical || p == ProposalLevelImportant || p == ProposalLevelNormal
+}
+
+func (p ProposalLevel) String() string {
+	switch p {
+	case ProposalLevelCritical:
+		return CRITICAL
+	case ProposalLevelImportant:
+		return IMPORTANT
+	case ProposalLevelNormal:
+		return NORMAL
+	default:
+		return ""
+	}
+}
+
+// Marshals to JSON using string
+func (p ProposalLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// Unmarshals from JSON assuming the name of the level
+func (p *ProposalLevel) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	level, err := ProposalLevelFromString(s)
+	if err != nil {
+		return err
+	}
+	*p = level
+	return nil
+}
@@ -14,12 +14,23 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgSubmitProposal,
 			MsgSubmitSoftwareUpgradeProposal,
 			MsgSubmitTokenAdditionProposal,
-			MsgSubmitCommunityTaxUsageProposal:
+			MsgSubmitCommunityTaxUsageProposal,
+			MsgSubmitMsgExecutionProposal,
+			MsgSubmitCommunityBudgetProposal,
+			MsgSubmitGuardianProposal:
 			return handleMsgSubmitProposal(ctx, keeper, msg)
 		case MsgDeposit:
 			return handleMsgDeposit(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+		case MsgWeightedVote:
+			return handleMsgWeightedVote(ctx, keeper, msg)
+		case MsgSetRepresentative:
+			return handleMsgSetRepresentative(ctx, keeper, msg)
+		case MsgCancelProposal:
+			return handleMsgCancelProposal(ctx, keeper, msg)
+		case MsgAmendProposal:
+			return handleMsgAmendProposal(ctx, keeper, msg)
 		default:
 			errMsg := "Unrecognized gov msg type"
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -78,3 +89,69 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 		Tags: resTags,
 	}
 }
+
+func handleMsgWeightedVote(ctx sdk.Context, keeper Keeper, msg MsgWeightedVote) sdk.Result {
+
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposalIDBytes := []byte(strconv.FormatUint(msg.ProposalID, 10))
+
+	resTags := sdk.NewTags(
+		tags.Voter, []byte(msg.Voter.String()),
+		tags.ProposalID, proposalIDBytes,
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgSetRepresentative(ctx sdk.Context, keeper Keeper, msg MsgSetRepresentative) sdk.Result {
+
+	err := keeper.SetRepresentative(ctx, msg.Delegator, msg.Representative)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Delegator, []byte(msg.Delegator.String()),
+		tags.Representative, []byte(msg.Representative.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgCancelProposal(ctx sdk.Context, keeper Keeper, msg MsgCancelProposal) sdk.Result {
+
+	err := keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Proposer, []byte(msg.Proposer.String()),
+		tags.ProposalID, []byte(strconv.FormatUint(msg.ProposalID, 10)),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgAmendProposal(ctx sdk.Context, keeper Keeper, msg MsgAmendProposal) sdk.Result {
+
+	err := keeper.AmendProposal(ctx, msg.ProposalID, msg.Proposer, msg.Title, msg.Description, msg.Params)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Proposer, []byte(msg.Proposer.String()),
+		tags.ProposalID, []byte(strconv.FormatUint(msg.ProposalID, 10)),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
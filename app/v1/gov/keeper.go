@@ -2,6 +2,7 @@ package gov
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/gov/tags"
 	"time"
@@ -56,6 +57,9 @@ type Keeper struct {
 	metrics *Metrics
 
 	ak AssetKeeper
+
+	// The router to execute the messages of MsgExecution proposals
+	router Router
 }
 
 // NewProtocolKeeper returns a governance keeper. It handles:
@@ -63,7 +67,7 @@ type Keeper struct {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
-func NewKeeper(key sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, paramsKeeper params.Keeper, protocolKeeper sdk.ProtocolKeeper, ck bank.Keeper, dk distribution.Keeper, guardianKeeper guardian.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, metrics *Metrics, ak AssetKeeper) Keeper {
+func NewKeeper(key sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, paramsKeeper params.Keeper, protocolKeeper sdk.ProtocolKeeper, ck bank.Keeper, dk distribution.Keeper, guardianKeeper guardian.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, metrics *Metrics, ak AssetKeeper, router Router) Keeper {
 	return Keeper{
 		key,
 		cdc,
@@ -78,6 +82,7 @@ func NewKeeper(key sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, p
 		codespace,
 		metrics,
 		ak,
+		router,
 	}
 }
 
@@ -91,11 +96,14 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, msg sdk.Msg) (sdk.Tags, sdk
 	if err != nil {
 		return nil, err
 	}
-	pLevel := proposal.GetProposalLevel()
+	// the level is fixed at the submission, so changing the levels of the proposal kinds
+	// does not affect the proposals in progress
+	pLevel := keeper.getNewProposalLevel(ctx, proposal)
+	proposal.SetProposalLevel(pLevel)
 
 	// validate MinInitialDeposit
 	initialDeposit := content.GetInitialDeposit()
-	minInitialDeposit := keeper.getMinInitialDeposit(ctx, pLevel)
+	minInitialDeposit := keeper.getMinInitialDeposit(ctx, proposal)
 	if !initialDeposit.IsAllGTE(minInitialDeposit) {
 		return nil, ErrNotEnoughInitialDeposit(DefaultCodespace, initialDeposit, minInitialDeposit)
 	}
@@ -104,6 +112,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, msg sdk.Msg) (sdk.Tags, sdk
 	if err := proposal.Validate(ctx, keeper, true); err != nil {
 		return nil, err
 	}
+	if num, ok := keeper.HasReachedTheMaxProposalKindNum(ctx, proposal.GetProposalType()); ok {
+		return nil, ErrMoreThanMaxProposal(keeper.codespace, num, proposal.GetProposalType().String())
+	}
 
 	//fill proposal field
 	proposalID, err := keeper.getNewProposalID(ctx)
@@ -128,6 +139,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, msg sdk.Msg) (sdk.Tags, sdk
 
 	//add proposal number
 	keeper.AddProposalNum(ctx, pLevel, proposal.GetProposalID())
+	keeper.AddProposalKindNum(ctx, proposal.GetProposalType())
 
 	//return tag
 	proposalIDBytes := []byte(strconv.FormatUint(proposal.GetProposalID(), 10))
@@ -288,11 +300,89 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	keeper.SetValidatorSet(ctx, proposal.GetProposalID())
 }
 
+// Cancels a proposal in deposit or voting period on behalf of its proposer,
+// a CancelBurnRate share of the deposits is burned and the rest refunded
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	if !proposal.GetProposer().Equals(proposer) {
+		return ErrNotProposer(keeper.codespace, proposer, proposalID)
+	}
+	status := proposal.GetStatus()
+	if status != StatusDepositPeriod && status != StatusVotingPeriod {
+		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+
+	keeper.burnDeposits(ctx, proposalID, keeper.GetCancelBurnRate(ctx))
+	if status == StatusVotingPeriod {
+		keeper.deleteVotes(ctx, proposalID)
+		keeper.DeleteValidatorSet(ctx, proposalID)
+	}
+	keeper.SubProposalNum(ctx, proposal.GetProposalLevel())
+	keeper.SubProposalKindNum(ctx, proposal.GetProposalType())
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.Logger().Info("Proposal canceled by the proposer", "ProposalID", proposalID, "proposer", proposer.String())
+	return nil
+}
+
+// Amends the title, description or parameter change of a proposal in deposit period,
+// the empty fields are left unchanged
+func (keeper Keeper) AmendProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress, title, description string, params Params) sdk.Error {
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	if !proposal.GetProposer().Equals(proposer) {
+		return ErrNotProposer(keeper.codespace, proposer, proposalID)
+	}
+	if proposal.GetStatus() != StatusDepositPeriod {
+		return ErrNotInDepositPeriod(keeper.codespace, proposalID)
+	}
+
+	if len(title) != 0 {
+		proposal.SetTitle(title)
+	}
+	if len(description) != 0 {
+		proposal.SetDescription(description)
+	}
+	if len(params) != 0 {
+		pp, ok := proposal.(*ParameterProposal)
+		if !ok {
+			return ErrInvalidAmendment(keeper.codespace, fmt.Sprintf("%s proposal %d has no params to amend", proposal.GetProposalType(), proposalID))
+		}
+		pp.Params = params
+	}
+
+	if err := proposal.Validate(ctx, keeper, false); err != nil {
+		return err
+	}
+	keeper.SetProposal(ctx, proposal)
+	return nil
+}
+
 // =====================================================
 // Votes
 
 // Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+	if !ValidVoteOption(option) {
+		return ErrInvalidVote(keeper.codespace, option)
+	}
+	return keeper.addVote(ctx, proposalID, voterAddr, option, nil)
+}
+
+// Adds a split vote, the voting power of the voter is cast for each option according to its weight
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
+	if !ValidWeightedVoteOptions(options) {
+		return ErrInvalidWeightedVote(keeper.codespace, options)
+	}
+	return keeper.addVote(ctx, proposalID, voterAddr, options.PrimaryOption(), options)
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption, options WeightedVoteOptions) sdk.Error {
 	proposal := keeper.GetProposal(ctx, proposalID)
 	if proposal == nil {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -308,7 +398,7 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			isDelegator = true
 			return isDelegator
 		})
-		if !isDelegator {
+		if !isDelegator && !keeper.IsRepresentative(ctx, voterAddr) {
 			return ErrOnlyValidatorOrDelegatorVote(keeper.codespace, voterAddr)
 		}
 	}
@@ -317,23 +407,20 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrAlreadyVote(keeper.codespace, voterAddr, proposalID)
 	}
 
-	if !ValidVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
-	}
-
 	vote := Vote{
 		ProposalID: proposalID,
 		Voter:      voterAddr,
 		Option:     option,
+		Options:    options,
 	}
 	keeper.setVote(ctx, proposalID, voterAddr, vote)
+	keeper.markVotedProposal(ctx, proposalID)
 	if validator != nil {
 		keeper.metrics.Vote.With(ValidatorLabel, validator.GetConsAddr().String(), ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(float64(option))
 	}
 	return nil
 }
 
-// Gets the vote of a specific voter on a specific proposal
 func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (Vote, bool) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := store.Get(KeyVote(proposalID, voterAddr))
@@ -362,6 +449,17 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store.Delete(KeyVote(proposalID, voterAddr))
 }
 
+// Deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+	defer votesIterator.Close()
+
+	for ; votesIterator.Valid(); votesIterator.Next() {
+		store.Delete(votesIterator.Key())
+	}
+}
+
 // =====================================================
 // Deposits
 
@@ -411,7 +509,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit tipped proposal into voting period
 	// Active voting period if so
 	activatedVotingPeriod := false
-	if proposal.GetTotalDeposit().IsAllGTE(keeper.GetDepositProcedure(ctx, proposal.GetProposalLevel()).MinDeposit) {
+	if proposal.GetTotalDeposit().IsAllGTE(keeper.GetProposalDepositProcedure(ctx, proposal).MinDeposit) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -435,50 +533,125 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) sdk.Iterato
 	return sdk.KVStorePrefixIterator(store, KeyDepositsSubspace(proposalID))
 }
 
-// Returns and deletes all the deposits on a specific proposal
-func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-	depositsIterator := keeper.GetDeposits(ctx, proposalID)
-	defer depositsIterator.Close()
+// Returns and deletes all the deposits on a specific proposal, a share of the deposits
+// equal to BurnRate of the minimum deposit is burned
+func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) sdk.Tags {
+	proposal := keeper.GetProposal(ctx, proposalID)
+	return keeper.settleDeposits(ctx, keeper.GetRefundOutcome(ctx, proposal, PASS, EmptyTallyResult()))
+}
+
+// Returns the settlement of the deposits on a proposal if it ends with the result
+func (keeper Keeper) GetRefundOutcome(ctx sdk.Context, proposal Proposal, result ProposalResult, tallyResults TallyResult) RefundOutcome {
+	participation := sdk.ZeroDec()
+	if tallyResults.SystemVotingPower.IsPositive() {
+		voted := tallyResults.Yes.Add(tallyResults.Abstain).Add(tallyResults.No).Add(tallyResults.NoWithVeto)
+		participation = voted.Quo(tallyResults.SystemVotingPower)
+	}
+	outcome := RefundOutcome{
+		ProposalID:    proposal.GetProposalID(),
+		Result:        result,
+		Participation: participation,
+		Refunds:       []DepositRefund{},
+	}
+
+	var deposits []Deposit
 	depositSum := sdk.Coins{}
-	deposits := []*Deposit{}
+	depositsIterator := keeper.GetDeposits(ctx, proposal.GetProposalID())
+	defer depositsIterator.Close()
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
-		deposit := &Deposit{}
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), deposit)
+		var deposit Deposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), &deposit)
 		deposits = append(deposits, deposit)
 		depositSum = depositSum.Add(deposit.Amount)
-		store.Delete(depositsIterator.Key())
+	}
+	if len(deposits) == 0 {
+		return outcome
+	}
+
+	proposerRate, supporterRate, legacy := keeper.GetRefundPolicy(ctx).refundRates(result, participation)
+	if legacy {
+		// burn a BurnRate share of the minimum deposit, split among the depositors
+		BurnAmountDec := sdk.NewDecFromInt(keeper.GetProposalDepositProcedure(ctx, proposal).MinDeposit.AmountOf(stakeTypes.StakeDenom)).Mul(BurnRate)
+		DepositSumInt := depositSum.AmountOf(stakeTypes.StakeDenom)
+		rate := BurnAmountDec.Quo(sdk.NewDecFromInt(DepositSumInt))
+		for _, deposit := range deposits {
+			AmountDec := sdk.NewDecFromInt(deposit.Amount.AmountOf(stakeTypes.StakeDenom))
+			RefundAmountInt := AmountDec.Sub(AmountDec.Mul(rate)).RoundInt()
+			refund := sdk.Coins{sdk.NewCoin(stakeTypes.StakeDenom, RefundAmountInt)}
+			outcome.Refunds = append(outcome.Refunds, newDepositRefund(deposit, refund))
+		}
+		return outcome
 	}
 
-	proposal := keeper.GetProposal(ctx, proposalID)
-	BurnAmountDec := sdk.NewDecFromInt(keeper.GetDepositProcedure(ctx, proposal.GetProposalLevel()).MinDeposit.AmountOf(stakeTypes.StakeDenom)).Mul(BurnRate)
-	DepositSumInt := depositSum.AmountOf(stakeTypes.StakeDenom)
-	rate := BurnAmountDec.Quo(sdk.NewDecFromInt(DepositSumInt))
-	RefundSumInt := sdk.NewInt(0)
 	for _, deposit := range deposits {
-		AmountDec := sdk.NewDecFromInt(deposit.Amount.AmountOf(stakeTypes.StakeDenom))
-		RefundAmountInt := AmountDec.Sub(AmountDec.Mul(rate)).RoundInt()
-		RefundSumInt = RefundSumInt.Add(RefundAmountInt)
-		deposit.Amount = sdk.Coins{sdk.NewCoin(stakeTypes.StakeDenom, RefundAmountInt)}
-
-		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), deposit.Depositor.String(), deposit.Amount.String(), sdk.GovDepositRefundFlow, "")
-		_, err := keeper.ck.SendCoins(ctx, auth.GovDepositCoinsAccAddr, deposit.Depositor, deposit.Amount)
-		if err != nil {
-			panic(err)
+		refundRate := supporterRate
+		if deposit.Depositor.Equals(proposal.GetProposer()) {
+			refundRate = proposerRate
+		}
+		var refund sdk.Coins
+		for _, coin := range deposit.Amount {
+			amount := sdk.NewDecFromInt(coin.Amount).Mul(refundRate).TruncateInt()
+			if amount.IsPositive() {
+				refund = append(refund, sdk.NewCoin(coin.Denom, amount))
+			}
 		}
+		outcome.Refunds = append(outcome.Refunds, newDepositRefund(deposit, refund))
 	}
+	return outcome
+}
 
-	burnCoin := sdk.NewCoin(stakeTypes.StakeDenom, DepositSumInt.Sub(RefundSumInt))
-	ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), "", burnCoin.String(), sdk.GovDepositBurnFlow, "")
-	_, err := keeper.ck.BurnCoins(ctx, auth.GovDepositCoinsAccAddr, sdk.Coins{burnCoin})
-	if err != nil {
-		panic(err)
+func newDepositRefund(deposit Deposit, refund sdk.Coins) DepositRefund {
+	if refund == nil {
+		refund = sdk.Coins{}
+	}
+	return DepositRefund{
+		Depositor: deposit.Depositor,
+		Deposit:   deposit.Amount,
+		Refund:    refund,
+		Burn:      deposit.Amount.Sub(refund),
 	}
+}
 
+// Refunds and burns the deposits by the outcome, the deposits are deleted
+func (keeper Keeper) settleDeposits(ctx sdk.Context, outcome RefundOutcome) sdk.Tags {
+	resTags := sdk.NewTags()
+	store := ctx.KVStore(keeper.storeKey)
+	for _, refund := range outcome.Refunds {
+		if !refund.Refund.IsZero() {
+			ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), refund.Depositor.String(), refund.Refund.String(), sdk.GovDepositRefundFlow, "")
+			_, err := keeper.ck.SendCoins(ctx, auth.GovDepositCoinsAccAddr, refund.Depositor, refund.Refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if !refund.Burn.IsZero() {
+			ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), "", refund.Burn.String(), sdk.GovDepositBurnFlow, "")
+			_, err := keeper.ck.BurnCoins(ctx, auth.GovDepositCoinsAccAddr, refund.Burn)
+			if err != nil {
+				panic(err)
+			}
+		}
+		store.Delete(KeyDeposit(outcome.ProposalID, refund.Depositor))
+
+		resTags = resTags.AppendTags(sdk.NewTags(
+			tags.Action, tags.ActionDepositSettled,
+			tags.ProposalID, []byte(strconv.FormatUint(outcome.ProposalID, 10)),
+			tags.Depositor, []byte(refund.Depositor.String()),
+			tags.Refund, []byte(refund.Refund.String()),
+			tags.Burn, []byte(refund.Burn.String()),
+		))
+	}
+	return resTags
+}
+
+// get the refund policy from the global param store
+func (keeper Keeper) GetRefundPolicy(ctx sdk.Context) (policy RefundPolicy) {
+	keeper.paramSpace.Get(ctx, KeyRefundPolicy, &policy)
+	return
 }
 
-// Deletes all the deposits on a specific proposal without refunding them
-func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
+// Burns a share of all the deposits on a specific proposal and refunds the rest, the deposits are deleted
+func (keeper Keeper) burnDeposits(ctx sdk.Context, proposalID uint64, burnRate sdk.Dec) {
 	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 	defer depositsIterator.Close()
@@ -487,10 +660,28 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 		deposit := &Deposit{}
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), deposit)
 
-		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), "", deposit.Amount.String(), sdk.GovDepositBurnFlow, "")
-		_, err := keeper.ck.BurnCoins(ctx, auth.GovDepositCoinsAccAddr, deposit.Amount)
-		if err != nil {
-			panic(err)
+		var refund sdk.Coins
+		for _, coin := range deposit.Amount {
+			amount := sdk.NewDecFromInt(coin.Amount).Mul(sdk.OneDec().Sub(burnRate)).TruncateInt()
+			if amount.IsPositive() {
+				refund = append(refund, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		burn := deposit.Amount.Sub(refund)
+
+		if !refund.IsZero() {
+			ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), deposit.Depositor.String(), refund.String(), sdk.GovDepositRefundFlow, "")
+			_, err := keeper.ck.SendCoins(ctx, auth.GovDepositCoinsAccAddr, deposit.Depositor, refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if !burn.IsZero() {
+			ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.GovDepositCoinsAccAddr.String(), "", burn.String(), sdk.GovDepositBurnFlow, "")
+			_, err := keeper.ck.BurnCoins(ctx, auth.GovDepositCoinsAccAddr, burn)
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		store.Delete(depositsIterator.Key())
@@ -506,6 +697,30 @@ func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.T
 	return store.Iterator(PrefixActiveProposalQueue, sdk.PrefixEndBytes(PrefixActiveProposalQueueTime(endTime)))
 }
 
+// Marks a proposal as voted in the current block, to be checked for an expedited pass in the end block
+func (keeper Keeper) markVotedProposal(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(proposalID)
+	store.Set(KeyVotedProposal(proposalID), bz)
+}
+
+// Gets the proposals still in voting period which have been voted in the current block, and clears the marks
+func (keeper Keeper) popVotedProposals(ctx sdk.Context) (proposals []Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixVotedProposal)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposalID uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposalID)
+		store.Delete(iterator.Key())
+		if proposal := keeper.GetProposal(ctx, proposalID); proposal != nil && proposal.GetStatus() == StatusVotingPeriod {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return proposals
+}
+
 // Inserts a ProposalID into the active proposal queue at endTime
 func (keeper Keeper) InsertActiveProposalQueue(ctx sdk.Context, endTime time.Time, proposalID uint64) {
 	keeper.metrics.ProposalStatus.With(ProposalIDLabel, strconv.FormatUint(proposalID, 10)).Set(1)
@@ -676,6 +891,16 @@ func (keeper Keeper) GetSystemHaltPeriod(ctx sdk.Context) (SystemHaltPeriod int6
 	return
 }
 
+func (keeper Keeper) GetCancelBurnRate(ctx sdk.Context) (cancelBurnRate sdk.Dec) {
+	keeper.paramSpace.Get(ctx, KeyCancelBurnRate, &cancelBurnRate)
+	return
+}
+
+func (keeper Keeper) GetExpeditedThreshold(ctx sdk.Context) (expeditedThreshold sdk.Dec) {
+	keeper.paramSpace.Get(ctx, KeyExpeditedThreshold, &expeditedThreshold)
+	return
+}
+
 // get inflation params from the global param store
 func (keeper Keeper) GetParamSet(ctx sdk.Context) GovParams {
 	var params GovParams
@@ -688,8 +913,8 @@ func (keeper Keeper) SetParamSet(ctx sdk.Context, params GovParams) {
 	keeper.paramSpace.SetParamSet(ctx, &params)
 }
 
-func (keeper Keeper) getMinInitialDeposit(ctx sdk.Context, proposalLevel ProposalLevel) sdk.Coins {
-	minDeposit := keeper.GetDepositProcedure(ctx, proposalLevel).MinDeposit
+func (keeper Keeper) getMinInitialDeposit(ctx sdk.Context, proposal Proposal) sdk.Coins {
+	minDeposit := keeper.GetProposalDepositProcedure(ctx, proposal).MinDeposit
 	minDepositInt := sdk.NewDecFromInt(minDeposit.AmountOf(stakeTypes.StakeDenom)).Mul(MinDepositRate).RoundInt()
 	return sdk.Coins{sdk.NewCoin(stakeTypes.StakeDenom, minDepositInt)}
 }
@@ -17,6 +17,7 @@ var (
 	KeyNextProposalID           = []byte("newProposalID")
 	PrefixActiveProposalQueue   = []byte("activeProposalQueue")
 	PrefixInactiveProposalQueue = []byte("inactiveProposalQueue")
+	PrefixVotedProposal         = []byte("votedProposal")
 )
 
 // Key for getting a specific proposal from the store
@@ -78,15 +79,49 @@ func KeyInactiveProposalQueueProposal(endTime time.Time, proposalID uint64) []by
 	}, KeyDelimiter)
 }
 
+// Key of a proposal voted in the current block
+func KeyVotedProposal(proposalID uint64) []byte {
+	return bytes.Join([][]byte{PrefixVotedProposal, sdk.Uint64ToBigEndian(proposalID)}, KeyDelimiter)
+}
+
 // Key for getting a the next available proposalID from the store
 var (
 	KeySystemHaltHeight     = []byte("SystemHaltHeight")
 	KeyCriticalProposal     = []byte("CriticalProposal")
 	KeyImportantProposalNum = []byte("ImportantProposalNum")
 	KeyNormalProposalNum    = []byte("NormalProposalNum")
+	PrefixProposalKindNum   = []byte("ProposalKindNum")
 	PrefixValidatorSet      = []byte("vs")
+
+	PrefixRepresentative       = []byte("representative")
+	PrefixRepresentedDelegator = []byte("representedDelegator")
 )
 
+// Key of the number of the proposals of a kind in the deposit period or the voting period
+func KeyProposalKindNum(kind ProposalKind) []byte {
+	return bytes.Join([][]byte{PrefixProposalKindNum, {byte(kind)}}, KeyDelimiter)
+}
+
 func KeyValidatorSet(proposalID uint64) []byte {
 	return bytes.Join([][]byte{PrefixValidatorSet, sdk.Uint64ToBigEndian(proposalID)}, KeyDelimiter)
 }
+
+// Key for getting the voting representative of a delegator
+func KeyRepresentative(delegator sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{PrefixRepresentative, delegator}, KeyDelimiter)
+}
+
+// Key for getting the representatives of all the delegators
+func KeyRepresentativesSubspace() []byte {
+	return bytes.Join([][]byte{PrefixRepresentative, {}}, KeyDelimiter)
+}
+
+// Key for getting all the delegators represented by a representative
+func KeyRepresentedDelegatorsSubspace(representative sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{PrefixRepresentedDelegator, representative, {}}, KeyDelimiter)
+}
+
+// Key of a delegator in the index of its representative
+func KeyRepresentedDelegator(representative, delegator sdk.AccAddress) []byte {
+	return append(KeyRepresentedDelegatorsSubspace(representative), delegator...)
+}
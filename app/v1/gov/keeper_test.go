@@ -0,0 +1,226 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelProposal(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	ctx := getTestContext(mapp, keeper)
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(5), nil)
+	_, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	proposalID := uint64(1)
+	require.Equal(t, StatusDepositPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// only the proposer can cancel the proposal
+	require.NotNil(t, keeper.CancelProposal(ctx, proposalID, addrs[1]))
+	require.NotNil(t, keeper.CancelProposal(ctx, proposalID+1, addrs[0]))
+
+	require.Nil(t, keeper.CancelProposal(ctx, proposalID, addrs[0]))
+	require.Nil(t, keeper.GetProposal(ctx, proposalID))
+	_, found := keeper.GetDeposit(ctx, proposalID, addrs[0])
+	require.False(t, found)
+
+	// 20% of the deposit is burned and the rest is refunded
+	coins := mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins()
+	require.True(t, coins.IsEqual(irisCoins(1042).Sub(irisCoins(1))), coins.String())
+
+	// a canceled proposal can not be canceled again
+	require.NotNil(t, keeper.CancelProposal(ctx, proposalID, addrs[0]))
+}
+
+func TestAmendProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 2)
+	ctx := getTestContext(mapp, keeper)
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{100})
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(5), nil)
+	_, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	proposalID := uint64(1)
+
+	require.NotNil(t, keeper.AmendProposal(ctx, proposalID, addrs[1], "Amended", "", nil))
+	// a plain text proposal has no params to amend
+	require.NotNil(t, keeper.AmendProposal(ctx, proposalID, addrs[0], "", "", Params{Param{}}))
+
+	// the empty fields are left unchanged
+	require.Nil(t, keeper.AmendProposal(ctx, proposalID, addrs[0], "Amended", "", nil))
+	proposal := keeper.GetProposal(ctx, proposalID)
+	require.Equal(t, "Amended", proposal.GetTitle())
+	require.Equal(t, "test description", proposal.GetDescription())
+
+	// the proposal can not be amended after the deposit period
+	err, votingStarted := keeper.AddDeposit(ctx, proposalID, addrs[1], irisCoins(5))
+	require.Nil(t, err)
+	require.True(t, votingStarted)
+	require.NotNil(t, keeper.AmendProposal(ctx, proposalID, addrs[0], "Amended again", "", nil))
+	require.Equal(t, "Amended", keeper.GetProposal(ctx, proposalID).GetTitle())
+}
+
+func TestExpeditedPass(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 4)
+	ctx := getTestContext(mapp, keeper)
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{45, 44, 10, 1})
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(10), nil)
+	_, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	proposalID := uint64(1)
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// 89% of the system voting power is below the expedited threshold of 90%
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+	EndBlocker(ctx, keeper)
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// the proposal is not tallied again in a block without votes
+	params := keeper.GetParamSet(ctx)
+	params.ExpeditedThreshold = sdk.NewDecWithPrec(85, 2)
+	keeper.SetParamSet(ctx, params)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// 90% of the system voting power reaches the expedited threshold
+	params.ExpeditedThreshold = sdk.NewDecWithPrec(9, 1)
+	keeper.SetParamSet(ctx, params)
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[3], OptionYes))
+	EndBlocker(ctx, keeper)
+	proposal := keeper.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusPassed, proposal.GetStatus())
+	require.Equal(t, ctx.BlockHeader().Time, proposal.GetVotingEndTime())
+}
+
+func TestRefundTiers(t *testing.T) {
+	policy, err := ParseRefundPolicy("tiers=0:0.5|0.25:0.8|0.5:1")
+	require.Nil(t, err)
+	tiers := policy.ParticipationTiers
+
+	require.True(t, tiers.RefundRate(sdk.ZeroDec()).Equal(sdk.NewDecWithPrec(5, 1)))
+	require.True(t, tiers.RefundRate(sdk.NewDecWithPrec(2499, 4)).Equal(sdk.NewDecWithPrec(5, 1)))
+	require.True(t, tiers.RefundRate(sdk.NewDecWithPrec(25, 2)).Equal(sdk.NewDecWithPrec(8, 1)))
+	require.True(t, tiers.RefundRate(sdk.NewDecWithPrec(4999, 4)).Equal(sdk.NewDecWithPrec(8, 1)))
+	require.True(t, tiers.RefundRate(sdk.NewDecWithPrec(5, 1)).Equal(sdk.OneDec()))
+	require.True(t, tiers.RefundRate(sdk.OneDec()).Equal(sdk.OneDec()))
+
+	// no refund below the lowest tier
+	policy, err = ParseRefundPolicy("tiers=0.1:0.5")
+	require.Nil(t, err)
+	require.True(t, policy.ParticipationTiers.RefundRate(sdk.NewDecWithPrec(5, 2)).IsZero())
+}
+
+// the tally result of a participation in percent of 100 voting power
+func participationTallyResult(percent int64) TallyResult {
+	tallyResults := EmptyTallyResult()
+	tallyResults.No = sdk.NewDec(percent)
+	tallyResults.SystemVotingPower = sdk.NewDec(100)
+	return tallyResults
+}
+
+// the share of 4iris refunded at the rate
+func refundOf4Iris(rate sdk.Dec) sdk.Coins {
+	amount := sdk.NewDecFromInt(irisCoins(4)[0].Amount).Mul(rate).TruncateInt()
+	return sdk.Coins{sdk.NewCoin(sdk.IrisAtto, amount)}
+}
+
+func TestGetRefundOutcome(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	ctx := getTestContext(mapp, keeper)
+
+	policy, err := ParseRefundPolicy("tiers=0:0.5|0.25:0.8|0.5:1;veto_burn_rate=1;supporter_refund_rate=0.5")
+	require.Nil(t, err)
+	params := keeper.GetParamSet(ctx)
+	params.RefundPolicy = policy
+	keeper.SetParamSet(ctx, params)
+	require.Equal(t, policy.String(), keeper.GetRefundPolicy(ctx).String())
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(4), nil)
+	_, err = keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	err, _ = keeper.AddDeposit(ctx, 1, addrs[1], irisCoins(4))
+	require.Nil(t, err)
+	proposal := keeper.GetProposal(ctx, 1)
+
+	half, rate08 := sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(8, 1)
+	cases := []struct {
+		result         ProposalResult
+		tallyResults   TallyResult
+		proposerRefund sdk.Coins
+		supportRefund  sdk.Coins
+	}{
+		{REJECT, participationTallyResult(24), refundOf4Iris(half), refundOf4Iris(half)},
+		{REJECT, participationTallyResult(25), refundOf4Iris(rate08), refundOf4Iris(rate08)},
+		{REJECT, participationTallyResult(49), refundOf4Iris(rate08), refundOf4Iris(rate08)},
+		{REJECT, participationTallyResult(50), irisCoins(4), irisCoins(4)},
+		// the supporters are refunded at least SupporterRefundRate
+		{REJECTVETO, participationTallyResult(60), sdk.Coins{}, refundOf4Iris(half)},
+		{DROP, EmptyTallyResult(), sdk.Coins{}, refundOf4Iris(half)},
+		// 20% of the minimum deposit of 10iris is burned, split among the depositors
+		{PASS, participationTallyResult(60), irisCoins(3), irisCoins(3)},
+	}
+	for i, c := range cases {
+		outcome := keeper.GetRefundOutcome(ctx, proposal, c.result, c.tallyResults)
+		require.Equal(t, c.result, outcome.Result)
+		require.Len(t, outcome.Refunds, 2, "case %d", i)
+		for _, refund := range outcome.Refunds {
+			expected := c.supportRefund
+			if refund.Depositor.Equals(addrs[0]) {
+				expected = c.proposerRefund
+			}
+			require.True(t, refund.Refund.IsEqual(expected), "case %d: %s", i, refund)
+			require.True(t, refund.Refund.Add(refund.Burn).IsEqual(irisCoins(4)), "case %d: %s", i, refund)
+		}
+	}
+
+	// the rejected proposals are refunded as the passed ones without participation tiers
+	params.RefundPolicy = DefaultRefundPolicy()
+	keeper.SetParamSet(ctx, params)
+	outcome := keeper.GetRefundOutcome(ctx, proposal, REJECT, participationTallyResult(10))
+	for _, refund := range outcome.Refunds {
+		require.True(t, refund.Refund.IsEqual(irisCoins(3)), refund.String())
+	}
+	outcome = keeper.GetRefundOutcome(ctx, proposal, DROP, EmptyTallyResult())
+	for _, refund := range outcome.Refunds {
+		require.True(t, refund.Refund.Empty(), refund.String())
+		require.True(t, refund.Burn.IsEqual(irisCoins(4)), refund.String())
+	}
+}
+
+func TestSettleDeposits(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	ctx := getTestContext(mapp, keeper)
+
+	policy, err := ParseRefundPolicy("tiers=0:0.5;veto_burn_rate=1;supporter_refund_rate=0.5")
+	require.Nil(t, err)
+	params := keeper.GetParamSet(ctx)
+	params.RefundPolicy = policy
+	keeper.SetParamSet(ctx, params)
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(4), nil)
+	_, err = keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	err, _ = keeper.AddDeposit(ctx, 1, addrs[1], irisCoins(4))
+	require.Nil(t, err)
+
+	outcome := keeper.GetRefundOutcome(ctx, keeper.GetProposal(ctx, 1), REJECTVETO, participationTallyResult(60))
+	resTags := keeper.settleDeposits(ctx, outcome)
+	require.NotEmpty(t, resTags)
+
+	// the proposer's deposit is burned and half of the supporter's is refunded
+	coins := mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins()
+	require.True(t, coins.IsEqual(irisCoins(1038)), coins.String())
+	coins = mapp.AccountKeeper.GetAccount(ctx, addrs[1]).GetCoins()
+	require.True(t, coins.IsEqual(irisCoins(1040)), coins.String())
+	require.True(t, mapp.AccountKeeper.GetAccount(ctx, auth.GovDepositCoinsAccAddr).GetCoins().Empty())
+
+	for _, addr := range addrs {
+		_, found := keeper.GetDeposit(ctx, 1, addr)
+		require.False(t, found)
+	}
+}
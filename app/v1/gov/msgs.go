@@ -1,8 +1,10 @@
 package gov
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/irisnet/irishub/app/v1/asset/exported"
+	"github.com/irisnet/irishub/app/v1/distribution"
 
 	sdk "github.com/irisnet/irishub/types"
 )
@@ -10,7 +12,7 @@ import (
 // name to idetify transaction types
 const MsgRoute = "gov"
 
-var _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgSubmitCommunityTaxUsageProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgSubmitCommunityTaxUsageProposal{}, MsgSubmitMsgExecutionProposal{}, MsgDeposit{}, MsgVote{}, MsgWeightedVote{}, MsgSetRepresentative{}, MsgCancelProposal{}, MsgAmendProposal{}
 
 type Content interface {
 	sdk.Msg
@@ -94,6 +96,19 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 		if len(msg.Params) > 1 {
 			return ErrInvalidParamNum(DefaultCodespace)
 		}
+		if err := validateParamSchedule(msg.Params[0]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateParamSchedule(param Param) sdk.Error {
+	if param.ActivationHeight < 0 || param.ExpiryHeight < 0 {
+		return ErrInvalidParamSchedule(DefaultCodespace, "the activation height and the expiry height can not be negative")
+	}
+	if param.ExpiryHeight != 0 && param.ExpiryHeight <= param.ActivationHeight {
+		return ErrInvalidParamSchedule(DefaultCodespace, fmt.Sprintf("expiry height %d must be greater than activation height %d", param.ExpiryHeight, param.ActivationHeight))
 	}
 	return nil
 }
@@ -206,6 +221,76 @@ func (msg MsgSubmitCommunityTaxUsageProposal) GetSignBytes() []byte {
 	return sdk.MustSortJSON(b)
 }
 
+type MsgSubmitCommunityBudgetProposal struct {
+	MsgSubmitProposal
+	Recipient       sdk.AccAddress `json:"recipient"`
+	Amount          sdk.Coins      `json:"amount"`
+	StartHeight     int64          `json:"start_height"`
+	EndHeight       int64          `json:"end_height"`
+	TrancheInterval int64          `json:"tranche_interval"`
+}
+
+func NewMsgSubmitCommunityBudgetProposal(msgSubmitProposal MsgSubmitProposal, recipient sdk.AccAddress, amount sdk.Coins, startHeight, endHeight, trancheInterval int64) MsgSubmitCommunityBudgetProposal {
+	return MsgSubmitCommunityBudgetProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		Recipient:         recipient,
+		Amount:            amount,
+		StartHeight:       startHeight,
+		EndHeight:         endHeight,
+		TrancheInterval:   trancheInterval,
+	}
+}
+
+func (msg MsgSubmitCommunityBudgetProposal) ValidateBasic() sdk.Error {
+	err := msg.MsgSubmitProposal.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if msg.ProposalType != ProposalTypeCommunityBudget {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	return distribution.ValidateBudgetStream(msg.Recipient, msg.Amount, msg.StartHeight, msg.EndHeight, msg.TrancheInterval)
+}
+
+func (msg MsgSubmitCommunityBudgetProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+type MsgSubmitGuardianProposal struct {
+	MsgSubmitProposal
+	Change GuardianChange `json:"change"`
+}
+
+func NewMsgSubmitGuardianProposal(msgSubmitProposal MsgSubmitProposal, change GuardianChange) MsgSubmitGuardianProposal {
+	return MsgSubmitGuardianProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		Change:            change,
+	}
+}
+
+func (msg MsgSubmitGuardianProposal) ValidateBasic() sdk.Error {
+	err := msg.MsgSubmitProposal.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if msg.ProposalType != ProposalTypeGuardian {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	return ValidateGuardianChange(msg.Change)
+}
+
+func (msg MsgSubmitGuardianProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
 //-----------------------------------------------------------
 // MsgDeposit
 type MsgDeposit struct {
@@ -322,6 +407,215 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
 
+//-----------------------------------------------------------
+// MsgWeightedVote
+type MsgWeightedVote struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  options chosen by the voter, the weights sum to 1
+}
+
+func NewMsgWeightedVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgWeightedVote {
+	return MsgWeightedVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgWeightedVote) Route() string { return MsgRoute }
+func (msg MsgWeightedVote) Type() string  { return "weighted_vote" }
+
+// Implements Msg.
+func (msg MsgWeightedVote) ValidateBasic() sdk.Error {
+	if len(msg.Voter.Bytes()) == 0 {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+	return nil
+}
+
+func (msg MsgWeightedVote) String() string {
+	return fmt.Sprintf("MsgWeightedVote{%v - %s}", msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgWeightedVote) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgWeightedVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+//-----------------------------------------------------------
+// MsgSetRepresentative
+type MsgSetRepresentative struct {
+	Delegator      sdk.AccAddress `json:"delegator"`      // address of the delegator
+	Representative sdk.AccAddress `json:"representative"` // address voting on behalf of the delegator, empty to remove the representative
+}
+
+func NewMsgSetRepresentative(delegator, representative sdk.AccAddress) MsgSetRepresentative {
+	return MsgSetRepresentative{
+		Delegator:      delegator,
+		Representative: representative,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgSetRepresentative) Route() string { return MsgRoute }
+func (msg MsgSetRepresentative) Type() string  { return "set_representative" }
+
+// Implements Msg.
+func (msg MsgSetRepresentative) ValidateBasic() sdk.Error {
+	if len(msg.Delegator.Bytes()) == 0 {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.Representative.Equals(msg.Delegator) {
+		return ErrInvalidRepresentative(DefaultCodespace, "a delegator can not represent itself")
+	}
+	return nil
+}
+
+func (msg MsgSetRepresentative) String() string {
+	return fmt.Sprintf("MsgSetRepresentative{%s - %s}", msg.Delegator, msg.Representative)
+}
+
+// Implements Msg.
+func (msg MsgSetRepresentative) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgSetRepresentative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+//-----------------------------------------------------------
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer"`    // address of the proposer
+}
+
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{
+		ProposalID: proposalID,
+		Proposer:   proposer,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return MsgRoute }
+func (msg MsgCancelProposal) Type() string  { return "cancel_proposal" }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if len(msg.Proposer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf("MsgCancelProposal{%v - %s}", msg.ProposalID, msg.Proposer)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+//-----------------------------------------------------------
+// MsgAmendProposal
+type MsgAmendProposal struct {
+	ProposalID  uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer    sdk.AccAddress `json:"proposer"`    // address of the proposer
+	Title       string         `json:"title"`       // new title of the proposal, empty to keep it
+	Description string         `json:"description"` // new description of the proposal, empty to keep it
+	Params      Params         `json:"params"`      // new parameter change of a parameter proposal, empty to keep it
+}
+
+func NewMsgAmendProposal(proposer sdk.AccAddress, proposalID uint64, title, description string, params Params) MsgAmendProposal {
+	return MsgAmendProposal{
+		ProposalID:  proposalID,
+		Proposer:    proposer,
+		Title:       title,
+		Description: description,
+		Params:      params,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgAmendProposal) Route() string { return MsgRoute }
+func (msg MsgAmendProposal) Type() string  { return "amend_proposal" }
+
+// Implements Msg.
+func (msg MsgAmendProposal) ValidateBasic() sdk.Error {
+	if len(msg.Proposer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	if len(msg.Title) == 0 && len(msg.Description) == 0 && len(msg.Params) == 0 {
+		return ErrInvalidAmendment(DefaultCodespace, "nothing to amend")
+	}
+	if len(msg.Title) > 70 {
+		return sdk.ErrInvalidLength(DefaultCodespace, CodeInvalidProposal, "title", len(msg.Title), 70)
+	}
+	if len(msg.Description) > 280 {
+		return sdk.ErrInvalidLength(DefaultCodespace, CodeInvalidProposal, "description", len(msg.Description), 280)
+	}
+	if len(msg.Params) > 1 {
+		return ErrInvalidParamNum(DefaultCodespace)
+	}
+	if len(msg.Params) == 1 {
+		return validateParamSchedule(msg.Params[0])
+	}
+	return nil
+}
+
+func (msg MsgAmendProposal) String() string {
+	return fmt.Sprintf("MsgAmendProposal{%v - %s, %s, %v}", msg.ProposalID, msg.Title, msg.Description, msg.Params)
+}
+
+// Implements Msg.
+func (msg MsgAmendProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgAmendProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
 func (msg MsgSubmitProposal) EnsureLength() sdk.Error {
 	if len(msg.Title) > 70 {
 		return sdk.ErrInvalidLength(DefaultCodespace, CodeInvalidProposal, "title", len(msg.Title), 70)
@@ -371,3 +665,47 @@ func (msg MsgSubmitTokenAdditionProposal) GetSignBytes() []byte {
 	}
 	return sdk.MustSortJSON(b)
 }
+
+type MsgSubmitMsgExecutionProposal struct {
+	MsgSubmitProposal
+	Msgs []sdk.Msg `json:"msgs"`
+}
+
+func NewMsgSubmitMsgExecutionProposal(msgSubmitProposal MsgSubmitProposal, msgs []sdk.Msg) MsgSubmitMsgExecutionProposal {
+	return MsgSubmitMsgExecutionProposal{
+		MsgSubmitProposal: msgSubmitProposal,
+		Msgs:              msgs,
+	}
+}
+
+func (msg MsgSubmitMsgExecutionProposal) ValidateBasic() sdk.Error {
+	err := msg.MsgSubmitProposal.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if msg.ProposalType != ProposalTypeMsgExecution {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	return validateExecutionMsgs(msg.Msgs)
+}
+
+// the contained msgs belong to other modules and are unknown to msgCdc,
+// so their own sign bytes are embedded instead
+func (msg MsgSubmitMsgExecutionProposal) GetSignBytes() []byte {
+	proposal, err := msgCdc.MarshalJSON(msg.MsgSubmitProposal)
+	if err != nil {
+		panic(err)
+	}
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = json.RawMessage(m.GetSignBytes())
+	}
+	b, err := json.Marshal(struct {
+		Proposal json.RawMessage   `json:"proposal"`
+		Msgs     []json.RawMessage `json:"msgs"`
+	}{proposal, msgs})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
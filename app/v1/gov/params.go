@@ -63,8 +63,22 @@ var (
 	KeyNormalPenalty       = []byte(NORMAL + "Penalty")
 
 	KeySystemHaltPeriod = []byte("SystemHaltPeriod")
+
+	KeyCancelBurnRate     = []byte("CancelBurnRate")
+	KeyExpeditedThreshold = []byte("ExpeditedThreshold")
+
+	KeyProposalKindLevels = []byte("ProposalKindLevels")
+	KeyProposalKindRules  = []byte("ProposalKindRules")
+	KeyRefundPolicy       = []byte("RefundPolicy")
 )
 
+// the parameters which can be changed by the parameter proposals
+var governableKeys = map[string]bool{
+	string(KeyProposalKindLevels): true,
+	string(KeyProposalKindRules):  true,
+	string(KeyRefundPolicy):       true,
+}
+
 // ParamTable for mint module
 func ParamTypeTable() params.TypeTable {
 	return params.NewTypeTable().RegisterParamSet(&GovParams{})
@@ -100,11 +114,24 @@ type GovParams struct {
 	NormalPenalty       sdk.Dec       `json:"normal_penalty"`       //  Penalty if validator does not vote
 
 	SystemHaltPeriod int64 `json:"system_halt_period"`
+
+	CancelBurnRate     sdk.Dec `json:"cancel_burn_rate"`    //  Proportion of the deposits burned when the proposer cancels a proposal
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold"` //  Minimum propotion of Yes votes in the system voting power for a proposal to pass before the voting period ends
+
+	ProposalKindLevels ProposalKindLevels `json:"proposal_kind_levels"` //  Levels of the proposal kinds overriding the built-in ones
+	ProposalKindRules  ProposalKindRules  `json:"proposal_kind_rules"`  //  Rules of the proposal kinds overriding the rules of their levels
+
+	RefundPolicy RefundPolicy `json:"refund_policy"` //  Refunds of the deposits of the rejected and dropped proposals
 }
 
 func (p GovParams) String() string {
 	return fmt.Sprintf(`Gov Params:
 System Halt Period:     %v
+Cancel Burn Rate:       %s
+Expedited Threshold:    %s
+Proposal Kind Levels:   %s
+Proposal Kind Rules:    %s
+Refund Policy:          %s
 Proposal Parameter:    [Critical]         [Important]        [Normal]
   DepositPeriod:        %v         %v        %v
   MinDeposit:           %s         %s        %s
@@ -114,7 +141,8 @@ Proposal Parameter:    [Critical]         [Important]        [Normal]
   Veto:                 %s         %s        %s
   Participation:        %s         %s        %s
   Penalty:              %s         %s        %s
-`, p.SystemHaltPeriod,
+`, p.SystemHaltPeriod, p.CancelBurnRate.String(), p.ExpeditedThreshold.String(),
+		p.ProposalKindLevels.String(), p.ProposalKindRules.String(), p.RefundPolicy.String(),
 		p.CriticalDepositPeriod, p.ImportantDepositPeriod, p.NormalDepositPeriod,
 		p.CriticalMinDeposit.String(), p.ImportantMinDeposit.String(), p.NormalMinDeposit.String(),
 		p.CriticalVotingPeriod, p.ImportantVotingPeriod, p.NormalVotingPeriod,
@@ -160,11 +188,49 @@ func (p *GovParams) KeyValuePairs() params.KeyValuePairs {
 		{KeyNormalPenalty, &p.NormalPenalty},
 
 		{KeySystemHaltPeriod, &p.SystemHaltPeriod},
+
+		{KeyCancelBurnRate, &p.CancelBurnRate},
+		{KeyExpeditedThreshold, &p.ExpeditedThreshold},
+
+		{KeyProposalKindLevels, &p.ProposalKindLevels},
+		{KeyProposalKindRules, &p.ProposalKindRules},
+		{KeyRefundPolicy, &p.RefundPolicy},
 	}
 }
 
+// only the levels and the rules of the proposal kinds and the refund policy can be changed by the parameter proposals
 func (p *GovParams) Validate(key string, value string) (interface{}, sdk.Error) {
-	return nil, nil
+	switch key {
+	case string(KeyProposalKindLevels):
+		levels, err := ParseProposalKindLevels(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateProposalKindLevels(levels); err != nil {
+			return nil, err
+		}
+		return levels, nil
+	case string(KeyProposalKindRules):
+		rules, err := ParseProposalKindRules(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateProposalKindRules(rules); err != nil {
+			return nil, err
+		}
+		return rules, nil
+	case string(KeyRefundPolicy):
+		policy, err := ParseRefundPolicy(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateRefundPolicy(policy); err != nil {
+			return nil, err
+		}
+		return policy, nil
+	default:
+		return nil, sdk.NewError(params.DefaultCodespace, params.CodeInvalidKey, fmt.Sprintf("%s is not found or can not be changed", key))
+	}
 }
 
 func (p *GovParams) StringFromBytes(cdc *codec.Codec, key string, bytes []byte) (string, error) {
@@ -247,13 +313,29 @@ func (p *GovParams) StringFromBytes(cdc *codec.Codec, key string, bytes []byte)
 	case string(KeySystemHaltPeriod):
 		err := cdc.UnmarshalJSON(bytes, &p.SystemHaltPeriod)
 		return strconv.FormatInt(p.SystemHaltPeriod, 10), err
+
+	case string(KeyCancelBurnRate):
+		err := cdc.UnmarshalJSON(bytes, &p.CancelBurnRate)
+		return p.CancelBurnRate.String(), err
+	case string(KeyExpeditedThreshold):
+		err := cdc.UnmarshalJSON(bytes, &p.ExpeditedThreshold)
+		return p.ExpeditedThreshold.String(), err
+	case string(KeyProposalKindLevels):
+		err := cdc.UnmarshalJSON(bytes, &p.ProposalKindLevels)
+		return p.ProposalKindLevels.String(), err
+	case string(KeyProposalKindRules):
+		err := cdc.UnmarshalJSON(bytes, &p.ProposalKindRules)
+		return p.ProposalKindRules.String(), err
+	case string(KeyRefundPolicy):
+		err := cdc.UnmarshalJSON(bytes, &p.RefundPolicy)
+		return p.RefundPolicy.String(), err
 	default:
 		return "", fmt.Errorf("%s is not existed", key)
 	}
 }
 
 func (p *GovParams) ReadOnly() bool {
-	return true
+	return false
 }
 
 // default minting module parameters
@@ -291,6 +373,14 @@ func DefaultParams() GovParams {
 			NormalParticipation: sdk.NewDecWithPrec(50, 2),
 			NormalPenalty:       sdk.ZeroDec(),
 			SystemHaltPeriod:    20000,
+
+			CancelBurnRate:     sdk.NewDecWithPrec(2, 1),
+			ExpeditedThreshold: sdk.NewDecWithPrec(8, 1),
+
+			ProposalKindLevels: ProposalKindLevels{},
+			ProposalKindRules:  ProposalKindRules{},
+
+			RefundPolicy: DefaultRefundPolicy(),
 		}
 	} else {
 		return GovParams{
@@ -321,6 +411,14 @@ func DefaultParams() GovParams {
 			NormalParticipation: sdk.NewDecWithPrec(50, 2),
 			NormalPenalty:       sdk.ZeroDec(),
 			SystemHaltPeriod:    60,
+
+			CancelBurnRate:     sdk.NewDecWithPrec(2, 1),
+			ExpeditedThreshold: sdk.NewDecWithPrec(8, 1),
+
+			ProposalKindLevels: ProposalKindLevels{},
+			ProposalKindRules:  ProposalKindRules{},
+
+			RefundPolicy: DefaultRefundPolicy(),
 		}
 	}
 }
@@ -358,6 +456,14 @@ func DefaultParamsForTest() GovParams {
 		NormalParticipation: sdk.NewDecWithPrec(75, 2),
 		NormalPenalty:       sdk.ZeroDec(),
 		SystemHaltPeriod:    60,
+
+		CancelBurnRate:     sdk.NewDecWithPrec(2, 1),
+		ExpeditedThreshold: sdk.NewDecWithPrec(9, 1),
+
+		ProposalKindLevels: ProposalKindLevels{},
+		ProposalKindRules:  ProposalKindRules{},
+
+		RefundPolicy: DefaultRefundPolicy(),
 	}
 }
 
@@ -436,6 +542,23 @@ func validateParams(p GovParams) sdk.Error {
 		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidSystemHaltPeriod, fmt.Sprintf("SystemHaltPeriod should be between [0, 50000]"))
 	}
 
+	if p.CancelBurnRate.IsNil() || p.CancelBurnRate.LT(sdk.ZeroDec()) || p.CancelBurnRate.GT(sdk.OneDec()) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidCancelBurnRate, fmt.Sprintf("Invalid CancelBurnRate ( %s ) should be [0,1]", p.CancelBurnRate.String()))
+	}
+	if p.ExpeditedThreshold.IsNil() || p.ExpeditedThreshold.LTE(sdk.NewDecWithPrec(5, 1)) || p.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidThreshold, fmt.Sprintf("Invalid ExpeditedThreshold ( %s ) should be (0.5,1]", p.ExpeditedThreshold.String()))
+	}
+
+	if err := validateProposalKindLevels(p.ProposalKindLevels); err != nil {
+		return err
+	}
+	if err := validateProposalKindRules(p.ProposalKindRules); err != nil {
+		return err
+	}
+	if err := validateRefundPolicy(p.RefundPolicy); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -458,19 +581,26 @@ type TallyingProcedure struct {
 }
 
 func validateDepositProcedure(dp DepositProcedure, level string) sdk.Error {
-	if dp.MinDeposit[0].Denom != sdk.IrisAtto {
+	if err := validateMinDeposit(dp.MinDeposit, level); err != nil {
+		return err
+	}
+
+	if dp.MaxDepositPeriod < sdk.TwentySeconds || dp.MaxDepositPeriod > sdk.ThreeDays {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidDepositPeriod, fmt.Sprintf(level+"MaxDepositPeriod (%s) should be between 20s and %s", dp.MaxDepositPeriod.String(), sdk.ThreeDays.String()))
+	}
+	return nil
+}
+
+func validateMinDeposit(minDeposit sdk.Coins, level string) sdk.Error {
+	if len(minDeposit) != 1 || minDeposit[0].Denom != sdk.IrisAtto {
 		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMinDepositDenom, fmt.Sprintf(level+"MinDeposit denom should be %s!", sdk.IrisAtto))
 	}
 
 	LowerBound, _ := sdk.IrisCoinType.ConvertToMinDenomCoin(fmt.Sprintf("%d%s", LOWER_BOUND_AMOUNT, sdk.Iris))
 	UpperBound, _ := sdk.IrisCoinType.ConvertToMinDenomCoin(fmt.Sprintf("%d%s", UPPER_BOUND_AMOUNT, sdk.Iris))
 
-	if dp.MinDeposit[0].Amount.LT(LowerBound.Amount) || dp.MinDeposit[0].Amount.GT(UpperBound.Amount) {
-		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMinDepositAmount, fmt.Sprintf(level+"MinDepositAmount"+dp.MinDeposit[0].String()+" should be larger than 10iris and less than 10000iris"))
-	}
-
-	if dp.MaxDepositPeriod < sdk.TwentySeconds || dp.MaxDepositPeriod > sdk.ThreeDays {
-		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidDepositPeriod, fmt.Sprintf(level+"MaxDepositPeriod (%s) should be between 20s and %s", dp.MaxDepositPeriod.String(), sdk.ThreeDays.String()))
+	if minDeposit[0].Amount.LT(LowerBound.Amount) || minDeposit[0].Amount.GT(UpperBound.Amount) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMinDepositAmount, fmt.Sprintf(level+"MinDepositAmount"+minDeposit[0].String()+" should be larger than 10iris and less than 10000iris"))
 	}
 	return nil
 }
@@ -498,6 +628,24 @@ func validateTallyingProcedure(tp TallyingProcedure, level string) sdk.Error {
 	return nil
 }
 
+func validateRefundPolicy(rp RefundPolicy) sdk.Error {
+	for i, tier := range rp.ParticipationTiers {
+		if !isValidRate(tier.Participation) || !isValidRate(tier.RefundRate) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidRefundPolicy, fmt.Sprintf("Invalid refund tier ( %s:%s ) should be in [0,1]", tier.Participation, tier.RefundRate))
+		}
+		if i > 0 && tier.Participation.LTE(rp.ParticipationTiers[i-1].Participation) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidRefundPolicy, fmt.Sprintf("The refund tiers should be sorted by participation ( %s )", rp.ParticipationTiers))
+		}
+	}
+	if !isValidRate(rp.VetoBurnRate) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidRefundPolicy, fmt.Sprintf("Invalid VetoBurnRate ( %s ) should be [0,1]", rp.VetoBurnRate))
+	}
+	if !isValidRate(rp.SupporterRefundRate) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidRefundPolicy, fmt.Sprintf("Invalid SupporterRefundRate ( %s ) should be [0,1]", rp.SupporterRefundRate))
+	}
+	return nil
+}
+
 func validateMaxNum(gp GovParams) sdk.Error {
 	if gp.CriticalMaxNum != STABLE_CRITIACAL_NUM {
 		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMaxProposalNum, fmt.Sprintf("The num of Max"+CRITICAL+"Proposal [%v] can only be %v.", gp.CriticalMaxNum, STABLE_CRITIACAL_NUM))
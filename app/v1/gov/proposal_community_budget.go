@@ -0,0 +1,86 @@
+package gov
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/distribution"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Implements Proposal Interface
+var _ Proposal = (*CommunityBudgetProposal)(nil)
+
+type Budget struct {
+	Recipient       sdk.AccAddress `json:"recipient"`
+	Amount          sdk.Coins      `json:"amount"`
+	StartHeight     int64          `json:"start_height"`
+	EndHeight       int64          `json:"end_height"`
+	TrancheInterval int64          `json:"tranche_interval"`
+}
+
+func (b Budget) String() string {
+	return fmt.Sprintf(`Budget:
+    Recipient:         %s
+    Amount:            %s
+    Start Height:      %d
+    End Height:        %d
+    Tranche Interval:  %d`,
+		b.Recipient, b.Amount, b.StartHeight, b.EndHeight, b.TrancheInterval)
+}
+
+// community budget proposal, which creates a budget stream paid from the
+// community tax pool on pass
+type CommunityBudgetProposal struct {
+	BasicProposal
+	Budget Budget `json:"budget"`
+}
+
+func (bp CommunityBudgetProposal) String() string {
+	return fmt.Sprintf(`%s
+  %s`,
+		bp.BasicProposal.String(), bp.Budget.String())
+}
+
+func (bp CommunityBudgetProposal) HumanString(converter sdk.CoinsConverter) string {
+	return fmt.Sprintf(`%s
+  Budget:
+    Recipient:         %s
+    Amount:            %s
+    Start Height:      %d
+    End Height:        %d
+    Tranche Interval:  %d`,
+		bp.BasicProposal.HumanString(converter), bp.Budget.Recipient, converter.ToMainUnit(bp.Budget.Amount),
+		bp.Budget.StartHeight, bp.Budget.EndHeight, bp.Budget.TrancheInterval)
+}
+
+func (bp *CommunityBudgetProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sdk.Error {
+	if err := bp.BasicProposal.Validate(ctx, k, verify); err != nil {
+		return err
+	}
+
+	budget := bp.Budget
+	if err := distribution.ValidateBudgetStream(budget.Recipient, budget.Amount, budget.StartHeight, budget.EndHeight, budget.TrancheInterval); err != nil {
+		return err
+	}
+	if budget.EndHeight <= ctx.BlockHeight() {
+		return distribution.ErrInvalidBudgetStream(k.codespace, fmt.Sprintf("end height %d has been reached", budget.EndHeight))
+	}
+	return nil
+}
+
+func (bp *CommunityBudgetProposal) Execute(ctx sdk.Context, gk Keeper) sdk.Error {
+	logger := ctx.Logger()
+	if err := bp.Validate(ctx, gk, false); err != nil {
+		logger.Error("Execute CommunityBudgetProposal failed", "height", ctx.BlockHeight(), "proposalId", bp.ProposalID, "err", err.Error())
+		return err
+	}
+
+	budget := bp.Budget
+	stream, err := gk.dk.CreateBudgetStream(ctx, bp.ProposalID, budget.Recipient, budget.Amount, budget.StartHeight, budget.EndHeight, budget.TrancheInterval)
+	if err != nil {
+		logger.Error("Execute CommunityBudgetProposal failed", "height", ctx.BlockHeight(), "proposalId", bp.ProposalID, "err", err.Error())
+		return err
+	}
+	logger.Info("Execute CommunityBudgetProposal success", "height", ctx.BlockHeight(), "proposalId", bp.ProposalID, "stream_id", stream.ID)
+	return nil
+}
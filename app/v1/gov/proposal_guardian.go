@@ -0,0 +1,362 @@
+package gov
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/modules/guardian"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/pkg/errors"
+)
+
+type GuardianAction byte
+
+const (
+	GuardianActionAdd    GuardianAction = 0x01
+	GuardianActionRemove GuardianAction = 0x02
+	GuardianActionRotate GuardianAction = 0x03
+)
+
+// String to GuardianAction byte.  Returns ff if invalid.
+func GuardianActionFromString(str string) (GuardianAction, error) {
+	switch str {
+	case "Add":
+		return GuardianActionAdd, nil
+	case "Remove":
+		return GuardianActionRemove, nil
+	case "Rotate":
+		return GuardianActionRotate, nil
+	default:
+		return GuardianAction(0xff), errors.Errorf("'%s' is not a valid guardian action", str)
+	}
+}
+
+// is defined GuardianAction?
+func ValidGuardianAction(ga GuardianAction) bool {
+	return ga == GuardianActionAdd ||
+		ga == GuardianActionRemove ||
+		ga == GuardianActionRotate
+}
+
+// Marshal needed for protobuf compatibility
+func (ga GuardianAction) Marshal() ([]byte, error) {
+	return []byte{byte(ga)}, nil
+}
+
+// Unmarshal needed for protobuf compatibility
+func (ga *GuardianAction) Unmarshal(data []byte) error {
+	*ga = GuardianAction(data[0])
+	return nil
+}
+
+// Marshals to JSON using string
+func (ga GuardianAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ga.String())
+}
+
+// Unmarshals from JSON assuming Bech32 encoding
+func (ga *GuardianAction) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz2, err := GuardianActionFromString(s)
+	if err != nil {
+		return err
+	}
+	*ga = bz2
+	return nil
+}
+
+func (ga GuardianAction) String() string {
+	switch ga {
+	case GuardianActionAdd:
+		return "Add"
+	case GuardianActionRemove:
+		return "Remove"
+	case GuardianActionRotate:
+		return "Rotate"
+	default:
+		return ""
+	}
+}
+
+// For Printf / Sprintf, returns the action name when using %s
+// nolint: errcheck
+func (ga GuardianAction) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(ga.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(ga))))
+	}
+}
+
+type GuardianRole byte
+
+const (
+	GuardianRoleProfiler GuardianRole = 0x01
+	GuardianRoleTrustee  GuardianRole = 0x02
+)
+
+// String to GuardianRole byte.  Returns ff if invalid.
+func GuardianRoleFromString(str string) (GuardianRole, error) {
+	switch str {
+	case "Profiler":
+		return GuardianRoleProfiler, nil
+	case "Trustee":
+		return GuardianRoleTrustee, nil
+	default:
+		return GuardianRole(0xff), errors.Errorf("'%s' is not a valid guardian role", str)
+	}
+}
+
+// is defined GuardianRole?
+func ValidGuardianRole(gr GuardianRole) bool {
+	return gr == GuardianRoleProfiler ||
+		gr == GuardianRoleTrustee
+}
+
+// Marshal needed for protobuf compatibility
+func (gr GuardianRole) Marshal() ([]byte, error) {
+	return []byte{byte(gr)}, nil
+}
+
+// Unmarshal needed for protobuf compatibility
+func (gr *GuardianRole) Unmarshal(data []byte) error {
+	*gr = GuardianRole(data[0])
+	return nil
+}
+
+// Marshals to JSON using string
+func (gr GuardianRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gr.String())
+}
+
+// Unmarshals from JSON assuming Bech32 encoding
+func (gr *GuardianRole) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz2, err := GuardianRoleFromString(s)
+	if err != nil {
+		return err
+	}
+	*gr = bz2
+	return nil
+}
+
+func (gr GuardianRole) String() string {
+	switch gr {
+	case GuardianRoleProfiler:
+		return "Profiler"
+	case GuardianRoleTrustee:
+		return "Trustee"
+	default:
+		return ""
+	}
+}
+
+// For Printf / Sprintf, returns the role name when using %s
+// nolint: errcheck
+func (gr GuardianRole) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(gr.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(gr))))
+	}
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*GuardianProposal)(nil)
+
+// GuardianChange adds, removes or rotates a profiler or a trustee. ExpiryHeight sets the end
+// of the guardian's term, 0 means no term limit on add and keeps the current term on rotate
+type GuardianChange struct {
+	Action       GuardianAction `json:"action"`
+	Role         GuardianRole   `json:"role"`
+	Address      sdk.AccAddress `json:"address"`
+	NewAddress   sdk.AccAddress `json:"new_address"`
+	Description  string         `json:"description"`
+	ExpiryHeight int64          `json:"expiry_height"`
+}
+
+func (gc GuardianChange) String() string {
+	return fmt.Sprintf(`GuardianChange:
+    Action:            %s
+    Role:              %s
+    Address:           %s
+    New Address:       %s
+    Description:       %s
+    Expiry Height:     %d`,
+		gc.Action, gc.Role, gc.Address, gc.NewAddress, gc.Description, gc.ExpiryHeight)
+}
+
+// guardian proposal, which changes the profilers or the trustees on pass
+type GuardianProposal struct {
+	BasicProposal
+	Change GuardianChange `json:"change"`
+}
+
+func (gp GuardianProposal) String() string {
+	return fmt.Sprintf(`%s
+  %s`,
+		gp.BasicProposal.String(), gp.Change.String())
+}
+
+func (gp GuardianProposal) HumanString(converter sdk.CoinsConverter) string {
+	return fmt.Sprintf(`%s
+  %s`,
+		gp.BasicProposal.HumanString(converter), gp.Change.String())
+}
+
+func (gp *GuardianProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sdk.Error {
+	if err := gp.BasicProposal.Validate(ctx, k, verify); err != nil {
+		return err
+	}
+
+	change := gp.Change
+	if err := ValidateGuardianChange(change); err != nil {
+		return err
+	}
+	if change.ExpiryHeight != 0 && change.ExpiryHeight <= ctx.BlockHeight() {
+		return ErrInvalidGuardianChange(k.codespace, fmt.Sprintf("expiry height %d has been reached", change.ExpiryHeight))
+	}
+
+	_, found := k.getGuardian(ctx, change.Role, change.Address)
+	switch change.Action {
+	case GuardianActionAdd:
+		if found {
+			return ErrInvalidGuardianChange(k.codespace, fmt.Sprintf("%s %s already exists", change.Role, change.Address))
+		}
+	case GuardianActionRemove:
+		if !found {
+			return ErrInvalidGuardianChange(k.codespace, fmt.Sprintf("%s %s does not exist", change.Role, change.Address))
+		}
+		if k.countGuardians(ctx, change.Role) == 1 {
+			return ErrInvalidGuardianChange(k.codespace, fmt.Sprintf("can not remove the last %s %s", change.Role, change.Address))
+		}
+	case GuardianActionRotate:
+		if !found {
+			return ErrInvalidGuardianChange(k.codespace, fmt.Sprintf("%s %s does not exist", change.Role, change.Address))
+		}
+		if _, found := k.getGuardian(ctx, change.Role, change.NewAddress); found {
+			return ErrInvalidGuardianChange(k.codespace, fmt.Sprintf("%s %s already exists", change.Role, change.NewAddress))
+		}
+	}
+	return nil
+}
+
+func (gp *GuardianProposal) Execute(ctx sdk.Context, gk Keeper) sdk.Error {
+	logger := ctx.Logger()
+	if err := gp.Validate(ctx, gk, false); err != nil {
+		logger.Error("Execute GuardianProposal failed", "height", ctx.BlockHeight(), "proposalId", gp.ProposalID, "err", err.Error())
+		return err
+	}
+
+	change := gp.Change
+	switch change.Action {
+	case GuardianActionAdd:
+		g := guardian.NewGuardian(change.Description, guardian.Ordinary, change.Address, auth.GovModuleAccAddr)
+		g.ExpiryHeight = change.ExpiryHeight
+		gk.setGuardian(ctx, change.Role, g)
+	case GuardianActionRemove:
+		gk.deleteGuardian(ctx, change.Role, change.Address)
+	case GuardianActionRotate:
+		// the new guardian takes over the account type and the term of the rotated one
+		g, _ := gk.getGuardian(ctx, change.Role, change.Address)
+		gk.deleteGuardian(ctx, change.Role, change.Address)
+		g.Address = change.NewAddress
+		g.AddedBy = auth.GovModuleAccAddr
+		if len(change.Description) > 0 {
+			g.Description = change.Description
+		}
+		if change.ExpiryHeight != 0 {
+			g.ExpiryHeight = change.ExpiryHeight
+		}
+		gk.setGuardian(ctx, change.Role, g)
+	}
+
+	logger.Info("Execute GuardianProposal success", "height", ctx.BlockHeight(), "proposalId", gp.ProposalID,
+		"action", change.Action.String(), "role", change.Role.String(), "address", change.Address.String())
+	return nil
+}
+
+// validate a guardian change regardless of the state
+func ValidateGuardianChange(change GuardianChange) sdk.Error {
+	if !ValidGuardianAction(change.Action) {
+		return ErrInvalidGuardianChange(DefaultCodespace, fmt.Sprintf("invalid guardian action %v", byte(change.Action)))
+	}
+	if !ValidGuardianRole(change.Role) {
+		return ErrInvalidGuardianChange(DefaultCodespace, fmt.Sprintf("invalid guardian role %v", byte(change.Role)))
+	}
+	if change.Address.Empty() {
+		return ErrInvalidGuardianChange(DefaultCodespace, "guardian address is empty")
+	}
+	if change.ExpiryHeight < 0 {
+		return ErrInvalidGuardianChange(DefaultCodespace, fmt.Sprintf("expiry height %d is negative", change.ExpiryHeight))
+	}
+
+	switch change.Action {
+	case GuardianActionAdd:
+		if len(change.Description) == 0 {
+			return ErrInvalidGuardianChange(DefaultCodespace, "guardian description is empty")
+		}
+		if !change.NewAddress.Empty() {
+			return ErrInvalidGuardianChange(DefaultCodespace, "new address is only allowed when rotating a guardian")
+		}
+	case GuardianActionRemove:
+		if !change.NewAddress.Empty() || len(change.Description) > 0 || change.ExpiryHeight != 0 {
+			return ErrInvalidGuardianChange(DefaultCodespace, "only the address is allowed when removing a guardian")
+		}
+	case GuardianActionRotate:
+		if change.NewAddress.Empty() {
+			return ErrInvalidGuardianChange(DefaultCodespace, "new address is empty")
+		}
+		if change.NewAddress.Equals(change.Address) {
+			return ErrInvalidGuardianChange(DefaultCodespace, "new address is the same as the rotated one")
+		}
+	}
+	return nil
+}
+
+func (keeper Keeper) getGuardian(ctx sdk.Context, role GuardianRole, address sdk.AccAddress) (guardian.Guardian, bool) {
+	if role == GuardianRoleProfiler {
+		return keeper.guardianKeeper.GetProfiler(ctx, address)
+	}
+	return keeper.guardianKeeper.GetTrustee(ctx, address)
+}
+
+func (keeper Keeper) setGuardian(ctx sdk.Context, role GuardianRole, g guardian.Guardian) {
+	if role == GuardianRoleProfiler {
+		keeper.guardianKeeper.AddProfiler(ctx, g)
+		return
+	}
+	keeper.guardianKeeper.AddTrustee(ctx, g)
+}
+
+func (keeper Keeper) deleteGuardian(ctx sdk.Context, role GuardianRole, address sdk.AccAddress) {
+	if role == GuardianRoleProfiler {
+		keeper.guardianKeeper.DeleteProfiler(ctx, address)
+		return
+	}
+	keeper.guardianKeeper.DeleteTrustee(ctx, address)
+}
+
+func (keeper Keeper) countGuardians(ctx sdk.Context, role GuardianRole) (count int) {
+	counter := func(_ guardian.Guardian) bool {
+		count++
+		return false
+	}
+	if role == GuardianRoleProfiler {
+		keeper.guardianKeeper.IterateProfilers(ctx, counter)
+	} else {
+		keeper.guardianKeeper.IterateTrustees(ctx, counter)
+	}
+	return count
+}
@@ -0,0 +1,303 @@
+package gov
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/irisnet/irishub/app/v1/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+//-----------------------------------------------------------
+// ProposalKindLevel
+
+// ProposalKindLevel overrides the built-in level of a proposal kind
+type ProposalKindLevel struct {
+	Kind  ProposalKind  `json:"kind"`
+	Level ProposalLevel `json:"level"`
+}
+
+type ProposalKindLevels []ProposalKindLevel
+
+// the format is "<kind>:<level>,<kind>:<level>", eg. "TokenAddition:Critical,PlainText:Important"
+func (kls ProposalKindLevels) String() string {
+	var out []string
+	for _, kl := range kls {
+		out = append(out, fmt.Sprintf("%s:%s", kl.Kind, kl.Level))
+	}
+	return strings.Join(out, ",")
+}
+
+// ParseProposalKindLevels parses the levels from the format of ProposalKindLevels.String
+func ParseProposalKindLevels(str string) (ProposalKindLevels, error) {
+	kls := ProposalKindLevels{}
+	if len(strings.TrimSpace(str)) == 0 {
+		return kls, nil
+	}
+
+	for _, item := range strings.Split(str, ",") {
+		kindLevel := strings.Split(strings.TrimSpace(item), ":")
+		if len(kindLevel) != 2 {
+			return nil, fmt.Errorf("%s is not a valid proposal level, expected <kind>:<level>", item)
+		}
+		kind, err := ProposalTypeFromString(kindLevel[0])
+		if err != nil {
+			return nil, err
+		}
+		level, err := ProposalLevelFromString(kindLevel[1])
+		if err != nil {
+			return nil, err
+		}
+		kls = append(kls, ProposalKindLevel{Kind: kind, Level: level})
+	}
+	return kls, nil
+}
+
+// get the level of a proposal kind
+func (kls ProposalKindLevels) Get(kind ProposalKind) (ProposalLevel, bool) {
+	for _, kl := range kls {
+		if kl.Kind == kind {
+			return kl.Level, true
+		}
+	}
+	return ProposalLevelNil, false
+}
+
+func validateProposalKindLevels(kls ProposalKindLevels) sdk.Error {
+	kinds := make(map[ProposalKind]bool)
+	for _, kl := range kls {
+		if !ValidProposalType(kl.Kind) || !ValidProposalLevel(kl.Level) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalKindRule, fmt.Sprintf("invalid proposal level %s of %s", kl.Level, kl.Kind))
+		}
+		if kinds[kl.Kind] {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalKindRule, fmt.Sprintf("duplicate proposal level of %s", kl.Kind))
+		}
+		kinds[kl.Kind] = true
+
+		// the software upgrades and the system halts rely on the exclusiveness of the critical proposals
+		if (kl.Kind == ProposalTypeSoftwareUpgrade || kl.Kind == ProposalTypeSystemHalt) && kl.Level != ProposalLevelCritical {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalKindRule, fmt.Sprintf("the level of %s can only be %s", kl.Kind, CRITICAL))
+		}
+	}
+	return nil
+}
+
+//-----------------------------------------------------------
+// ProposalKindRule
+
+// ProposalKindRule overrides the rules of the level for the proposals of a kind,
+// a zero value keeps the rule of the level
+type ProposalKindRule struct {
+	Kind       ProposalKind `json:"kind"`
+	MinDeposit sdk.Coins    `json:"min_deposit"` //  Minimum deposit for the proposals of the kind to enter voting period
+	Quorum     sdk.Dec      `json:"quorum"`      //  Minimum participation of the system voting power
+	Threshold  sdk.Dec      `json:"threshold"`   //  Minimum propotion of Yes votes for the proposals of the kind to pass
+	MaxNum     uint64       `json:"max_num"`     //  Maximum number of the proposals of the kind in the deposit period or the voting period
+}
+
+type ProposalKindRules []ProposalKindRule
+
+func (kr ProposalKindRule) String() string {
+	var fields []string
+	if !kr.MinDeposit.Empty() {
+		fields = append(fields, "min_deposit="+kr.MinDeposit.String())
+	}
+	if !kr.Quorum.IsZero() {
+		fields = append(fields, "quorum="+kr.Quorum.String())
+	}
+	if !kr.Threshold.IsZero() {
+		fields = append(fields, "threshold="+kr.Threshold.String())
+	}
+	if kr.MaxNum != 0 {
+		fields = append(fields, "max_num="+strconv.FormatUint(kr.MaxNum, 10))
+	}
+	return fmt.Sprintf("%s:%s", kr.Kind, strings.Join(fields, ";"))
+}
+
+// the format is "<kind>:<rule>=<value>;<rule>=<value>,<kind>:...", the rules are min_deposit, quorum,
+// threshold and max_num, eg. "TokenAddition:min_deposit=5000iris;threshold=0.8,PlainText:max_num=2"
+func (krs ProposalKindRules) String() string {
+	var out []string
+	for _, kr := range krs {
+		out = append(out, kr.String())
+	}
+	return strings.Join(out, ",")
+}
+
+// ParseProposalKindRules parses the rules from the format of ProposalKindRules.String
+func ParseProposalKindRules(str string) (ProposalKindRules, error) {
+	krs := ProposalKindRules{}
+	if len(strings.TrimSpace(str)) == 0 {
+		return krs, nil
+	}
+
+	for _, item := range strings.Split(str, ",") {
+		kindRules := strings.SplitN(strings.TrimSpace(item), ":", 2)
+		if len(kindRules) != 2 {
+			return nil, fmt.Errorf("%s is not a valid proposal rule, expected <kind>:<rule>=<value>", item)
+		}
+		kind, err := ProposalTypeFromString(kindRules[0])
+		if err != nil {
+			return nil, err
+		}
+
+		kr := ProposalKindRule{Kind: kind, MinDeposit: sdk.Coins{}, Quorum: sdk.ZeroDec(), Threshold: sdk.ZeroDec()}
+		for _, field := range strings.Split(kindRules[1], ";") {
+			nameValue := strings.Split(strings.TrimSpace(field), "=")
+			if len(nameValue) != 2 {
+				return nil, fmt.Errorf("%s is not a valid proposal rule, expected <rule>=<value>", field)
+			}
+			switch value := nameValue[1]; nameValue[0] {
+			case "min_deposit":
+				coin, err := sdk.IrisCoinType.ConvertToMinDenomCoin(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid min_deposit %s", value)
+				}
+				kr.MinDeposit = sdk.Coins{coin}
+			case "quorum":
+				if kr.Quorum, err = sdk.NewDecFromStr(value); err != nil {
+					return nil, fmt.Errorf("invalid quorum %s", value)
+				}
+			case "threshold":
+				if kr.Threshold, err = sdk.NewDecFromStr(value); err != nil {
+					return nil, fmt.Errorf("invalid threshold %s", value)
+				}
+			case "max_num":
+				if kr.MaxNum, err = strconv.ParseUint(value, 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid max_num %s", value)
+				}
+			default:
+				return nil, fmt.Errorf("unknown proposal rule %s", nameValue[0])
+			}
+		}
+		krs = append(krs, kr)
+	}
+	return krs, nil
+}
+
+// get the rule of a proposal kind
+func (krs ProposalKindRules) Get(kind ProposalKind) (ProposalKindRule, bool) {
+	for _, kr := range krs {
+		if kr.Kind == kind {
+			return kr, true
+		}
+	}
+	return ProposalKindRule{}, false
+}
+
+func validateProposalKindRules(krs ProposalKindRules) sdk.Error {
+	kinds := make(map[ProposalKind]bool)
+	for _, kr := range krs {
+		if !ValidProposalType(kr.Kind) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalKindRule, fmt.Sprintf("invalid proposal kind %s", kr.Kind))
+		}
+		if kinds[kr.Kind] {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidProposalKindRule, fmt.Sprintf("duplicate proposal rule of %s", kr.Kind))
+		}
+		kinds[kr.Kind] = true
+
+		if !kr.MinDeposit.Empty() {
+			if err := validateMinDeposit(kr.MinDeposit, kr.Kind.String()); err != nil {
+				return err
+			}
+		}
+		if kr.Quorum.IsNil() || kr.Quorum.IsNegative() || kr.Quorum.GTE(sdk.OneDec()) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidParticipation, fmt.Sprintf("Invalid %s quorum ( %s ) should be [0,1)", kr.Kind, kr.Quorum))
+		}
+		if kr.Threshold.IsNil() || kr.Threshold.IsNegative() || kr.Threshold.GTE(sdk.OneDec()) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidThreshold, fmt.Sprintf("Invalid %s threshold ( %s ) should be [0,1)", kr.Kind, kr.Threshold))
+		}
+	}
+	return nil
+}
+
+//-----------------------------------------------------------
+// Keeper
+
+// get the rule overriding the rules of the level for a proposal kind
+func (keeper Keeper) GetProposalKindRule(ctx sdk.Context, kind ProposalKind) (rule ProposalKindRule, found bool) {
+	var rules ProposalKindRules
+	keeper.paramSpace.Get(ctx, KeyProposalKindRules, &rules)
+	return rules.Get(kind)
+}
+
+// the level of a new proposal, the level of its kind if it is overridden, otherwise the built-in level.
+// An override can raise the level, but never lower it below the level the proposal requires itself,
+// eg. the level of the most sensitive msg of a msg execution proposal
+func (keeper Keeper) getNewProposalLevel(ctx sdk.Context, proposal Proposal) ProposalLevel {
+	var levels ProposalKindLevels
+	keeper.paramSpace.Get(ctx, KeyProposalKindLevels, &levels)
+	level := proposal.GetProposalLevel()
+	// the lower level is the more sensitive one
+	if kindLevel, ok := levels.Get(proposal.GetProposalType()); ok && kindLevel < level {
+		level = kindLevel
+	}
+	return level
+}
+
+// Returns the Deposit Procedure of the proposal, the procedure of its level with the minimum deposit of its kind
+func (keeper Keeper) GetProposalDepositProcedure(ctx sdk.Context, proposal Proposal) DepositProcedure {
+	procedure := keeper.GetDepositProcedure(ctx, proposal.GetProposalLevel())
+	if rule, ok := keeper.GetProposalKindRule(ctx, proposal.GetProposalType()); ok && !rule.MinDeposit.Empty() {
+		procedure.MinDeposit = rule.MinDeposit
+	}
+	return procedure
+}
+
+// Returns the Tallying Procedure of the proposal, the procedure of its level with the quorum and the threshold of its kind
+func (keeper Keeper) GetProposalTallyingProcedure(ctx sdk.Context, proposal Proposal) TallyingProcedure {
+	procedure := keeper.GetTallyingProcedure(ctx, proposal.GetProposalLevel())
+	if rule, ok := keeper.GetProposalKindRule(ctx, proposal.GetProposalType()); ok {
+		if !rule.Quorum.IsZero() {
+			procedure.Participation = rule.Quorum
+		}
+		if !rule.Threshold.IsZero() {
+			procedure.Threshold = rule.Threshold
+		}
+	}
+	return procedure
+}
+
+// the number of the proposals of the kind in the deposit period or the voting period
+func (keeper Keeper) GetProposalKindNum(ctx sdk.Context, kind ProposalKind) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyProposalKindNum(kind))
+	if bz == nil {
+		return 0
+	}
+	var num uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &num)
+	return num
+}
+
+func (keeper Keeper) SetProposalKindNum(ctx sdk.Context, kind ProposalKind, num uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	if num == 0 {
+		store.Delete(KeyProposalKindNum(kind))
+		return
+	}
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(num)
+	store.Set(KeyProposalKindNum(kind), bz)
+}
+
+func (keeper Keeper) AddProposalKindNum(ctx sdk.Context, kind ProposalKind) {
+	keeper.SetProposalKindNum(ctx, kind, keeper.GetProposalKindNum(ctx, kind)+1)
+}
+
+// the proposals submitted before the counter existed are not counted, so the number never goes below zero
+func (keeper Keeper) SubProposalKindNum(ctx sdk.Context, kind ProposalKind) {
+	if num := keeper.GetProposalKindNum(ctx, kind); num > 0 {
+		keeper.SetProposalKindNum(ctx, kind, num-1)
+	}
+}
+
+// whether the number of the proposals of the kind in the deposit period or the voting period has reached its maximum
+func (keeper Keeper) HasReachedTheMaxProposalKindNum(ctx sdk.Context, kind ProposalKind) (uint64, bool) {
+	rule, ok := keeper.GetProposalKindRule(ctx, kind)
+	if !ok || rule.MaxNum == 0 {
+		return 0, false
+	}
+	num := keeper.GetProposalKindNum(ctx, kind)
+	return num, num >= rule.MaxNum
+}
@@ -0,0 +1,177 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/protocol"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProposalKindLevels(t *testing.T) {
+	levels, err := ParseProposalKindLevels("TokenAddition:Critical, PlainText:Important")
+	require.Nil(t, err)
+	require.Equal(t, ProposalKindLevels{
+		{Kind: ProposalTypeTokenAddition, Level: ProposalLevelCritical},
+		{Kind: ProposalTypePlainText, Level: ProposalLevelImportant},
+	}, levels)
+
+	parsed, err := ParseProposalKindLevels(levels.String())
+	require.Nil(t, err)
+	require.Equal(t, levels, parsed)
+
+	levels, err = ParseProposalKindLevels("")
+	require.Nil(t, err)
+	require.Empty(t, levels)
+
+	_, err = ParseProposalKindLevels("PlainText")
+	require.NotNil(t, err)
+	_, err = ParseProposalKindLevels("Unknown:Critical")
+	require.NotNil(t, err)
+	_, err = ParseProposalKindLevels("PlainText:Unknown")
+	require.NotNil(t, err)
+}
+
+func TestParseProposalKindRules(t *testing.T) {
+	rules, err := ParseProposalKindRules("TokenAddition:min_deposit=5000iris;threshold=0.8,PlainText:max_num=2")
+	require.Nil(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, ProposalTypeTokenAddition, rules[0].Kind)
+	require.True(t, rules[0].MinDeposit.IsEqual(irisCoins(5000)))
+	require.True(t, rules[0].Threshold.Equal(sdk.NewDecWithPrec(8, 1)))
+	require.True(t, rules[0].Quorum.IsZero())
+	require.Equal(t, ProposalTypePlainText, rules[1].Kind)
+	require.Equal(t, uint64(2), rules[1].MaxNum)
+	require.Empty(t, rules[1].MinDeposit)
+
+	parsed, err := ParseProposalKindRules(rules.String())
+	require.Nil(t, err)
+	require.Equal(t, rules.String(), parsed.String())
+	require.Nil(t, validateProposalKindRules(parsed))
+
+	_, err = ParseProposalKindRules("PlainText")
+	require.NotNil(t, err)
+	_, err = ParseProposalKindRules("PlainText:max_num")
+	require.NotNil(t, err)
+	_, err = ParseProposalKindRules("PlainText:unknown=1")
+	require.NotNil(t, err)
+	_, err = ParseProposalKindRules("PlainText:quorum=abc")
+	require.NotNil(t, err)
+}
+
+func TestValidateProposalKindLevels(t *testing.T) {
+	require.Nil(t, validateProposalKindLevels(ProposalKindLevels{
+		{Kind: ProposalTypeSoftwareUpgrade, Level: ProposalLevelCritical},
+		{Kind: ProposalTypePlainText, Level: ProposalLevelImportant},
+	}))
+
+	// the software upgrades and the system halts can only be critical
+	require.NotNil(t, validateProposalKindLevels(ProposalKindLevels{{Kind: ProposalTypeSoftwareUpgrade, Level: ProposalLevelImportant}}))
+	require.NotNil(t, validateProposalKindLevels(ProposalKindLevels{{Kind: ProposalTypeSystemHalt, Level: ProposalLevelNormal}}))
+
+	require.NotNil(t, validateProposalKindLevels(ProposalKindLevels{
+		{Kind: ProposalTypePlainText, Level: ProposalLevelImportant},
+		{Kind: ProposalTypePlainText, Level: ProposalLevelNormal},
+	}))
+	require.NotNil(t, validateProposalKindLevels(ProposalKindLevels{{Kind: ProposalTypePlainText, Level: ProposalLevelNil}}))
+}
+
+func TestProposalKindRuleProcedures(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1)
+	ctx := getTestContext(mapp, keeper)
+
+	params := keeper.GetParamSet(ctx)
+	params.ProposalKindLevels = ProposalKindLevels{{Kind: ProposalTypePlainText, Level: ProposalLevelImportant}}
+	params.ProposalKindRules = ProposalKindRules{{
+		Kind:       ProposalTypePlainText,
+		MinDeposit: irisCoins(20),
+		Quorum:     sdk.ZeroDec(),
+		Threshold:  sdk.NewDecWithPrec(8, 1),
+	}}
+	keeper.SetParamSet(ctx, params)
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(10), nil)
+	_, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	proposal := keeper.GetProposal(ctx, 1)
+	require.Equal(t, ProposalLevelImportant, proposal.GetProposalLevel())
+	// the minimum deposit of the kind keeps the proposal in the deposit period
+	require.Equal(t, StatusDepositPeriod, proposal.GetStatus())
+
+	depositProcedure := keeper.GetProposalDepositProcedure(ctx, proposal)
+	require.True(t, depositProcedure.MinDeposit.IsEqual(irisCoins(20)))
+	require.Equal(t, params.ImportantDepositPeriod, depositProcedure.MaxDepositPeriod)
+
+	// the zero quorum keeps the participation of the level
+	tallyingProcedure := keeper.GetProposalTallyingProcedure(ctx, proposal)
+	require.True(t, tallyingProcedure.Threshold.Equal(sdk.NewDecWithPrec(8, 1)))
+	require.True(t, tallyingProcedure.Participation.Equal(params.ImportantParticipation))
+	require.True(t, tallyingProcedure.Veto.Equal(params.ImportantVeto))
+
+	// the other kinds keep the procedures of their levels
+	proposal.SetProposalType(ProposalTypeParameter)
+	require.True(t, keeper.GetProposalDepositProcedure(ctx, proposal).MinDeposit.IsEqual(params.ImportantMinDeposit))
+	require.True(t, keeper.GetProposalTallyingProcedure(ctx, proposal).Threshold.Equal(params.ImportantThreshold))
+}
+
+func TestHasReachedTheMaxProposalKindNum(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1)
+	ctx := getTestContext(mapp, keeper)
+
+	params := keeper.GetParamSet(ctx)
+	params.ProposalKindRules = ProposalKindRules{{
+		Kind:       ProposalTypePlainText,
+		MinDeposit: sdk.Coins{},
+		Quorum:     sdk.ZeroDec(),
+		Threshold:  sdk.ZeroDec(),
+		MaxNum:     1,
+	}}
+	keeper.SetParamSet(ctx, params)
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, addrs[0], irisCoins(5), nil)
+	_, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), keeper.GetProposalKindNum(ctx, ProposalTypePlainText))
+	num, reached := keeper.HasReachedTheMaxProposalKindNum(ctx, ProposalTypePlainText)
+	require.Equal(t, uint64(1), num)
+	require.True(t, reached)
+	_, err = keeper.SubmitProposal(ctx, msg)
+	require.NotNil(t, err)
+
+	// the canceled proposal is no longer counted
+	require.Nil(t, keeper.CancelProposal(ctx, 1, addrs[0]))
+	require.Equal(t, uint64(0), keeper.GetProposalKindNum(ctx, ProposalTypePlainText))
+	_, err = keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+
+	// the counter never goes below zero
+	keeper.SubProposalKindNum(ctx, ProposalTypeParameter)
+	require.Equal(t, uint64(0), keeper.GetProposalKindNum(ctx, ProposalTypeParameter))
+}
+
+func TestGetNewProposalLevel(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 1)
+	ctx := getTestContext(mapp, keeper)
+
+	params := keeper.GetParamSet(ctx)
+	params.ProposalKindLevels = ProposalKindLevels{
+		{Kind: ProposalTypeMsgExecution, Level: ProposalLevelNormal},
+		{Kind: ProposalTypePlainText, Level: ProposalLevelImportant},
+	}
+	keeper.SetParamSet(ctx, params)
+
+	// the override does not lower the level required by the msgs
+	proposal := &MsgExecutionProposal{
+		BasicProposal: BasicProposal{ProposalType: ProposalTypeMsgExecution},
+		Msgs:          []sdk.Msg{testExecutionMsg{route: testExecutionRoute}, testExecutionMsg{route: protocol.UpgradeRoute}},
+	}
+	require.Equal(t, ProposalLevelCritical, keeper.getNewProposalLevel(ctx, proposal))
+
+	// the override raises the level
+	params.ProposalKindLevels[0].Level = ProposalLevelImportant
+	keeper.SetParamSet(ctx, params)
+	proposal.Msgs = proposal.Msgs[:1]
+	require.Equal(t, ProposalLevelImportant, keeper.getNewProposalLevel(ctx, proposal))
+	plainText := &PlainTextProposal{BasicProposal{ProposalType: ProposalTypePlainText}}
+	require.Equal(t, ProposalLevelImportant, keeper.getNewProposalLevel(ctx, plainText))
+}
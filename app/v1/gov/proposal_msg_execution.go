@@ -0,0 +1,151 @@
+package gov
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/irisnet/irishub/app/protocol"
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// maximum number of msgs carried by a single MsgExecutionProposal
+const MaxExecutionMsgNum = 16
+
+var _ Proposal = (*MsgExecutionProposal)(nil)
+
+// routes not listed here are executed at ProposalLevelNormal
+var msgRouteLevelMap = map[string]ProposalLevel{
+	protocol.UpgradeRoute:  ProposalLevelCritical,
+	protocol.GuardianRoute: ProposalLevelCritical,
+	protocol.ParamsRoute:   ProposalLevelImportant,
+	protocol.StakeRoute:    ProposalLevelImportant,
+	protocol.SlashingRoute: ProposalLevelImportant,
+	protocol.DistrRoute:    ProposalLevelImportant,
+	protocol.AssetRoute:    ProposalLevelImportant,
+	protocol.BankRoute:     ProposalLevelImportant,
+}
+
+type MsgExecutionProposal struct {
+	BasicProposal
+	Msgs []sdk.Msg `json:"msgs"`
+}
+
+func (mp MsgExecutionProposal) String() string {
+	return fmt.Sprintf(`%s
+  Msgs:               %s`,
+		mp.BasicProposal.String(), executionMsgsString(mp.Msgs))
+}
+
+func (mp MsgExecutionProposal) HumanString(converter sdk.CoinsConverter) string {
+	bps := mp.BasicProposal.HumanString(converter)
+	return fmt.Sprintf(`%s
+  Msgs:               %s`,
+		bps, executionMsgsString(mp.Msgs))
+}
+
+// the level of the proposal is the level of its most sensitive msg unless it is fixed at the submission
+func (mp *MsgExecutionProposal) GetProposalLevel() ProposalLevel {
+	if mp.Level != ProposalLevelNil {
+		return mp.Level
+	}
+	level := ProposalLevelNormal
+	for _, msg := range mp.Msgs {
+		if l := getMsgLevel(msg); l < level {
+			level = l
+		}
+	}
+	return level
+}
+
+func (mp *MsgExecutionProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sdk.Error {
+	if err := validateExecutionMsgs(mp.Msgs); err != nil {
+		return err
+	}
+	for _, msg := range mp.Msgs {
+		if k.router == nil || k.router.Route(msg.Route()) == nil {
+			return ErrInvalidExecutionMsg(k.codespace, fmt.Sprintf("no handler found for route %s", msg.Route()))
+		}
+	}
+
+	if !verify {
+		return nil
+	}
+	pLevel := mp.GetProposalLevel()
+	if num, ok := k.HasReachedTheMaxProposalNum(ctx, pLevel); ok {
+		return ErrMoreThanMaxProposal(k.codespace, num, pLevel.string())
+	}
+	return nil
+}
+
+// all msgs are executed atomically, nothing is committed if any of them fails or panics
+func (mp *MsgExecutionProposal) Execute(ctx sdk.Context, gk Keeper) (err sdk.Error) {
+	logger := ctx.Logger()
+	if err := mp.Validate(ctx, gk, false); err != nil {
+		logger.Error("Execute MsgExecutionProposal failed", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "err", err.Error())
+		return err
+	}
+
+	// a panicking handler must not halt the chain in the EndBlocker, the cached context is discarded
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Execute MsgExecutionProposal panicked", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "panic", r)
+			err = ErrInvalidExecutionMsg(gk.codespace, fmt.Sprintf("execution panicked: %v", r))
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range mp.Msgs {
+		handler := gk.router.Route(msg.Route())
+		res := handler(cacheCtx, msg)
+		if !res.IsOK() {
+			logger.Error("Execute MsgExecutionProposal failed", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "msg_index", i, "msg_type", msg.Type(), "err", res.Log)
+			return sdk.NewError(res.Codespace, res.Code, res.Log)
+		}
+	}
+	writeCache()
+
+	logger.Info("Execute MsgExecutionProposal success", "height", ctx.BlockHeight(), "proposalId", mp.ProposalID, "msg_num", len(mp.Msgs))
+	return nil
+}
+
+func getMsgLevel(msg sdk.Msg) ProposalLevel {
+	if level, ok := msgRouteLevelMap[msg.Route()]; ok {
+		return level
+	}
+	return ProposalLevelNormal
+}
+
+func validateExecutionMsgs(msgs []sdk.Msg) sdk.Error {
+	if len(msgs) == 0 {
+		return ErrInvalidExecutionMsg(DefaultCodespace, "no msg to execute")
+	}
+	if len(msgs) > MaxExecutionMsgNum {
+		return ErrInvalidExecutionMsg(DefaultCodespace, fmt.Sprintf("the number of msgs %d exceeds the maximum %d", len(msgs), MaxExecutionMsgNum))
+	}
+
+	for i, msg := range msgs {
+		if msg == nil {
+			return ErrInvalidExecutionMsg(DefaultCodespace, fmt.Sprintf("msg %d is empty", i))
+		}
+		if msg.Route() == MsgRoute {
+			return ErrInvalidExecutionMsg(DefaultCodespace, fmt.Sprintf("msg %d: governance msgs can not be executed by a proposal", i))
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(auth.GovModuleAccAddr) {
+			return ErrInvalidExecutionMsg(DefaultCodespace, fmt.Sprintf("msg %d: the only signer must be the gov module account %s", i, auth.GovModuleAccAddr))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func executionMsgsString(msgs []sdk.Msg) string {
+	var types []string
+	for _, msg := range msgs {
+		types = append(types, fmt.Sprintf("%s/%s", msg.Route(), msg.Type()))
+	}
+	return strings.Join(types, ", ")
+}
@@ -0,0 +1,102 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/protocol"
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testExecutionRoute = "testexecution"
+
+// a msg signed by the gov module account which writes its key to the gov store
+type testExecutionMsg struct {
+	route string
+	key   string
+	fail  bool
+	panic bool
+}
+
+func (msg testExecutionMsg) Route() string            { return msg.route }
+func (msg testExecutionMsg) Type() string             { return "test_execution" }
+func (msg testExecutionMsg) ValidateBasic() sdk.Error { return nil }
+func (msg testExecutionMsg) GetSignBytes() []byte     { return []byte(msg.key) }
+func (msg testExecutionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{auth.GovModuleAccAddr}
+}
+
+func testExecutionHandler(storeKey sdk.StoreKey) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		execMsg := msg.(testExecutionMsg)
+		ctx.KVStore(storeKey).Set([]byte(execMsg.key), []byte{1})
+		if execMsg.panic {
+			panic("test execution panicked")
+		}
+		if execMsg.fail {
+			return sdk.ErrInternal("test execution failed").Result()
+		}
+		return sdk.Result{}
+	}
+}
+
+func TestMsgExecutionProposalExecute(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 1)
+	ctx := getTestContext(mapp, keeper)
+	keeper.router.(protocol.Router).AddRoute(testExecutionRoute, testExecutionHandler(keeper.storeKey))
+	store := ctx.KVStore(keeper.storeKey)
+
+	// the msgs executed before a failed msg are rolled back
+	proposal := &MsgExecutionProposal{
+		BasicProposal: BasicProposal{ProposalID: 1},
+		Msgs: []sdk.Msg{
+			testExecutionMsg{route: testExecutionRoute, key: "first"},
+			testExecutionMsg{route: testExecutionRoute, key: "second"},
+			testExecutionMsg{route: testExecutionRoute, key: "third", fail: true},
+		},
+	}
+	require.NotNil(t, proposal.Execute(ctx, keeper))
+	require.False(t, store.Has([]byte("first")))
+	require.False(t, store.Has([]byte("second")))
+	require.False(t, store.Has([]byte("third")))
+
+	// all the msgs are committed if they succeed
+	proposal.Msgs = proposal.Msgs[:2]
+	require.Nil(t, proposal.Execute(ctx, keeper))
+	require.True(t, store.Has([]byte("first")))
+	require.True(t, store.Has([]byte("second")))
+
+	// a panicking msg fails the execution and rolls back the msgs executed before
+	proposal.Msgs = []sdk.Msg{
+		testExecutionMsg{route: testExecutionRoute, key: "fourth"},
+		testExecutionMsg{route: testExecutionRoute, key: "fifth", panic: true},
+	}
+	require.NotPanics(t, func() {
+		require.NotNil(t, proposal.Execute(ctx, keeper))
+	})
+	require.False(t, store.Has([]byte("fourth")))
+	require.False(t, store.Has([]byte("fifth")))
+
+	// a msg without handler is not executed
+	proposal.Msgs = []sdk.Msg{testExecutionMsg{route: "unknown", key: "unknown"}}
+	require.NotNil(t, proposal.Execute(ctx, keeper))
+	require.False(t, store.Has([]byte("unknown")))
+}
+
+func TestMsgExecutionProposalLevel(t *testing.T) {
+	proposal := &MsgExecutionProposal{
+		Msgs: []sdk.Msg{testExecutionMsg{route: testExecutionRoute}},
+	}
+	require.Equal(t, ProposalLevelNormal, proposal.GetProposalLevel())
+
+	proposal.Msgs = append(proposal.Msgs, testExecutionMsg{route: protocol.StakeRoute})
+	require.Equal(t, ProposalLevelImportant, proposal.GetProposalLevel())
+
+	proposal.Msgs = append(proposal.Msgs, testExecutionMsg{route: protocol.UpgradeRoute}, testExecutionMsg{route: protocol.BankRoute})
+	require.Equal(t, ProposalLevelCritical, proposal.GetProposalLevel())
+
+	// the level fixed at the submission is kept
+	proposal.SetProposalLevel(ProposalLevelNormal)
+	require.Equal(t, ProposalLevelNormal, proposal.GetProposalLevel())
+}
@@ -1,6 +1,11 @@
 package gov
 
-import sdk "github.com/irisnet/irishub/types"
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/params"
+	sdk "github.com/irisnet/irishub/types"
+)
 
 const (
 	Insert string = "insert"
@@ -11,6 +16,16 @@ type Param struct {
 	Subspace string `json:"subspace"`
 	Key      string `json:"key"`
 	Value    string `json:"value"`
+
+	// the change is applied at ActivationHeight if it is not reached yet when the proposal passes,
+	// and the previous value is restored at ExpiryHeight if it is not 0
+	ActivationHeight int64 `json:"activation_height,omitempty"`
+	ExpiryHeight     int64 `json:"expiry_height,omitempty"`
+}
+
+// whether the change is scheduled instead of applied right away
+func (p Param) IsScheduled() bool {
+	return p.ActivationHeight != 0 || p.ExpiryHeight != 0
 }
 
 type Params []Param
@@ -36,6 +51,15 @@ func (pp *ParameterProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sd
 	} else {
 		return ErrInvalidParam(DefaultCodespace, param.Subspace)
 	}
+
+	if param.IsScheduled() {
+		if err := k.paramsKeeper.ValidateParamChange(param.Subspace, param.Key, param.Value, param.ActivationHeight, param.ExpiryHeight); err != nil {
+			return err
+		}
+		if param.ExpiryHeight != 0 && param.ExpiryHeight <= ctx.BlockHeight() {
+			return ErrInvalidParamSchedule(k.codespace, fmt.Sprintf("expiry height %d has been reached", param.ExpiryHeight))
+		}
+	}
 	return nil
 }
 
@@ -48,12 +72,23 @@ func (pp *ParameterProposal) Execute(ctx sdk.Context, k Keeper) sdk.Error {
 		return nil
 	}
 	param := pp.Params[0]
+	if param.IsScheduled() {
+		change, err := k.paramsKeeper.ScheduleParamChange(ctx, pp.ProposalID, param.Subspace, param.Key, param.Value, param.ActivationHeight, param.ExpiryHeight)
+		if err != nil {
+			ctx.Logger().Error("Execute ParameterProposal Failed", "key", param.Key, "value", param.Value, "err", err.Error())
+			return err
+		}
+		k.SetParamChangeMetrics(ctx, params.ParamChanges{change})
+		ctx.Logger().Info("Execute ParameterProposal Success", "key", param.Key, "value", param.Value, "param_change_id", change.ID, "status", change.Status.String())
+		return nil
+	}
+
 	paramSet, _ := k.paramsKeeper.GetParamSet(param.Subspace)
 	value, _ := paramSet.Validate(param.Key, param.Value)
 	subspace, found := k.paramsKeeper.GetSubspace(param.Subspace)
 	if found {
 		SetParameterMetrics(k.metrics, param.Key, value)
-		subspace.Set(ctx, []byte(param.Key), value)
+		subspace.Set(params.WithProposalID(ctx, pp.ProposalID), []byte(param.Key), value)
 		ctx.Logger().Info("Execute ParameterProposal Success", "key", param.Key, "value", param.Value)
 	} else {
 		ctx.Logger().Info("Execute ParameterProposal Failed", "key", param.Key, "value", param.Value)
@@ -61,3 +96,13 @@ func (pp *ParameterProposal) Execute(ctx sdk.Context, k Keeper) sdk.Error {
 
 	return nil
 }
+
+// updates the parameter metrics with the values set by the scheduled parameter changes,
+// as the parameter proposals applied right away do
+func (keeper Keeper) SetParamChangeMetrics(ctx sdk.Context, changes params.ParamChanges) {
+	for _, change := range changes {
+		if value, err := keeper.paramsKeeper.GetParamChangeValue(change); err == nil {
+			SetParameterMetrics(keeper.metrics, change.Key, value)
+		}
+	}
+}
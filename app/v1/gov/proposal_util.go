@@ -115,6 +115,60 @@ func createTokenAdditionInfo() pTypeInfo {
 	}
 }
 
+func createMsgExecutionInfo() pTypeInfo {
+	return pTypeInfo{
+		ProposalTypeMsgExecution,
+		// the actual level is derived from the contained messages
+		ProposalLevelNormal,
+		func(content Content) Proposal {
+			return buildProposal(content, func(p BasicProposal, content Content) Proposal {
+				execMsg, _ := content.(MsgSubmitMsgExecutionProposal)
+				return &MsgExecutionProposal{
+					p,
+					execMsg.Msgs,
+				}
+			})
+		},
+	}
+}
+
+func createCommunityBudgetInfo() pTypeInfo {
+	return pTypeInfo{
+		ProposalTypeCommunityBudget,
+		ProposalLevelImportant,
+		func(content Content) Proposal {
+			return buildProposal(content, func(p BasicProposal, content Content) Proposal {
+				budgetMsg := content.(MsgSubmitCommunityBudgetProposal)
+				return &CommunityBudgetProposal{
+					p,
+					Budget{
+						budgetMsg.Recipient,
+						budgetMsg.Amount,
+						budgetMsg.StartHeight,
+						budgetMsg.EndHeight,
+						budgetMsg.TrancheInterval},
+				}
+			})
+		},
+	}
+}
+
+func createGuardianInfo() pTypeInfo {
+	return pTypeInfo{
+		ProposalTypeGuardian,
+		ProposalLevelCritical,
+		func(content Content) Proposal {
+			return buildProposal(content, func(p BasicProposal, content Content) Proposal {
+				guardianMsg, _ := content.(MsgSubmitGuardianProposal)
+				return &GuardianProposal{
+					p,
+					guardianMsg.Change,
+				}
+			})
+		},
+	}
+}
+
 func buildProposal(content Content, callback func(p BasicProposal, content Content) Proposal) Proposal {
 	var p = BasicProposal{
 		Title:        content.GetTitle(),
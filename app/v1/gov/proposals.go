@@ -47,6 +47,7 @@ type Proposal interface {
 	SetVotingEndTime(time.Time)
 
 	GetProposalLevel() ProposalLevel
+	SetProposalLevel(ProposalLevel)
 	GetProposer() sdk.AccAddress
 
 	String() string
@@ -72,6 +73,8 @@ type BasicProposal struct {
 	VotingStartTime time.Time      `json:"voting_start_time"` //  Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time      `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
 	Proposer        sdk.AccAddress `json:"proposer"`
+
+	Level ProposalLevel `json:"level,omitempty"` //  Level fixed at the submission, the built-in level of the proposal type if not set
 }
 
 func (bp BasicProposal) String() string {
@@ -181,15 +184,21 @@ func (bp *BasicProposal) Validate(ctx sdk.Context, k Keeper, verify bool) sdk.Er
 	if !verify {
 		return nil
 	}
-	pLevel := bp.ProposalType.GetProposalLevel()
+	pLevel := bp.GetProposalLevel()
 	if num, ok := k.HasReachedTheMaxProposalNum(ctx, pLevel); ok {
 		return ErrMoreThanMaxProposal(k.codespace, num, pLevel.string())
 	}
 	return nil
 }
 func (bp *BasicProposal) GetProposalLevel() ProposalLevel {
+	if bp.Level != ProposalLevelNil {
+		return bp.Level
+	}
 	return bp.ProposalType.GetProposalLevel()
 }
+func (bp *BasicProposal) SetProposalLevel(level ProposalLevel) {
+	bp.Level = level
+}
 
 func (bp *BasicProposal) GetProposer() sdk.AccAddress {
 	return bp.Proposer
@@ -217,6 +226,9 @@ const (
 	ProposalTypeCommunityTaxUsage ProposalKind = 0x04
 	ProposalTypePlainText         ProposalKind = 0x05
 	ProposalTypeTokenAddition     ProposalKind = 0x06
+	ProposalTypeMsgExecution      ProposalKind = 0x07
+	ProposalTypeCommunityBudget   ProposalKind = 0x08
+	ProposalTypeGuardian          ProposalKind = 0x09
 )
 
 var pTypeMap = map[string]pTypeInfo{
@@ -226,6 +238,9 @@ var pTypeMap = map[string]pTypeInfo{
 	"SystemHalt":        createSystemHaltInfo(),
 	"CommunityTaxUsage": createCommunityTaxUsageInfo(),
 	"TokenAddition":     createTokenAdditionInfo(),
+	"MsgExecution":      createMsgExecutionInfo(),
+	"CommunityBudget":   createCommunityBudgetInfo(),
+	"Guardian":          createGuardianInfo(),
 }
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -8,13 +10,16 @@ import (
 
 // query endpoints supported by the governance Querier
 const (
-	QueryProposals = "proposals"
-	QueryProposal  = "proposal"
-	QueryDeposits  = "deposits"
-	QueryDeposit   = "deposit"
-	QueryVotes     = "votes"
-	QueryVote      = "vote"
-	QueryTally     = "tally"
+	QueryProposals      = "proposals"
+	QueryProposal       = "proposal"
+	QueryDeposits       = "deposits"
+	QueryDeposit        = "deposit"
+	QueryVotes          = "votes"
+	QueryVote           = "vote"
+	QueryTally          = "tally"
+	QueryRepresentative = "representative"
+	QueryRefund         = "refund"
+	QuerySimulate       = "simulate"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -34,6 +39,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QueryRepresentative:
+			return queryRepresentative(ctx, path[1:], req, keeper)
+		case QueryRefund:
+			return queryRefund(ctx, path[1:], req, keeper)
+		case QuerySimulate:
+			return querySimulate(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -272,3 +283,91 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	}
 	return bz, nil
 }
+
+// Params for query 'custom/gov/representative'
+type QueryRepresentativeParams struct {
+	Delegator sdk.AccAddress
+}
+
+// nolint: unparam
+func queryRepresentative(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRepresentativeParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return nil, sdk.ParseParamsErr(err2)
+	}
+
+	representative, found := keeper.GetRepresentative(ctx, params.Delegator)
+	if !found {
+		return nil, ErrInvalidRepresentative(DefaultCodespace, fmt.Sprintf("delegator %s has no representative", params.Delegator))
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, Representation{Delegator: params.Delegator, Representative: representative})
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/refund'
+type QueryRefundParams struct {
+	ProposalID uint64
+}
+
+// the settlement of the deposits if the proposal ended now: a proposal in voting period is
+// tallied with the current votes, and one in deposit period has not reached the minimum deposit
+func queryRefund(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRefundParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	proposal := keeper.GetProposal(ctx, params.ProposalID)
+	if proposal == nil {
+		return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+	}
+
+	var outcome RefundOutcome
+	switch proposal.GetStatus() {
+	case StatusDepositPeriod:
+		outcome = keeper.GetRefundOutcome(ctx, proposal, DROP, EmptyTallyResult())
+	case StatusVotingPeriod:
+		result, tallyResults, _ := tally(ctx, keeper, proposal)
+		outcome = keeper.GetRefundOutcome(ctx, proposal, result, tallyResults)
+	default:
+		return nil, ErrAlreadyFinishedProposal(DefaultCodespace, params.ProposalID)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, outcome)
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/simulate'
+type QuerySimulateParams struct {
+	ProposalID uint64
+}
+
+// the outcome of a proposal in voting period if its voting period ended now
+func querySimulate(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QuerySimulateParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	proposal := keeper.GetProposal(ctx, params.ProposalID)
+	if proposal == nil {
+		return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+	}
+	if proposal.GetStatus() != StatusVotingPeriod {
+		return nil, ErrInactiveProposal(DefaultCodespace, params.ProposalID)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.SimulateProposal(ctx, proposal))
+	if err2 != nil {
+		return nil, sdk.MarshalResultErr(err2)
+	}
+	return bz, nil
+}
@@ -0,0 +1,91 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Representation records the representative voting on behalf of a delegator
+type Representation struct {
+	Delegator      sdk.AccAddress `json:"delegator"`
+	Representative sdk.AccAddress `json:"representative"`
+}
+
+func (r Representation) String() string {
+	return fmt.Sprintf("Delegator %s is represented by %s", r.Delegator, r.Representative)
+}
+
+// Gets the voting representative of a delegator
+func (keeper Keeper) GetRepresentative(ctx sdk.Context, delegator sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyRepresentative(delegator))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// Sets the voting representative of a delegator, an empty representative removes it.
+// A representative can not be a validator, and its votes are cast on behalf of the
+// delegator unless the delegator votes itself
+func (keeper Keeper) SetRepresentative(ctx sdk.Context, delegator, representative sdk.AccAddress) sdk.Error {
+	if !representative.Empty() {
+		if representative.Equals(delegator) {
+			return ErrInvalidRepresentative(keeper.codespace, "a delegator can not represent itself")
+		}
+		if keeper.vs.Validator(ctx, sdk.ValAddress(representative)) != nil {
+			return ErrInvalidRepresentative(keeper.codespace, fmt.Sprintf("validator %s can not be a representative", representative))
+		}
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	if old, found := keeper.GetRepresentative(ctx, delegator); found {
+		store.Delete(KeyRepresentedDelegator(old, delegator))
+		store.Delete(KeyRepresentative(delegator))
+	}
+	if representative.Empty() {
+		return nil
+	}
+	store.Set(KeyRepresentative(delegator), representative)
+	store.Set(KeyRepresentedDelegator(representative, delegator), delegator)
+	return nil
+}
+
+// Iterates over the delegators represented by a representative
+func (keeper Keeper) IterateRepresentedDelegators(ctx sdk.Context, representative sdk.AccAddress, fn func(delegator sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyRepresentedDelegatorsSubspace(representative))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// Returns whether an account represents any delegator
+func (keeper Keeper) IsRepresentative(ctx sdk.Context, representative sdk.AccAddress) (isRepresentative bool) {
+	keeper.IterateRepresentedDelegators(ctx, representative, func(_ sdk.AccAddress) bool {
+		isRepresentative = true
+		return true
+	})
+	return isRepresentative
+}
+
+// Gets all the representations, used during genesis dump
+func (keeper Keeper) GetAllRepresentations(ctx sdk.Context) (representations []Representation) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyRepresentativesSubspace())
+	defer iterator.Close()
+
+	prefixLen := len(KeyRepresentativesSubspace())
+	for ; iterator.Valid(); iterator.Next() {
+		representations = append(representations, Representation{
+			Delegator:      sdk.AccAddress(iterator.Key()[prefixLen:]),
+			Representative: sdk.AccAddress(iterator.Value()),
+		})
+	}
+	return representations
+}
@@ -0,0 +1,144 @@
+package gov
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/distribution"
+	"github.com/irisnet/irishub/app/v1/params"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// StateChange is a change of the state made by the execution of a proposal
+type StateChange struct {
+	Key    string `json:"key"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+func (sc StateChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", sc.Key, sc.Before, sc.After)
+}
+
+type StateChanges []StateChange
+
+// ProposalSimulation is the outcome of a proposal if its voting period ended now
+type ProposalSimulation struct {
+	ProposalID  uint64         `json:"proposal_id"`
+	Result      ProposalResult `json:"result"`
+	TallyResult TallyResult    `json:"tally_result"`
+	Executed    bool           `json:"executed"` // only the passed proposals of the simulated kinds are executed
+	Error       string         `json:"error"`
+	Changes     StateChanges   `json:"changes"`
+}
+
+func (ps ProposalSimulation) String() string {
+	out := fmt.Sprintf(`Simulation of Proposal %d:
+  Result:    %s
+  Executed:  %t`, ps.ProposalID, ps.Result, ps.Executed)
+	if len(ps.Error) != 0 {
+		out += fmt.Sprintf("\n  Error:     %s", ps.Error)
+	}
+	out += "\n  Changes:"
+	for _, change := range ps.Changes {
+		out += fmt.Sprintf("\n    %s", change)
+	}
+	return fmt.Sprintf("%s\n%s", out, ps.TallyResult.String())
+}
+
+// whether the execution of the proposals of the kind can be simulated
+func isSimulatedProposalType(kind ProposalKind) bool {
+	switch kind {
+	case ProposalTypeParameter, ProposalTypeTokenAddition, ProposalTypeCommunityTaxUsage:
+		return true
+	default:
+		return false
+	}
+}
+
+// SimulateProposal tallies the proposal against the current state, and if it would pass, executes it
+// in a cached context which is discarded afterwards
+func (keeper Keeper) SimulateProposal(ctx sdk.Context, proposal Proposal) (simulation ProposalSimulation) {
+	ctx, _ = ctx.CacheContext()
+	// the simulation must not report to the metrics of the node
+	keeper.metrics = NopMetrics()
+	keeper.dk = keeper.dk.WithMetrics(distribution.NopMetrics())
+
+	result, tallyResults, _ := tally(ctx, keeper, proposal)
+	simulation = ProposalSimulation{
+		ProposalID:  proposal.GetProposalID(),
+		Result:      result,
+		TallyResult: tallyResults,
+		Changes:     StateChanges{},
+	}
+	if result != PASS || !isSimulatedProposalType(proposal.GetProposalType()) {
+		return simulation
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			simulation.Error = fmt.Sprintf("%v", r)
+			simulation.Changes = StateChanges{}
+		}
+	}()
+
+	before := keeper.getProposalState(ctx, proposal)
+	nextRecordID := keeper.paramsKeeper.GetNextParamRecordID(ctx)
+	nextChangeID := keeper.paramsKeeper.GetNextParamChangeID(ctx)
+
+	simulation.Executed = true
+	if err := proposal.Execute(ctx, keeper); err != nil {
+		simulation.Error = err.Error()
+		return simulation
+	}
+
+	after := keeper.getProposalState(ctx, proposal)
+	for i := range before {
+		if before[i].After != after[i].After {
+			simulation.Changes = append(simulation.Changes, StateChange{Key: before[i].Key, Before: before[i].After, After: after[i].After})
+		}
+	}
+
+	// the parameters changed and the parameter changes scheduled by the proposal
+	keeper.paramsKeeper.IterateParamRecords(ctx, "", "", func(record params.ParamRecord) bool {
+		if record.ID >= nextRecordID {
+			simulation.Changes = append(simulation.Changes, StateChange{
+				Key:    fmt.Sprintf("params/%s/%s", record.Subspace, record.Key),
+				Before: record.OldValue,
+				After:  record.NewValue,
+			})
+		}
+		return false
+	})
+	for id := nextChangeID; id < keeper.paramsKeeper.GetNextParamChangeID(ctx); id++ {
+		if change, found := keeper.paramsKeeper.GetParamChange(ctx, id); found {
+			simulation.Changes = append(simulation.Changes, StateChange{
+				Key:   fmt.Sprintf("params/changes/%d", id),
+				After: fmt.Sprintf("%s/%s=%s (%s)", change.Subspace, change.Key, change.Value, change.Status),
+			})
+		}
+	}
+	return simulation
+}
+
+// the state touched by the execution of the proposal, the values are kept in After
+func (keeper Keeper) getProposalState(ctx sdk.Context, proposal Proposal) (state StateChanges) {
+	switch p := proposal.(type) {
+	case *TokenAdditionProposal:
+		tokenID := p.FToken.GetUniqueID()
+		value := ""
+		if keeper.ak.HasToken(ctx, tokenID) {
+			value = "issued"
+		}
+		state = append(state, StateChange{Key: fmt.Sprintf("asset/tokens/%s", tokenID), After: value})
+	case *CommunityTaxUsageProposal:
+		addrs := []sdk.AccAddress{auth.CommunityTaxCoinsAccAddr}
+		if p.TaxUsage.Usage != UsageTypeBurn {
+			addrs = append(addrs, p.TaxUsage.DestAddress)
+		}
+		for _, addr := range addrs {
+			state = append(state, StateChange{Key: fmt.Sprintf("accounts/%s", addr), After: keeper.ck.GetCoins(ctx, addr).String()})
+		}
+	}
+	return state
+}
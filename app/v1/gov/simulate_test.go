@@ -0,0 +1,78 @@
+package gov
+
+import (
+	"testing"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// a parameter proposal whose execution panics
+type panickingProposal struct {
+	ParameterProposal
+}
+
+func (pp *panickingProposal) Execute(ctx sdk.Context, k Keeper) sdk.Error {
+	panic("execution panicked")
+}
+
+// all the key-value pairs of a store
+func storeSnapshot(ctx sdk.Context, key sdk.StoreKey) map[string]string {
+	snapshot := make(map[string]string)
+	iterator := ctx.KVStore(key).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		snapshot[string(iterator.Key())] = string(iterator.Value())
+	}
+	return snapshot
+}
+
+func TestSimulateProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 1)
+	mapp.ParamsKeeper.RegisterParamSet(&GovParams{})
+	ctx := getTestContext(mapp, keeper)
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{100})
+
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypeParameter, addrs[0], irisCoins(10),
+		Params{{Subspace: DefaultParamSpace, Key: string(KeyRefundPolicy), Value: "tiers=0:0.5"}})
+	_, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	proposalID := keeper.GetLastProposalID(ctx)
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+
+	govState, paramsState := storeSnapshot(ctx, keeper.storeKey), storeSnapshot(ctx, mapp.KeyParams)
+	policy := keeper.GetRefundPolicy(ctx)
+
+	simulation := keeper.SimulateProposal(ctx, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, PASS, simulation.Result)
+	require.True(t, simulation.Executed)
+	require.Empty(t, simulation.Error)
+	require.Len(t, simulation.Changes, 1)
+	require.Equal(t, "params/gov/RefundPolicy", simulation.Changes[0].Key)
+
+	// the cached context of the simulation is discarded
+	require.Equal(t, govState, storeSnapshot(ctx, keeper.storeKey))
+	require.Equal(t, paramsState, storeSnapshot(ctx, mapp.KeyParams))
+	require.Equal(t, policy.String(), keeper.GetRefundPolicy(ctx).String())
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// the querier returns the same simulation
+	req := abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(QuerySimulateParams{ProposalID: proposalID})}
+	bz, err := NewQuerier(keeper)(ctx, []string{QuerySimulate}, req)
+	require.Nil(t, err)
+	var queried ProposalSimulation
+	keeper.cdc.MustUnmarshalJSON(bz, &queried)
+	require.Equal(t, simulation.String(), queried.String())
+	require.Equal(t, govState, storeSnapshot(ctx, keeper.storeKey))
+
+	// a panicking execution is reported as an error
+	proposal := keeper.GetProposal(ctx, proposalID).(*ParameterProposal)
+	require.NotPanics(t, func() {
+		simulation = keeper.SimulateProposal(ctx, &panickingProposal{*proposal})
+	})
+	require.True(t, simulation.Executed)
+	require.Equal(t, "execution panicked", simulation.Error)
+	require.Empty(t, simulation.Changes)
+	require.Equal(t, govState, storeSnapshot(ctx, keeper.storeKey))
+}
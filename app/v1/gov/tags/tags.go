@@ -12,6 +12,7 @@ var (
 	ActionProposalDropped  = []byte("proposal-dropped")
 	ActionProposalPassed   = []byte("proposal-passed")
 	ActionProposalRejected = []byte("proposal-rejected")
+	ActionDepositSettled   = []byte("deposit-settled")
 
 	Action            = sdk.TagAction
 	Proposer          = "proposer"
@@ -24,4 +25,9 @@ var (
 	Percent           = "percent"
 	DestAddress       = "dest-address"
 	TokenId           = "token-id"
+	Delegator         = "delegator"
+	Representative    = "representative"
+	Expedited         = "expedited"
+	Refund            = "refund"
+	Burn              = "burn"
 )
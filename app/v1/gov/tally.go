@@ -10,12 +10,15 @@ const (
 	PASS       ProposalResult = "pass"
 	REJECT     ProposalResult = "reject"
 	REJECTVETO ProposalResult = "reject-veto"
+
+	// the deposit period ended before the minimum deposit was reached, never returned by tally
+	DROP ProposalResult = "drop"
 )
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	Vote                WeightedVoteOptions // Vote of the validator
 	TokenPerShare       sdk.Dec
 	DelegatorShares     sdk.Dec // Total outstanding delegator shares
 	DelegatorDeductions sdk.Dec // Delegator deductions from validator's delegators voting independently
@@ -39,41 +42,63 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (result ProposalRe
 		currValidators[validator.GetOperator().String()] = validatorGovInfo{
 			Address:             validator.GetOperator(),
 			TokenPerShare:       validator.GetTokens().Quo(validator.GetDelegatorShares()),
-			Vote:                OptionEmpty,
+			Vote:                nil,
 			DelegatorShares:     validator.GetDelegatorShares(),
 			DelegatorDeductions: sdk.ZeroDec(),
 		}
 		systemVotingPower = systemVotingPower.Add(validator.GetTokens())
 		return false
 	})
+	// the voting power of a delegator is deducted from its validators and cast with the given options
+	tallyDelegations := func(delegator sdk.AccAddress, options WeightedVoteOptions) {
+		selfValAddrStr := sdk.ValAddress(delegator).String()
+		keeper.ds.IterateDelegations(ctx, delegator, func(index int64, delegation sdk.Delegation) (stop bool) {
+			valAddr := delegation.GetValidatorAddr().String()
+			if valAddr == selfValAddrStr {
+				return false
+			}
+			//only tally the delegator voting power under the validator
+			if val, ok := currValidators[valAddr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddr] = val
+
+				votingPower := delegation.GetShares().Mul(val.TokenPerShare)
+				addVotingPower(results, options, votingPower)
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+			return false
+		})
+	}
+
 	// iterate over all the votes
+	var votes []Vote
+	voted := make(map[string]bool)
 	votesIterator := keeper.GetVotes(ctx, proposal.GetProposalID())
 	defer votesIterator.Close()
 	for ; votesIterator.Valid(); votesIterator.Next() {
-		vote := &Vote{}
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), vote)
+		vote := Vote{}
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
+		votes = append(votes, vote)
+		voted[vote.Voter.String()] = true
+	}
+
+	for _, vote := range votes {
+		options := vote.GetOptions()
 
 		// if validator, just record it in the map
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = options
 			votingVals[valAddrStr] = true
 			currValidators[valAddrStr] = val
 		}
 		// if validator is also delegator
-		keeper.ds.IterateDelegations(ctx, vote.Voter, func(index int64, delegation sdk.Delegation) (stop bool) {
-			valAddr := delegation.GetValidatorAddr().String()
-			if valAddr == valAddrStr {
-				return false
-			}
-			//only tally the delegator voting power under the validator
-			if val, ok := currValidators[valAddr]; ok {
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddr] = val
+		tallyDelegations(vote.Voter, options)
 
-				votingPower := delegation.GetShares().Mul(val.TokenPerShare)
-				results[vote.Option] = results[vote.Option].Add(votingPower)
-				totalVotingPower = totalVotingPower.Add(votingPower)
+		// the representative votes on behalf of the delegators which have not voted themselves
+		keeper.IterateRepresentedDelegators(ctx, vote.Voter, func(delegator sdk.AccAddress) (stop bool) {
+			if !voted[delegator.String()] {
+				tallyDelegations(delegator, options)
 			}
 			return false
 		})
@@ -81,18 +106,18 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (result ProposalRe
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.Mul(val.TokenPerShare)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		addVotingPower(results, val.Vote, votingPower)
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyingProcedure := keeper.GetTallyingProcedure(ctx, proposal.GetProposalLevel())
+	tallyingProcedure := keeper.GetProposalTallyingProcedure(ctx, proposal)
 
 	tallyResults = TallyResult{
 		Yes:               results[OptionYes].QuoInt(sdk.AttoScaleFactor),
@@ -125,3 +150,10 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (result ProposalRe
 
 	return REJECT, tallyResults, votingVals
 }
+
+// splits the voting power among the options according to their weights
+func addVotingPower(results map[VoteOption]sdk.Dec, options WeightedVoteOptions, votingPower sdk.Dec) {
+	for _, o := range options {
+		results[o.Option] = results[o.Option].Add(votingPower.Mul(o.Weight))
+	}
+}
@@ -0,0 +1,121 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/stake"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidWeightedVoteOptions(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,No=0.3,Abstain=0.1")
+	require.Nil(t, err)
+	require.True(t, ValidWeightedVoteOptions(options))
+	require.Equal(t, OptionYes, options.PrimaryOption())
+
+	for _, str := range []string{
+		"Yes=0.6,No=0.3",       // the weights sum up to less than 1
+		"Yes=0.6,No=0.5",       // the weights sum up to more than 1
+		"Yes=0.5,Yes=0.5",      // duplicate option
+		"Yes=1.2,No=-0.2",      // negative weight
+		"Yes=1,No=0",           // zero weight
+		"Yes=0.5,NoWithVeto=0", // zero weight
+	} {
+		options, err := WeightedVoteOptionsFromString(str)
+		require.Nil(t, err, str)
+		require.False(t, ValidWeightedVoteOptions(options), str)
+	}
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{}))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{NewWeightedVoteOption(OptionEmpty, sdk.OneDec())}))
+}
+
+// submits a proposal entering the voting period at once
+func submitVotingProposal(t *testing.T, ctx sdk.Context, keeper Keeper, proposer sdk.AccAddress) uint64 {
+	msg := NewMsgSubmitProposal("Test", "test description", ProposalTypePlainText, proposer, irisCoins(10), nil)
+	resTags, err := keeper.SubmitProposal(ctx, msg)
+	require.Nil(t, err)
+	require.NotEmpty(t, resTags)
+	proposalID := keeper.GetLastProposalID(ctx)
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+	return proposalID
+}
+
+func TestTallyWeightedVote(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 2)
+	ctx := getTestContext(mapp, keeper)
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{60, 40})
+	proposalID := submitVotingProposal(t, ctx, keeper, addrs[0])
+
+	invalid := WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)), NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1))}
+	require.NotNil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[0], invalid))
+
+	options := WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)), NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1))}
+	require.Nil(t, keeper.AddWeightedVote(ctx, proposalID, addrs[0], options))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+
+	vote, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.True(t, vote.GetOptions().Equals(options))
+
+	// the voting power of the split vote is cast for each option according to its weight
+	result, tallyResults, votingVals := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, PASS, result)
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDec(70)), tallyResults.Yes.String())
+	require.True(t, tallyResults.No.Equal(sdk.NewDec(30)), tallyResults.No.String())
+	require.True(t, tallyResults.SystemVotingPower.Equal(sdk.NewDec(100)))
+	require.Len(t, votingVals, 2)
+}
+
+func TestSetRepresentative(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 3)
+	ctx := getTestContext(mapp, keeper)
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{100})
+
+	require.NotNil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[1]))
+	require.NotNil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[0]))
+
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], addrs[2]))
+	representative, found := keeper.GetRepresentative(ctx, addrs[1])
+	require.True(t, found)
+	require.Equal(t, addrs[2], representative)
+	require.True(t, keeper.IsRepresentative(ctx, addrs[2]))
+	require.Len(t, keeper.GetAllRepresentations(ctx), 1)
+
+	// an empty representative removes the representation
+	require.Nil(t, keeper.SetRepresentative(ctx, addrs[1], nil))
+	_, found = keeper.GetRepresentative(ctx, addrs[1])
+	require.False(t, found)
+	require.False(t, keeper.IsRepresentative(ctx, addrs[2]))
+}
+
+func TestTallyRepresentativeVote(t *testing.T) {
+	mapp, keeper, sk, addrs, pubKeys, _ := getMockApp(t, 4)
+	ctx := getTestContext(mapp, keeper)
+	createValidators(t, ctx, sk, addrs, pubKeys, []int64{100})
+
+	// two delegators of 50 voting power represented by the same account
+	for _, delegator := range addrs[1:3] {
+		res := stake.NewHandler(sk)(ctx, stake.NewTestMsgDelegate(delegator, sdk.ValAddress(addrs[0]), irisCoins(50)[0].Amount))
+		require.True(t, res.IsOK(), res.Log)
+		require.Nil(t, keeper.SetRepresentative(ctx, delegator, addrs[3]))
+	}
+	sk.ApplyAndReturnValidatorSetUpdates(ctx)
+	proposalID := submitVotingProposal(t, ctx, keeper, addrs[0])
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[3], OptionNo))
+
+	// the representative votes on behalf of the delegators instead of their validator
+	_, tallyResults, _ := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDec(100)), tallyResults.Yes.String())
+	require.True(t, tallyResults.No.Equal(sdk.NewDec(100)), tallyResults.No.String())
+
+	// the vote of a delegator overrides the vote of its representative
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionAbstain))
+	_, tallyResults, _ = tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDec(100)), tallyResults.Yes.String())
+	require.True(t, tallyResults.No.Equal(sdk.NewDec(50)), tallyResults.No.String())
+	require.True(t, tallyResults.Abstain.Equal(sdk.NewDec(50)), tallyResults.Abstain.String())
+	require.True(t, tallyResults.SystemVotingPower.Equal(sdk.NewDec(200)))
+}
@@ -13,11 +13,9 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	"fmt"
-	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/bank"
 	"github.com/irisnet/irishub/app/v1/distribution"
 	"github.com/irisnet/irishub/app/v1/mock"
-	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/stake"
 	"github.com/irisnet/irishub/modules/guardian"
 	sdk "github.com/irisnet/irishub/types"
@@ -32,37 +30,28 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 
 	keyGov := sdk.NewKVStoreKey("gov")
 	keyDistr := sdk.NewKVStoreKey("distr")
+	keyAsset := sdk.NewKVStoreKey("asset")
 
-	paramsKeeper := params.NewKeeper(
-		mapp.Cdc,
-		sdk.NewKVStoreKey("params"),
-		sdk.NewTransientStoreKey("transient_params"),
-	)
-	feeKeeper := auth.NewFeeKeeper(
-		mapp.Cdc,
-		sdk.NewKVStoreKey("fee"),
-		paramsKeeper.Subspace(auth.DefaultParamSpace),
-	)
-
+	paramsKeeper := mapp.ParamsKeeper
 	ck := bank.NewBaseKeeper(mapp.Cdc, mapp.AccountKeeper)
 	sk := stake.NewKeeper(
 		mapp.Cdc,
 		mapp.KeyStake, mapp.TkeyStake,
-		mapp.BankKeeper, mapp.ParamsKeeper.Subspace(stake.DefaultParamspace),
+		mapp.BankKeeper, paramsKeeper.Subspace(stake.DefaultParamspace),
 		stake.DefaultCodespace,
 		stake.NopMetrics())
-	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, paramsKeeper.Subspace(distribution.DefaultParamspace), ck, sk, feeKeeper, DefaultCodespace, distribution.NopMetrics())
-	guardianKeeper := guardian.NewKeeper(mapp.Cdc, sdk.NewKVStoreKey("guardian"), guardian.DefaultCodespace)
-	ak := asset.NewKeeper(mapp.Cdc, protocol.KeyAsset, ck, asset.DefaultCodespace, paramsKeeper.Subspace(asset.DefaultParamSpace))
+	guardianKeeper := guardian.NewKeeper(mapp.Cdc, mapp.KeyGuardian, guardian.DefaultCodespace)
+	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, paramsKeeper.Subspace(distribution.DefaultParamspace), ck, sk, mapp.FeeKeeper, guardianKeeper, DefaultCodespace, distribution.NopMetrics())
+	ak := asset.NewKeeper(mapp.Cdc, keyAsset, ck, asset.DefaultCodespace, paramsKeeper.Subspace(asset.DefaultParamSpace))
 
-	gk := NewKeeper(keyGov, mapp.Cdc, paramsKeeper.Subspace(DefaultParamSpace), paramsKeeper, sdk.NewProtocolKeeper(sdk.NewKVStoreKey("main")), ck, dk, guardianKeeper, sk, DefaultCodespace, NopMetrics(), ak)
+	gk := NewKeeper(keyGov, mapp.Cdc, paramsKeeper.Subspace(DefaultParamSpace), paramsKeeper, sdk.NewProtocolKeeper(mapp.KeyMain), ck, dk, guardianKeeper, sk, DefaultCodespace, NopMetrics(), ak, protocol.NewRouter())
 
 	mapp.Router().AddRoute("gov", []*sdk.KVStoreKey{keyGov}, NewHandler(gk))
 
 	mapp.SetEndBlocker(getEndBlocker(gk))
 	mapp.SetInitChainer(getInitChainer(mapp, gk, sk))
 
-	require.NoError(t, mapp.CompleteSetup(keyGov))
+	require.NoError(t, mapp.CompleteSetup(keyGov, keyDistr, keyAsset))
 
 	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin(fmt.Sprintf("%d%s", 1042, sdk.Iris))
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
@@ -102,6 +91,31 @@ func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper) sdk
 	}
 }
 
+// the context of a new block with the gov params for test
+func getTestContext(mapp *mock.App, keeper Keeper) sdk.Context {
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.SetParamSet(ctx, DefaultParamsForTest())
+	return ctx
+}
+
+// the coins of an amount of iris
+func irisCoins(amount int64) sdk.Coins {
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin(fmt.Sprintf("%d%s", amount, sdk.Iris))
+	return sdk.Coins{coin}
+}
+
+// creates a bonded validator operated by each of the first accounts, with the voting power in iris
+func createValidators(t *testing.T, ctx sdk.Context, sk stake.Keeper, addrs []sdk.AccAddress, pubKeys []crypto.PubKey, powers []int64) {
+	handler := stake.NewHandler(sk)
+	for i, power := range powers {
+		msg := stake.NewTestMsgCreateValidator(sdk.ValAddress(addrs[i]), pubKeys[i], irisCoins(power)[0].Amount)
+		res := handler(ctx, msg)
+		require.True(t, res.IsOK(), res.Log)
+	}
+	sk.ApplyAndReturnValidatorSetUpdates(ctx)
+}
+
 // Sorts Addresses
 func SortAddresses(addrs []sdk.AccAddress) {
 	var byteAddrs [][]byte
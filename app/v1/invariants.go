@@ -3,6 +3,7 @@ package v1
 import (
 	"fmt"
 
+	"github.com/irisnet/irishub/app/v1/asset"
 	"github.com/irisnet/irishub/app/v1/bank"
 	distr "github.com/irisnet/irishub/app/v1/distribution"
 	"github.com/irisnet/irishub/app/v1/stake"
@@ -22,6 +23,8 @@ func (p *ProtocolV1) runtimeInvariants() []sdk.Invariant {
 		stake.NonNegativePowerInvariant(p.StakeKeeper),
 		stake.PositiveDelegationInvariant(p.StakeKeeper),
 		stake.DelegatorSharesInvariant(p.StakeKeeper),
+
+		asset.TokenSupplyInvariant(p.assetKeeper, p.accountMapper),
 	}
 }
 
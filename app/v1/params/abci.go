@@ -0,0 +1,11 @@
+package params
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// BeginBlocker applies the scheduled parameter changes reaching their activation
+// height and reverts the ones reaching their expiry height, the changes handled are returned
+func BeginBlocker(ctx sdk.Context, keeper Keeper) ParamChanges {
+	return keeper.ApplyParamChanges(ctx)
+}
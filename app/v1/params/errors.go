@@ -8,7 +8,8 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = "params"
 	//
-	CodeInvalidString sdk.CodeType = 0
+	CodeInvalidString      sdk.CodeType = 0
+	CodeInvalidParamChange sdk.CodeType = 1
 
 	//gov
 	CodeInvalidMinDeposit        sdk.CodeType = 100
@@ -27,6 +28,9 @@ const (
 	CodeInvalidQueryParams       sdk.CodeType = 113
 	CodeInvalidMaxProposalNum    sdk.CodeType = 114
 	CodeInvalidSystemHaltPeriod  sdk.CodeType = 115
+	CodeInvalidCancelBurnRate    sdk.CodeType = 116
+	CodeInvalidProposalKindRule  sdk.CodeType = 117
+	CodeInvalidRefundPolicy      sdk.CodeType = 118
 
 	//service
 	CodeInvalidMaxRequestTimeout    sdk.CodeType = 200
@@ -48,6 +52,9 @@ const (
 	CodeInvalidMaxValidators sdk.CodeType = 501
 	CodeInvalidBondDenom     sdk.CodeType = 502
 
+	CodeInvalidConsPubKeyRotationInterval sdk.CodeType = 503
+	CodeInvalidMaxValidatorBondedRatio    sdk.CodeType = 504
+
 	//auth
 	CodeInvalidGasPriceThreshold sdk.CodeType = 600
 	CodeInvalidTxSizeLimit       sdk.CodeType = 601
@@ -66,8 +73,16 @@ const (
 	CodeInvalidGatewayAssetFeeRatio sdk.CodeType = 902
 	CodeInvalidIssueTokenBaseFee    sdk.CodeType = 903
 	CodeInvalidCreateGatewayBaseFee sdk.CodeType = 904
+
+	//rand
+	CodeInvalidRandServiceFee     sdk.CodeType = 1000
+	CodeInvalidMaxPendingRequests sdk.CodeType = 1001
 )
 
 func ErrInvalidString(valuestr string) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodeInvalidString, fmt.Sprintf("%s can't convert to a specific type", valuestr))
 }
+
+func ErrInvalidParamChange(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidParamChange, msg)
+}
@@ -0,0 +1,110 @@
+package params
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// GenesisState - the scheduled parameter changes and the parameter history, the
+// parameters themselves are part of the genesis state of their modules
+type GenesisState struct {
+	ParamChanges ParamChanges `json:"param_changes"`
+	ParamHistory ParamRecords `json:"param_history"`
+}
+
+func NewGenesisState(changes ParamChanges, history ParamRecords) GenesisState {
+	return GenesisState{
+		ParamChanges: changes,
+		ParamHistory: history,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// InitGenesis restores the scheduled parameter changes, their queues and the parameter history
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	store := ctx.KVStore(keeper.key)
+
+	var nextChangeID uint64 = 1
+	for _, change := range data.ParamChanges {
+		keeper.SetParamChange(ctx, change)
+		switch change.Status {
+		case StatusPending:
+			store.Set(GetPendingParamChangeQueueKey(change.ActivationHeight, change.ID), []byte{})
+		case StatusActive:
+			store.Set(GetActiveParamChangeQueueKey(change.ExpiryHeight, change.ID), []byte{})
+		}
+		if change.ID >= nextChangeID {
+			nextChangeID = change.ID + 1
+		}
+	}
+	keeper.SetNextParamChangeID(ctx, nextChangeID)
+
+	var nextRecordID uint64 = 1
+	for _, record := range data.ParamHistory {
+		keeper.SetParamRecord(ctx, record)
+		if record.ID >= nextRecordID {
+			nextRecordID = record.ID + 1
+		}
+	}
+	keeper.SetNextParamRecordID(ctx, nextRecordID)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	var changes ParamChanges
+	keeper.IterateParamChanges(ctx, func(change ParamChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+
+	var history ParamRecords
+	keeper.IterateParamRecords(ctx, "", "", func(record ParamRecord) bool {
+		history = append(history, record)
+		return false
+	})
+	sort.Sort(history)
+	return NewGenesisState(changes, history)
+}
+
+// ValidateGenesis validates the schedule of the parameter changes and the parameter history
+func ValidateGenesis(data GenesisState) error {
+	ids := make(map[uint64]bool)
+	for _, change := range data.ParamChanges {
+		if ids[change.ID] {
+			return fmt.Errorf("duplicate parameter change id %d", change.ID)
+		}
+		ids[change.ID] = true
+
+		if len(change.Status.String()) == 0 {
+			return fmt.Errorf("invalid status of parameter change %d", change.ID)
+		}
+		if change.ActivationHeight < 0 || (change.ExpiryHeight != 0 && change.ExpiryHeight <= change.ActivationHeight) {
+			return fmt.Errorf("invalid schedule of parameter change %d, activation height %d, expiry height %d",
+				change.ID, change.ActivationHeight, change.ExpiryHeight)
+		}
+	}
+
+	recordIDs := make(map[uint64]bool)
+	for _, record := range data.ParamHistory {
+		if recordIDs[record.ID] {
+			return fmt.Errorf("duplicate parameter record id %d", record.ID)
+		}
+		recordIDs[record.ID] = true
+
+		if len(record.Subspace) == 0 || len(record.Key) == 0 ||
+			strings.Contains(record.Subspace, "/") || strings.Contains(record.Key, "/") {
+			return fmt.Errorf("invalid parameter %s/%s of parameter record %d", record.Subspace, record.Key, record.ID)
+		}
+		if record.Height < 0 {
+			return fmt.Errorf("invalid height %d of parameter record %d", record.Height, record.ID)
+		}
+	}
+	return nil
+}
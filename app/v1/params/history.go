@@ -0,0 +1,123 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+type proposalIDKey struct{}
+
+// WithProposalID returns a context whose parameter writes are recorded as made by the proposal
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDKey{}, proposalID)
+}
+
+// get the proposal making the parameter writes, 0 if there is none
+func proposalIDFromContext(ctx sdk.Context) uint64 {
+	if proposalID, ok := ctx.Value(proposalIDKey{}).(uint64); ok {
+		return proposalID
+	}
+	return 0
+}
+
+// ParamRecord is a write changing the value of a parameter, the values are the stored JSON
+type ParamRecord struct {
+	ID         uint64 `json:"id"`
+	Subspace   string `json:"subspace"`
+	Key        string `json:"key"`
+	OldValue   string `json:"old_value"`
+	NewValue   string `json:"new_value"`
+	Height     int64  `json:"height"`
+	ProposalID uint64 `json:"proposal_id"` // 0 if the parameter was not changed by a proposal
+}
+
+func (pr ParamRecord) String() string {
+	return fmt.Sprintf(`ParamRecord %d:
+  Parameter:    %s/%s
+  Old Value:    %s
+  New Value:    %s
+  Height:       %d
+  Proposal ID:  %d`,
+		pr.ID, pr.Subspace, pr.Key, pr.OldValue, pr.NewValue, pr.Height, pr.ProposalID)
+}
+
+type ParamRecords []ParamRecord
+
+func (prs ParamRecords) String() string {
+	if len(prs) == 0 {
+		return "[]"
+	}
+	var out []string
+	for _, pr := range prs {
+		out = append(out, pr.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+// implements sort.Interface, the records are sorted by id
+func (prs ParamRecords) Len() int           { return len(prs) }
+func (prs ParamRecords) Less(i, j int) bool { return prs[i].ID < prs[j].ID }
+func (prs ParamRecords) Swap(i, j int)      { prs[i], prs[j] = prs[j], prs[i] }
+
+// recordParam is the recorder of the subspaces, it stores every change of a parameter
+func (k Keeper) recordParam(ctx sdk.Context, subspace string, key []byte, old, new []byte) {
+	record := ParamRecord{
+		ID:         k.GetNextParamRecordID(ctx),
+		Subspace:   subspace,
+		Key:        string(key),
+		OldValue:   string(old),
+		NewValue:   string(new),
+		Height:     ctx.BlockHeight(),
+		ProposalID: proposalIDFromContext(ctx),
+	}
+	k.SetParamRecord(ctx, record)
+	k.SetNextParamRecordID(ctx, record.ID+1)
+}
+
+// set a parameter record
+func (k Keeper) SetParamRecord(ctx sdk.Context, record ParamRecord) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(GetParamRecordKey(record.Subspace, record.Key, record.ID), bz)
+}
+
+// iterate over the records of a subspace, or of a single parameter if key is not empty,
+// the records of the same parameter are iterated in the order of their ids
+func (k Keeper) IterateParamRecords(ctx sdk.Context, subspace, key string, fn func(record ParamRecord) (stop bool)) {
+	prefix := ParamRecordKey
+	if len(subspace) != 0 {
+		prefix = GetParamRecordsPrefix(subspace, key)
+	}
+
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record ParamRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// get the id of the next parameter record
+func (k Keeper) GetNextParamRecordID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(NextParamRecordIDKey)
+	if bz == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+// set the id of the next parameter record
+func (k Keeper) SetNextParamRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	store.Set(NextParamRecordIDKey, bz)
+}
@@ -0,0 +1,67 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+func TestParamHistory(t *testing.T) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey("params")
+	tkey := sdk.NewTransientStoreKey("transient_params")
+	ctx := defaultContext(key, tkey)
+	keeper := NewKeeper(cdc, key, tkey)
+	space := keeper.Subspace("schedule").WithTypeTable(NewTypeTable().RegisterParamSet(&scheduleParams{}))
+	keeper.RegisterParamSet(&scheduleParams{})
+
+	getHistory := func(subspace, key string) (records ParamRecords) {
+		keeper.IterateParamRecords(ctx, subspace, key, func(record ParamRecord) bool {
+			records = append(records, record)
+			return false
+		})
+		return records
+	}
+
+	// the initial value and the writes not changing the value are not recorded
+	space.SetParamSet(ctx, &scheduleParams{MaxNum: 10})
+	space.Set(ctx, keyMaxNum, uint16(10))
+	require.Equal(t, 0, len(getHistory("", "")))
+
+	ctx = ctx.WithBlockHeight(5)
+	space.Set(ctx, keyMaxNum, uint16(20))
+	ctx = ctx.WithBlockHeight(8)
+	space.Set(WithProposalID(ctx, 3), keyMaxNum, uint16(30))
+
+	history := getHistory("schedule", "MaxNum")
+	require.Equal(t, 2, len(history))
+	require.Equal(t, ParamRecord{ID: 1, Subspace: "schedule", Key: "MaxNum", OldValue: "10", NewValue: "20", Height: 5}, history[0])
+	require.Equal(t, ParamRecord{ID: 2, Subspace: "schedule", Key: "MaxNum", OldValue: "20", NewValue: "30", Height: 8, ProposalID: 3}, history[1])
+	require.Equal(t, 2, len(getHistory("schedule", "")))
+	require.Equal(t, 0, len(getHistory("schedule", "Max")))
+	require.Equal(t, 0, len(getHistory("unknown", "")))
+
+	// the scheduled changes are recorded with their proposals
+	_, err := keeper.ScheduleParamChange(ctx, 4, "schedule", "MaxNum", "40", 8, 10)
+	require.Nil(t, err)
+	ctx = ctx.WithBlockHeight(10)
+	keeper.ApplyParamChanges(ctx)
+
+	history = getHistory("schedule", "MaxNum")
+	require.Equal(t, 4, len(history))
+	require.Equal(t, ParamRecord{ID: 3, Subspace: "schedule", Key: "MaxNum", OldValue: "30", NewValue: "40", Height: 8, ProposalID: 4}, history[2])
+	require.Equal(t, ParamRecord{ID: 4, Subspace: "schedule", Key: "MaxNum", OldValue: "40", NewValue: "30", Height: 10, ProposalID: 4}, history[3])
+
+	// export and import the history
+	genesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, history, genesis.ParamHistory)
+	require.Nil(t, ValidateGenesis(genesis))
+	InitGenesis(ctx, keeper, genesis)
+	require.Equal(t, uint64(5), keeper.GetNextParamRecordID(ctx))
+
+	genesis.ParamHistory = append(genesis.ParamHistory, history[0])
+	require.NotNil(t, ValidateGenesis(genesis))
+}
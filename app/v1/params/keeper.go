@@ -42,7 +42,7 @@ func (k Keeper) Subspace(spacename string) Subspace {
 		panic("cannot use empty string for subspace")
 	}
 
-	space := subspace.NewSubspace(k.cdc, k.key, k.tkey, spacename)
+	space := subspace.NewSubspace(k.cdc, k.key, k.tkey, spacename).WithRecorder(k.recordParam)
 
 	k.spaces[spacename] = &space
 
@@ -0,0 +1,57 @@
+package params
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Keys of the scheduled parameter changes and of the parameter history. The subspaces
+// store their parameters under alphanumeric prefixes, so keys starting with a
+// non-printable byte never collide with them
+var (
+	ParamChangeKey          = []byte{0x01} // prefix for the scheduled parameter changes by id
+	PendingParamChangeQueue = []byte{0x02} // prefix for the changes waiting for their activation height
+	ActiveParamChangeQueue  = []byte{0x03} // prefix for the activated changes waiting for their expiry height
+	NextParamChangeIDKey    = []byte{0x04} // key for the id of the next scheduled parameter change
+	ParamRecordKey          = []byte{0x05} // prefix for the parameter records by subspace, key and id
+	NextParamRecordIDKey    = []byte{0x06} // key for the id of the next parameter record
+)
+
+// Key for getting a scheduled parameter change
+func GetParamChangeKey(id uint64) []byte {
+	return append(ParamChangeKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// Key for the change activated at the given height in the pending queue
+func GetPendingParamChangeQueueKey(height int64, id uint64) []byte {
+	return append(GetPendingParamChangeQueueHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Prefix for the changes activated at the given height in the pending queue
+func GetPendingParamChangeQueueHeightKey(height int64) []byte {
+	return append(PendingParamChangeQueue, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// Key for the change reverted at the given height in the active queue
+func GetActiveParamChangeQueueKey(height int64, id uint64) []byte {
+	return append(GetActiveParamChangeQueueHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Prefix for the changes reverted at the given height in the active queue
+func GetActiveParamChangeQueueHeightKey(height int64) []byte {
+	return append(ActiveParamChangeQueue, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// Prefix for the records of a subspace, or of a single parameter if key is not empty.
+// The subspace names and the parameter keys never contain '/'
+func GetParamRecordsPrefix(subspace, key string) []byte {
+	prefix := append(append(ParamRecordKey, []byte(subspace)...), '/')
+	if len(key) == 0 {
+		return prefix
+	}
+	return append(append(prefix, []byte(key)...), '/')
+}
+
+// Key for getting a parameter record
+func GetParamRecordKey(subspace, key string, id uint64) []byte {
+	return append(GetParamRecordsPrefix(subspace, key), sdk.Uint64ToBigEndian(id)...)
+}
@@ -2,6 +2,7 @@ package params
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/irisnet/irishub/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -9,7 +10,9 @@ import (
 
 // query endpoints supported by the params Querier
 const (
-	QueryModule = "module"
+	QueryModule  = "module"
+	QueryChanges = "changes"
+	QueryHistory = "history"
 )
 
 // creates a querier for params REST endpoints
@@ -31,6 +34,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			bz, _ := keeper.cdc.MarshalJSON(ps)
 			return bz, nil
 
+		case QueryChanges:
+			return queryChanges(ctx, req, keeper)
+
+		case QueryHistory:
+			return queryHistory(ctx, req, keeper)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown params query endpoint")
 		}
@@ -42,6 +51,72 @@ type QueryModuleParams struct {
 	Module string
 }
 
+// defines the params for query: "custom/params/changes", empty fields match all the changes
+type QueryChangesParams struct {
+	Module string
+	Status string
+}
+
+// defines the params for query: "custom/params/history", an empty module matches all the
+// records and an empty key matches all the records of the module
+type QueryHistoryParams struct {
+	Module string
+	Key    string
+}
+
+func queryHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryHistoryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+	if len(params.Module) == 0 && len(params.Key) != 0 {
+		return nil, sdk.NewError(DefaultCodespace, CodeInvalidQueryParams, "the module of the key must be specified")
+	}
+
+	records := ParamRecords{}
+	keeper.IterateParamRecords(ctx, params.Module, params.Key, func(record ParamRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	sort.Sort(records)
+
+	bz, err := keeper.cdc.MarshalJSON(records)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+	return bz, nil
+}
+
+func queryChanges(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryChangesParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	var status ParamChangeStatus
+	if len(params.Status) > 0 {
+		var err error
+		if status, err = ParamChangeStatusFromString(params.Status); err != nil {
+			return nil, sdk.NewError(DefaultCodespace, CodeInvalidQueryParams, err.Error())
+		}
+	}
+
+	changes := ParamChanges{}
+	keeper.IterateParamChanges(ctx, func(change ParamChange) bool {
+		if (len(params.Module) == 0 || change.Subspace == params.Module) &&
+			(len(params.Status) == 0 || change.Status == status) {
+			changes = append(changes, change)
+		}
+		return false
+	})
+
+	bz, err := keeper.cdc.MarshalJSON(changes)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+	return bz, nil
+}
+
 func queryAll(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	var paramSets ParamSets
 	for key, _ := range keeper.spaces {
@@ -0,0 +1,386 @@
+package params
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/pkg/errors"
+)
+
+type ParamChangeStatus byte
+
+const (
+	StatusPending    ParamChangeStatus = 0x01 // waiting for the activation height
+	StatusActive     ParamChangeStatus = 0x02 // applied, waiting for the expiry height to be reverted
+	StatusApplied    ParamChangeStatus = 0x03 // applied without expiry
+	StatusReverted   ParamChangeStatus = 0x04 // the previous value has been restored at the expiry height
+	StatusSuperseded ParamChangeStatus = 0x05 // not reverted since the parameter has been changed again meanwhile
+	StatusFailed     ParamChangeStatus = 0x06 // the value was no longer valid at the activation height, or the previous value at the expiry height
+)
+
+// String to ParamChangeStatus byte.  Returns ff if invalid.
+func ParamChangeStatusFromString(str string) (ParamChangeStatus, error) {
+	switch str {
+	case "Pending":
+		return StatusPending, nil
+	case "Active":
+		return StatusActive, nil
+	case "Applied":
+		return StatusApplied, nil
+	case "Reverted":
+		return StatusReverted, nil
+	case "Superseded":
+		return StatusSuperseded, nil
+	case "Failed":
+		return StatusFailed, nil
+	default:
+		return ParamChangeStatus(0xff), errors.Errorf("'%s' is not a valid parameter change status", str)
+	}
+}
+
+// Marshal needed for protobuf compatibility
+func (status ParamChangeStatus) Marshal() ([]byte, error) {
+	return []byte{byte(status)}, nil
+}
+
+// Unmarshal needed for protobuf compatibility
+func (status *ParamChangeStatus) Unmarshal(data []byte) error {
+	*status = ParamChangeStatus(data[0])
+	return nil
+}
+
+// Marshals to JSON using string
+func (status ParamChangeStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// Unmarshals from JSON assuming Bech32 encoding
+func (status *ParamChangeStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz2, err := ParamChangeStatusFromString(s)
+	if err != nil {
+		return err
+	}
+	*status = bz2
+	return nil
+}
+
+func (status ParamChangeStatus) String() string {
+	switch status {
+	case StatusPending:
+		return "Pending"
+	case StatusActive:
+		return "Active"
+	case StatusApplied:
+		return "Applied"
+	case StatusReverted:
+		return "Reverted"
+	case StatusSuperseded:
+		return "Superseded"
+	case StatusFailed:
+		return "Failed"
+	default:
+		return ""
+	}
+}
+
+// For Printf / Sprintf, returns the status name when using %s
+// nolint: errcheck
+func (status ParamChangeStatus) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(status.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(status))))
+	}
+}
+
+// ParamChange is a parameter change scheduled by a passed parameter proposal. It is applied
+// at ActivationHeight and, if ExpiryHeight is not 0, the previous value is restored at ExpiryHeight
+type ParamChange struct {
+	ID               uint64            `json:"id"`
+	ProposalID       uint64            `json:"proposal_id"`
+	Subspace         string            `json:"subspace"`
+	Key              string            `json:"key"`
+	Value            string            `json:"value"`
+	ActivationHeight int64             `json:"activation_height"`
+	ExpiryHeight     int64             `json:"expiry_height"`
+	PreviousValue    string            `json:"previous_value"` // the stored value replaced at the activation height
+	Status           ParamChangeStatus `json:"status"`
+}
+
+func (pc ParamChange) String() string {
+	return fmt.Sprintf(`ParamChange %d:
+  Proposal ID:        %d
+  Parameter:          %s/%s
+  Value:              %s
+  Activation Height:  %d
+  Expiry Height:      %d
+  Previous Value:     %s
+  Status:             %s`,
+		pc.ID, pc.ProposalID, pc.Subspace, pc.Key, pc.Value,
+		pc.ActivationHeight, pc.ExpiryHeight, pc.PreviousValue, pc.Status)
+}
+
+type ParamChanges []ParamChange
+
+func (pcs ParamChanges) String() string {
+	if len(pcs) == 0 {
+		return "[]"
+	}
+	var out []string
+	for _, pc := range pcs {
+		out = append(out, pc.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+// get a scheduled parameter change
+func (k Keeper) GetParamChange(ctx sdk.Context, id uint64) (change ParamChange, found bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(GetParamChangeKey(id))
+	if bz == nil {
+		return change, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &change)
+	return change, true
+}
+
+// set a scheduled parameter change
+func (k Keeper) SetParamChange(ctx sdk.Context, change ParamChange) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(change)
+	store.Set(GetParamChangeKey(change.ID), bz)
+}
+
+// iterate over all the scheduled parameter changes in the order of their ids
+func (k Keeper) IterateParamChanges(ctx sdk.Context, fn func(change ParamChange) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, ParamChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change ParamChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &change)
+		if fn(change) {
+			break
+		}
+	}
+}
+
+// get the id of the next scheduled parameter change
+func (k Keeper) GetNextParamChangeID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(NextParamChangeIDKey)
+	if bz == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+// set the id of the next scheduled parameter change
+func (k Keeper) SetNextParamChangeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	store.Set(NextParamChangeIDKey, bz)
+}
+
+// ValidateParamChange checks the parameter and the schedule of a change regardless of the current height
+func (k Keeper) ValidateParamChange(subspace, key, value string, activationHeight, expiryHeight int64) sdk.Error {
+	if activationHeight < 0 {
+		return ErrInvalidParamChange(fmt.Sprintf("activation height %d is negative", activationHeight))
+	}
+	if expiryHeight != 0 && expiryHeight <= activationHeight {
+		return ErrInvalidParamChange(fmt.Sprintf("expiry height %d must be 0 or greater than activation height %d", expiryHeight, activationHeight))
+	}
+	if _, ok := k.GetSubspace(subspace); !ok {
+		return ErrInvalidParamChange(fmt.Sprintf("the module %s does not support params change", subspace))
+	}
+	paramSet, ok := k.GetParamSet(subspace)
+	if !ok {
+		return ErrInvalidParamChange(fmt.Sprintf("the module %s does not support params change", subspace))
+	}
+	_, err := paramSet.Validate(key, value)
+	return err
+}
+
+// ScheduleParamChange schedules a parameter change, which is applied right away if the
+// activation height has been reached
+func (k Keeper) ScheduleParamChange(ctx sdk.Context, proposalID uint64, subspace, key, value string,
+	activationHeight, expiryHeight int64) (ParamChange, sdk.Error) {
+
+	if err := k.ValidateParamChange(subspace, key, value, activationHeight, expiryHeight); err != nil {
+		return ParamChange{}, err
+	}
+	if expiryHeight != 0 && expiryHeight <= ctx.BlockHeight() {
+		return ParamChange{}, ErrInvalidParamChange(fmt.Sprintf("expiry height %d has been reached", expiryHeight))
+	}
+
+	id := k.GetNextParamChangeID(ctx)
+	change := ParamChange{
+		ID:               id,
+		ProposalID:       proposalID,
+		Subspace:         subspace,
+		Key:              key,
+		Value:            value,
+		ActivationHeight: activationHeight,
+		ExpiryHeight:     expiryHeight,
+		Status:           StatusPending,
+	}
+	k.SetNextParamChangeID(ctx, id+1)
+
+	ctx.Logger().Info("Schedule parameter change", "id", id, "proposal_id", proposalID, "param", subspace+"/"+key,
+		"value", value, "activation_height", activationHeight, "expiry_height", expiryHeight)
+
+	if activationHeight <= ctx.BlockHeight() {
+		change = k.activateParamChange(ctx, change)
+		return change, nil
+	}
+
+	k.SetParamChange(ctx, change)
+	store := ctx.KVStore(k.key)
+	store.Set(GetPendingParamChangeQueueKey(activationHeight, id), []byte{})
+	return change, nil
+}
+
+// apply the value of a change, the changes with an expiry height are queued to be reverted
+func (k Keeper) activateParamChange(ctx sdk.Context, change ParamChange) ParamChange {
+	err := k.ValidateParamChange(change.Subspace, change.Key, change.Value, change.ActivationHeight, change.ExpiryHeight)
+	if err != nil {
+		change.Status = StatusFailed
+		k.SetParamChange(ctx, change)
+		ctx.Logger().Error("Activate parameter change failed", "id", change.ID, "param", change.Subspace+"/"+change.Key, "err", err.Error())
+		return change
+	}
+
+	subspace, _ := k.GetSubspace(change.Subspace)
+	paramSet, _ := k.GetParamSet(change.Subspace)
+	value, _ := paramSet.Validate(change.Key, change.Value)
+	change.PreviousValue = string(subspace.GetRaw(ctx, []byte(change.Key)))
+	subspace.Set(WithProposalID(ctx, change.ProposalID), []byte(change.Key), value)
+
+	change.Status = StatusApplied
+	if change.ExpiryHeight != 0 {
+		change.Status = StatusActive
+		store := ctx.KVStore(k.key)
+		store.Set(GetActiveParamChangeQueueKey(change.ExpiryHeight, change.ID), []byte{})
+	}
+	k.SetParamChange(ctx, change)
+
+	ctx.Logger().Info("Activate parameter change", "id", change.ID, "param", change.Subspace+"/"+change.Key,
+		"value", change.Value, "previous_value", change.PreviousValue)
+	return change
+}
+
+// restore the value replaced by a change, unless the parameter has been changed again meanwhile
+func (k Keeper) revertParamChange(ctx sdk.Context, change ParamChange) ParamChange {
+	subspace, _ := k.GetSubspace(change.Subspace)
+	paramSet, _ := k.GetParamSet(change.Subspace)
+	value, _ := paramSet.Validate(change.Key, change.Value)
+
+	current := subspace.GetRaw(ctx, []byte(change.Key))
+	if len(change.PreviousValue) == 0 || string(current) != string(k.cdc.MustMarshalJSON(value)) {
+		change.Status = StatusSuperseded
+		k.SetParamChange(ctx, change)
+		ctx.Logger().Info("Parameter change superseded", "id", change.ID, "param", change.Subspace+"/"+change.Key,
+			"current_value", string(current))
+		return change
+	}
+
+	// the previous value is validated like a new value, the rules may have changed since it was stored
+	if _, err := k.restoredValue(change); err != nil {
+		change.Status = StatusFailed
+		k.SetParamChange(ctx, change)
+		ctx.Logger().Error("Revert parameter change failed", "id", change.ID, "param", change.Subspace+"/"+change.Key,
+			"previous_value", change.PreviousValue, "err", err.Error())
+		return change
+	}
+
+	subspace.SetRaw(WithProposalID(ctx, change.ProposalID), []byte(change.Key), []byte(change.PreviousValue))
+	change.Status = StatusReverted
+	k.SetParamChange(ctx, change)
+
+	ctx.Logger().Info("Revert parameter change", "id", change.ID, "param", change.Subspace+"/"+change.Key,
+		"restored_value", change.PreviousValue)
+	return change
+}
+
+// the previous value of a change, parsed and validated by the param set of its subspace
+func (k Keeper) restoredValue(change ParamChange) (interface{}, sdk.Error) {
+	paramSet, ok := k.GetParamSet(change.Subspace)
+	if !ok {
+		return nil, ErrInvalidParamChange(fmt.Sprintf("the module %s does not support params change", change.Subspace))
+	}
+	str, err := paramSet.StringFromBytes(k.cdc, change.Key, []byte(change.PreviousValue))
+	if err != nil {
+		return nil, ErrInvalidParamChange(fmt.Sprintf("invalid previous value %s: %s", change.PreviousValue, err.Error()))
+	}
+	return paramSet.Validate(change.Key, str)
+}
+
+// GetParamChangeValue returns the value set by a change, which is the previous value if the change has been reverted
+func (k Keeper) GetParamChangeValue(change ParamChange) (interface{}, sdk.Error) {
+	switch change.Status {
+	case StatusApplied, StatusActive:
+		paramSet, ok := k.GetParamSet(change.Subspace)
+		if !ok {
+			return nil, ErrInvalidParamChange(fmt.Sprintf("the module %s does not support params change", change.Subspace))
+		}
+		return paramSet.Validate(change.Key, change.Value)
+	case StatusReverted:
+		return k.restoredValue(change)
+	default:
+		return nil, ErrInvalidParamChange(fmt.Sprintf("parameter change %d has set no value", change.ID))
+	}
+}
+
+// ApplyParamChanges activates the pending changes and reverts the expired ones up to the current height,
+// and returns the changes handled
+func (k Keeper) ApplyParamChanges(ctx sdk.Context) (changes ParamChanges) {
+	height := ctx.BlockHeight()
+
+	for _, id := range k.dequeueParamChanges(ctx, PendingParamChangeQueue, GetPendingParamChangeQueueHeightKey(height)) {
+		change, found := k.GetParamChange(ctx, id)
+		if !found {
+			ctx.Logger().Error("Pending parameter change not found", "id", id)
+			continue
+		}
+		changes = append(changes, k.activateParamChange(ctx, change))
+	}
+
+	for _, id := range k.dequeueParamChanges(ctx, ActiveParamChangeQueue, GetActiveParamChangeQueueHeightKey(height)) {
+		change, found := k.GetParamChange(ctx, id)
+		if !found {
+			ctx.Logger().Error("Active parameter change not found", "id", id)
+			continue
+		}
+		changes = append(changes, k.revertParamChange(ctx, change))
+	}
+	return changes
+}
+
+// remove the changes queued up to the given height prefix and return their ids
+func (k Keeper) dequeueParamChanges(ctx sdk.Context, queue, heightKey []byte) (ids []uint64) {
+	store := ctx.KVStore(k.key)
+	iterator := store.Iterator(queue, sdk.PrefixEndBytes(heightKey))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		keys = append(keys, key)
+		ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return ids
+}
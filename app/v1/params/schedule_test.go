@@ -0,0 +1,148 @@
+package params
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+var keyMaxNum = []byte("MaxNum")
+
+// a param set with a single uint16 parameter
+type scheduleParams struct {
+	MaxNum uint16
+}
+
+func (p *scheduleParams) KeyValuePairs() KeyValuePairs {
+	return KeyValuePairs{{Key: keyMaxNum, Value: &p.MaxNum}}
+}
+
+func (p *scheduleParams) Validate(key string, value string) (interface{}, sdk.Error) {
+	num, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || num == 0 {
+		return nil, ErrInvalidString(value)
+	}
+	return uint16(num), nil
+}
+
+func (p *scheduleParams) GetParamSpace() string { return "schedule" }
+
+func (p *scheduleParams) StringFromBytes(cdc *codec.Codec, key string, bytes []byte) (string, error) {
+	return string(bytes), nil
+}
+
+func (p *scheduleParams) String() string { return "" }
+
+func (p *scheduleParams) ReadOnly() bool { return false }
+
+func TestScheduleParamChange(t *testing.T) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey("params")
+	tkey := sdk.NewTransientStoreKey("transient_params")
+	ctx := defaultContext(key, tkey)
+	keeper := NewKeeper(cdc, key, tkey)
+	space := keeper.Subspace("schedule").WithTypeTable(NewTypeTable().RegisterParamSet(&scheduleParams{}))
+	keeper.RegisterParamSet(&scheduleParams{})
+	space.SetParamSet(ctx, &scheduleParams{MaxNum: 10})
+
+	getMaxNum := func() (num uint16) {
+		space.Get(ctx, keyMaxNum, &num)
+		return num
+	}
+
+	// invalid schedules
+	_, err := keeper.ScheduleParamChange(ctx, 1, "schedule", "MaxNum", "20", 10, 10)
+	require.NotNil(t, err)
+	_, err = keeper.ScheduleParamChange(ctx, 1, "schedule", "MaxNum", "0", 10, 0)
+	require.NotNil(t, err)
+	_, err = keeper.ScheduleParamChange(ctx, 1, "unknown", "MaxNum", "20", 10, 0)
+	require.NotNil(t, err)
+
+	ctx = ctx.WithBlockHeight(5)
+	change, err := keeper.ScheduleParamChange(ctx, 1, "schedule", "MaxNum", "20", 10, 20)
+	require.Nil(t, err)
+	require.Equal(t, StatusPending, change.Status)
+
+	ctx = ctx.WithBlockHeight(9)
+	keeper.ApplyParamChanges(ctx)
+	require.Equal(t, uint16(10), getMaxNum())
+
+	ctx = ctx.WithBlockHeight(10)
+	changes := keeper.ApplyParamChanges(ctx)
+	require.Equal(t, uint16(20), getMaxNum())
+	require.Len(t, changes, 1)
+	require.Equal(t, StatusActive, changes[0].Status)
+	value, err := keeper.GetParamChangeValue(changes[0])
+	require.Nil(t, err)
+	require.Equal(t, uint16(20), value)
+	change, _ = keeper.GetParamChange(ctx, change.ID)
+	require.Equal(t, StatusActive, change.Status)
+
+	ctx = ctx.WithBlockHeight(20)
+	changes = keeper.ApplyParamChanges(ctx)
+	require.Equal(t, uint16(10), getMaxNum())
+	require.Len(t, changes, 1)
+	value, err = keeper.GetParamChangeValue(changes[0])
+	require.Nil(t, err)
+	require.Equal(t, uint16(10), value)
+	change, _ = keeper.GetParamChange(ctx, change.ID)
+	require.Equal(t, StatusReverted, change.Status)
+
+	// a change whose activation height has been reached is applied right away,
+	// and it is not reverted if the parameter is changed again before its expiry
+	change, err = keeper.ScheduleParamChange(ctx, 2, "schedule", "MaxNum", "30", 15, 25)
+	require.Nil(t, err)
+	require.Equal(t, StatusActive, change.Status)
+	require.Equal(t, uint16(30), getMaxNum())
+
+	space.Set(ctx, keyMaxNum, uint16(40))
+	ctx = ctx.WithBlockHeight(25)
+	keeper.ApplyParamChanges(ctx)
+	require.Equal(t, uint16(40), getMaxNum())
+	change, _ = keeper.GetParamChange(ctx, change.ID)
+	require.Equal(t, StatusSuperseded, change.Status)
+
+	// export and import the changes
+	genesis := ExportGenesis(ctx, keeper)
+	require.Equal(t, 2, len(genesis.ParamChanges))
+	require.Nil(t, ValidateGenesis(genesis))
+	InitGenesis(ctx, keeper, genesis)
+	require.Equal(t, uint64(3), keeper.GetNextParamChangeID(ctx))
+}
+
+func TestRevertInvalidParamChange(t *testing.T) {
+	cdc := codec.New()
+	key := sdk.NewKVStoreKey("params")
+	tkey := sdk.NewTransientStoreKey("transient_params")
+	ctx := defaultContext(key, tkey)
+	keeper := NewKeeper(cdc, key, tkey)
+	space := keeper.Subspace("schedule").WithTypeTable(NewTypeTable().RegisterParamSet(&scheduleParams{}))
+	keeper.RegisterParamSet(&scheduleParams{})
+
+	// the stored value is no longer valid, so it is not restored at the expiry height
+	space.SetParamSet(ctx, &scheduleParams{MaxNum: 0})
+	change, err := keeper.ScheduleParamChange(ctx, 1, "schedule", "MaxNum", "20", 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, StatusActive, change.Status)
+
+	ctx = ctx.WithBlockHeight(10)
+	changes := keeper.ApplyParamChanges(ctx)
+	require.Len(t, changes, 1)
+	require.Equal(t, StatusFailed, changes[0].Status)
+	_, err = keeper.GetParamChangeValue(changes[0])
+	require.NotNil(t, err)
+	var num uint16
+	space.Get(ctx, keyMaxNum, &num)
+	require.Equal(t, uint16(20), num)
+
+	// a queued change which does not exist is skipped
+	store := ctx.KVStore(key)
+	store.Set(GetPendingParamChangeQueueKey(11, 100), []byte{})
+	ctx = ctx.WithBlockHeight(11)
+	require.Empty(t, keeper.ApplyParamChanges(ctx))
+	require.False(t, store.Has(GetPendingParamChangeQueueKey(11, 100)))
+}
@@ -36,6 +36,8 @@ func (pss ParamSets) String() string {
 			p = ps
 		}
 	}
-	s += fmt.Sprintf("\n%s", p.String())
+	if p != nil {
+		s += fmt.Sprintf("\n%s", p.String())
+	}
 	return s
 }
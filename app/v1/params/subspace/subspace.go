@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"bytes"
 	"reflect"
 
 	"github.com/irisnet/irishub/codec"
@@ -18,8 +19,13 @@ type Subspace struct {
 	name []byte
 
 	table TypeTable
+
+	recorder Recorder
 }
 
+// Recorder is called after a write changes the stored value of a parameter
+type Recorder func(ctx sdk.Context, subspace string, key []byte, old, new []byte)
+
 // NewSubspace constructs a store with namestore
 func NewSubspace(cdc *codec.Codec, key sdk.StoreKey, tkey sdk.StoreKey, name string) (res Subspace) {
 	res = Subspace{
@@ -57,6 +63,12 @@ func (s Subspace) WithTypeTable(table TypeTable) Subspace {
 	return s
 }
 
+// WithRecorder returns a Subspace reporting its parameter changes to the recorder
+func (s Subspace) WithRecorder(recorder Recorder) Subspace {
+	s.recorder = recorder
+	return s
+}
+
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
 	// append here is safe, appends within a function won't cause
@@ -135,11 +147,30 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 	if err != nil {
 		panic(err)
 	}
+	old := store.Get(key)
 	store.Set(key, bz)
 
 	tstore := s.transientStore(ctx)
 	tstore.Set(key, []byte{})
 
+	// the initial values are not recorded, only the changes of them
+	if s.recorder != nil && old != nil && !bytes.Equal(old, bz) {
+		s.recorder(ctx, s.Name(), key, old, bz)
+	}
+}
+
+// Set raw bytes of parameter, the bytes must be decodable as the registered type
+func (s Subspace) SetRaw(ctx sdk.Context, key []byte, bz []byte) {
+	ty, ok := s.table.m[string(key)]
+	if !ok {
+		panic("Parameter not registered")
+	}
+
+	ptr := reflect.New(ty).Interface()
+	if err := s.cdc.UnmarshalJSON(bz, ptr); err != nil {
+		panic(err)
+	}
+	s.Set(ctx, key, ptr)
 }
 
 // Get to ParamSet
@@ -233,7 +233,7 @@ func (p *ProtocolV1) configKeepers() {
 		p.cdc,
 		protocol.KeyDistr,
 		p.paramsKeeper.Subspace(distr.DefaultParamspace),
-		p.bankKeeper, &stakeKeeper, p.feeKeeper,
+		p.bankKeeper, &stakeKeeper, p.feeKeeper, p.guardianKeeper,
 		distr.DefaultCodespace, distr.PrometheusMetrics(p.config),
 	)
 	p.slashingKeeper = slashing.NewKeeper(
@@ -277,9 +277,18 @@ func (p *ProtocolV1) configKeepers() {
 		gov.DefaultCodespace,
 		gov.PrometheusMetrics(p.config),
 		p.assetKeeper,
+		p.router,
 	)
 
-	p.randKeeper = rand.NewKeeper(p.cdc, protocol.KeyRand, rand.DefaultCodespace)
+	p.randKeeper = rand.NewKeeper(
+		p.cdc,
+		protocol.KeyRand,
+		p.bankKeeper,
+		p.StakeKeeper,
+		p.slashingKeeper,
+		rand.DefaultCodespace,
+		p.paramsKeeper.Subspace(rand.DefaultParamSpace),
+	)
 }
 
 // configure all Routers
@@ -313,8 +322,9 @@ func (p *ProtocolV1) configFeeHandlers() {
 	authAnteHandler := auth.NewAnteHandler(p.accountMapper, p.feeKeeper)
 	assetAnteHandler := asset.NewAnteHandler(p.assetKeeper)
 	bankAnteHandler := bank.NewAnteHandler(p.accountMapper)
+	freezeAnteHandler := asset.NewFreezeAnteHandler(p.assetKeeper)
 
-	p.anteHandlers = []sdk.AnteHandler{authAnteHandler, bankAnteHandler, assetAnteHandler}
+	p.anteHandlers = []sdk.AnteHandler{authAnteHandler, bankAnteHandler, freezeAnteHandler, assetAnteHandler}
 	p.feeRefundHandler = auth.NewFeeRefundHandler(p.accountMapper, p.feeKeeper)
 	p.feePreprocessHandler = auth.NewFeePreprocessHandler(p.feeKeeper)
 }
@@ -340,16 +350,20 @@ func (p *ProtocolV1) GetKVStoreKeyList() []*sdk.KVStoreKey {
 
 // configure all Params
 func (p *ProtocolV1) configParams() {
-	p.paramsKeeper.RegisterParamSet(&mint.Params{}, &slashing.Params{}, &service.Params{}, &auth.Params{}, &stake.Params{}, &distr.Params{}, &asset.Params{}, &gov.GovParams{})
+	p.paramsKeeper.RegisterParamSet(&mint.Params{}, &slashing.Params{}, &service.Params{}, &auth.Params{}, &stake.Params{}, &distr.Params{}, &asset.Params{}, &rand.Params{}, &gov.GovParams{})
 }
 
 // application updates every begin block
 func (p *ProtocolV1) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// apply the scheduled parameter changes before any module reads its parameters
+	paramChanges := params.BeginBlocker(ctx, p.paramsKeeper)
+	p.govKeeper.SetParamChangeMetrics(ctx, paramChanges)
+
 	// mint new tokens for this new block
 	tags := mint.BeginBlocker(ctx, p.mintKeeper)
 
 	// distribute rewards from previous block
-	distr.BeginBlocker(ctx, req, p.distrKeeper)
+	distrTags := distr.BeginBlocker(ctx, req, p.distrKeeper)
 
 	slashTags := slashing.BeginBlocker(ctx, req, p.slashingKeeper)
 
@@ -358,7 +372,7 @@ func (p *ProtocolV1) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) a
 
 	ctx.CoinFlowTags().TagWrite()
 
-	tags = tags.AppendTags(slashTags).AppendTags(randTags)
+	tags = tags.AppendTags(distrTags).AppendTags(slashTags).AppendTags(randTags)
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
 	}
@@ -370,6 +384,7 @@ func (p *ProtocolV1) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 	tags = tags.AppendTags(slashing.EndBlocker(ctx, req, p.slashingKeeper))
 	tags = tags.AppendTags(service.EndBlocker(ctx, p.serviceKeeper))
 	tags = tags.AppendTags(upgrade.EndBlocker(ctx, p.upgradeKeeper))
+	tags = tags.AppendTags(guardian.EndBlocker(ctx, p.guardianKeeper))
 	validatorUpdates := stake.EndBlocker(ctx, p.StakeKeeper)
 	if p.trackCoinFlow {
 		ctx.CoinFlowTags().TagWrite()
@@ -404,7 +419,7 @@ func (p *ProtocolV1) InitChainer(ctx sdk.Context, DeliverTx sdk.DeliverTx, req a
 	// load the accounts
 	for _, gacc := range genesisState.Accounts {
 		acc := gacc.ToAccount()
-		acc.AccountNumber = p.accountMapper.GetNextAccountNumber(ctx)
+		acc.SetAccountNumber(p.accountMapper.GetNextAccountNumber(ctx))
 		p.accountMapper.SetGenesisAccount(ctx, acc)
 	}
 
@@ -425,6 +440,7 @@ func (p *ProtocolV1) InitChainer(ctx sdk.Context, DeliverTx sdk.DeliverTx, req a
 	guardian.InitGenesis(ctx, p.guardianKeeper, genesisState.GuardianData)
 	upgrade.InitGenesis(ctx, p.upgradeKeeper, genesisState.UpgradeData)
 	rand.InitGenesis(ctx, p.randKeeper, genesisState.RandData)
+	params.InitGenesis(ctx, p.paramsKeeper, genesisState.ParamsData)
 
 	// load the address to pubkey map
 	err = IrisValidateGenesisState(genesisState)
@@ -534,6 +550,11 @@ func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddre
 	h.sh.OnValidatorBeginUnbonding(ctx, consAddr, valAddr)
 }
 
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	h.dh.OnValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+	h.sh.OnValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+}
+
 func (h Hooks) OnDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.dh.OnDelegationCreated(ctx, delAddr, valAddr)
 	h.sh.OnDelegationCreated(ctx, delAddr, valAddr)
@@ -29,7 +29,42 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) (tags s
 		reqID := GenerateRequestID(request)
 
 		// generate a random number
-		rand := MakePRNG(lastBlockHash, currentTimestamp, request.Consumer).GetRand()
+		var rng RNG = MakePRNG(lastBlockHash, currentTimestamp, request.Consumer)
+		fulfilled := true
+		var fulfillers []sdk.AccAddress
+
+		if request.Source == RandSourceCommitReveal {
+			fulfilled = false
+			if round, found := k.GetSeedRound(ctx, reqID); found {
+				revealed, slashTags := k.CloseSeedRound(ctx, round)
+				tags = tags.AppendTags(slashTags)
+
+				// fall back to PRNG if no seed is revealed
+				if len(revealed) > 0 {
+					var seeds [][]byte
+					for _, commitment := range revealed {
+						seeds = append(seeds, commitment.Seed)
+						fulfillers = append(fulfillers, sdk.AccAddress(commitment.Validator))
+					}
+
+					rng = MakeCommitRevealRNG(request.TxHash, seeds)
+					fulfilled = true
+				}
+			}
+		}
+
+		rand := rng.GetRand()
+
+		// the service fee is paid for the fulfilled request, otherwise refunded
+		var err sdk.Error
+		if fulfilled {
+			err = k.PayServiceFee(ctx, request, fulfillers)
+		} else {
+			err = k.RefundServiceFee(ctx, request)
+		}
+		if err != nil {
+			ctx.Logger().Error("failed to settle the service fee", "request_id", hex.EncodeToString(reqID), "err", err.Error())
+		}
 		k.SetRand(ctx, reqID, NewRand(request.TxHash, lastBlockHeight, rand))
 
 		// remove the request
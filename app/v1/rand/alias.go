@@ -7,16 +7,24 @@ import (
 
 // exported types
 type (
-	MsgRequestRand = types.MsgRequestRand
-	Rand           = types.Rand
-	Request        = types.Request
-	Requests       = types.Requests
+	MsgRequestRand    = types.MsgRequestRand
+	MsgCommitRandSeed = types.MsgCommitRandSeed
+	MsgRevealRandSeed = types.MsgRevealRandSeed
+	Rand              = types.Rand
+	Request           = types.Request
+	Requests          = types.Requests
+	RandSource        = types.RandSource
+	RNG               = types.RNG
+	SeedRound         = types.SeedRound
+	SeedCommitment    = types.SeedCommitment
+	Participation     = types.Participation
 
 	Params       = types.Params
 	GenesisState = types.GenesisState
 
 	QueryRandParams             = types.QueryRandParams
 	QueryRandRequestQueueParams = types.QueryRandRequestQueueParams
+	QueryConsumerRequestsParams = types.QueryConsumerRequestsParams
 
 	Keeper = keeper.Keeper
 )
@@ -29,22 +37,37 @@ var (
 	DefaultParamsForTest = types.DefaultParamsForTest
 	ValidateParams       = types.ValidateParams
 	RegisterCodec        = types.RegisterCodec
+	ParamTypeTable       = types.ParamTypeTable
 
 	NewMsgRequestRand    = types.NewMsgRequestRand
+	NewMsgCommitRandSeed = types.NewMsgCommitRandSeed
+	NewMsgRevealRandSeed = types.NewMsgRevealRandSeed
 	NewRand              = types.NewRand
 	NewRequest           = types.NewRequest
 	MakePRNG             = types.MakePRNG
+	MakeCommitRevealRNG  = types.MakeCommitRevealRNG
+	GenerateSeedHash     = types.GenerateSeedHash
+	RandSourceFromString = types.RandSourceFromString
 	GenerateRequestID    = types.GenerateRequestID
 	CheckReqID           = types.CheckReqID
 	DefaultBlockInterval = types.DefaultBlockInterval
 	RandPrec             = types.RandPrec
+	MaxSeedLength        = types.MaxSeedLength
+	RevealBlockInterval  = types.RevealBlockInterval
+
+	RandSourcePRNG         = types.RandSourcePRNG
+	RandSourceCommitReveal = types.RandSourceCommitReveal
 
 	QueryRand             = types.QueryRand
 	QueryRandRequestQueue = types.QueryRandRequestQueue
+	QueryParticipation    = types.QueryParticipation
+	QueryConsumerRequests = types.QueryConsumerRequests
 
 	TagReqID      = types.TagReqID
 	TagRandHeight = types.TagRandHeight
 	TagRand       = types.TagRand
+	TagSource     = types.TagSource
+	TagValidator  = types.TagValidator
 
 	NewKeeper  = keeper.NewKeeper
 	NewQuerier = keeper.NewQuerier
@@ -1,6 +1,8 @@
 package rand
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -9,6 +11,12 @@ import (
 
 // InitGenesis stores genesis data
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetParamSet(ctx, data.Params)
+
 	for height, requests := range data.PendingRandRequests {
 		for _, request := range requests {
 			h, err := strconv.ParseInt(height, 10, 64)
@@ -20,34 +28,123 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 			k.EnqueueRandRequest(ctx, h, reqID, request)
 		}
 	}
+
+	for _, participation := range data.SeedRounds {
+		k.SetSeedRound(ctx, participation.Round)
+		for _, commitment := range participation.Commitments {
+			k.SetSeedCommitment(ctx, participation.Round.ReqID, commitment)
+		}
+	}
 }
 
 // ExportGenesis outputs genesis data
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	pendingRequests := make(map[string][]Request)
+	seedRounds := make([]Participation, 0)
 
 	k.IterateRandRequestQueue(ctx, func(height int64, request Request) bool {
 		leftHeight := fmt.Sprintf("%d", height-ctx.BlockHeight()+1)
 		pendingRequests[leftHeight] = append(pendingRequests[leftHeight], request)
 
+		// the deadlines of the open round are rebased the same as the request height
+		if request.Source == RandSourceCommitReveal {
+			if participation, err := k.GetParticipation(ctx, GenerateRequestID(request)); err == nil {
+				participation.Round.CommitDeadline -= ctx.BlockHeight() - 1
+				participation.Round.RevealDeadline -= ctx.BlockHeight() - 1
+				seedRounds = append(seedRounds, participation)
+			}
+		}
+
 		return false
 	})
 
 	return GenesisState{
+		Params:              k.GetParamSet(ctx),
 		PendingRandRequests: pendingRequests,
+		SeedRounds:          seedRounds,
 	}
 }
 
 // DefaultGenesisState gets the default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
+		Params:              DefaultParams(),
 		PendingRandRequests: map[string][]Request{},
+		SeedRounds:          []Participation{},
 	}
 }
 
 // DefaultGenesisStateForTest gets the default genesis state for test
 func DefaultGenesisStateForTest() GenesisState {
 	return GenesisState{
+		Params:              DefaultParamsForTest(),
 		PendingRandRequests: map[string][]Request{},
+		SeedRounds:          []Participation{},
 	}
 }
+
+// ValidateGenesis validates the provided rand genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	return validateSeedRounds(data.PendingRandRequests, data.SeedRounds)
+}
+
+// validateSeedRounds validates the commit-reveal rounds, each of which must belong to
+// a pending commit-reveal request queued at the reveal deadline of the round
+func validateSeedRounds(pendingRequests map[string][]Request, rounds []Participation) error {
+	requestHeights := make(map[string]int64)
+	for height, requests := range pendingRequests {
+		h, err := strconv.ParseInt(height, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid height %s of pending rand requests", height)
+		}
+
+		for _, request := range requests {
+			if request.Source == RandSourceCommitReveal {
+				requestHeights[hex.EncodeToString(GenerateRequestID(request))] = h
+			}
+		}
+	}
+
+	seenRounds := make(map[string]bool)
+	for _, participation := range rounds {
+		round := participation.Round
+		reqID := hex.EncodeToString(round.ReqID)
+
+		if seenRounds[reqID] {
+			return fmt.Errorf("duplicate commit-reveal round of request %s", reqID)
+		}
+		seenRounds[reqID] = true
+
+		height, found := requestHeights[reqID]
+		if !found {
+			return fmt.Errorf("commit-reveal round of request %s has no pending commit-reveal request", reqID)
+		}
+		if round.RevealDeadline != height || round.CommitDeadline >= round.RevealDeadline {
+			return fmt.Errorf("invalid deadlines of commit-reveal round %s, commit deadline %d, reveal deadline %d",
+				reqID, round.CommitDeadline, round.RevealDeadline)
+		}
+
+		seenValidators := make(map[string]bool)
+		for _, commitment := range participation.Commitments {
+			if len(commitment.Validator) == 0 || len(commitment.SeedHash) == 0 {
+				return fmt.Errorf("invalid seed commitment of commit-reveal round %s", reqID)
+			}
+			if seenValidators[commitment.Validator.String()] {
+				return fmt.Errorf("duplicate seed commitment of validator %s in commit-reveal round %s", commitment.Validator, reqID)
+			}
+			seenValidators[commitment.Validator.String()] = true
+
+			if commitment.Revealed() && (len(commitment.Seed) > MaxSeedLength ||
+				!bytes.Equal(GenerateSeedHash(commitment.Seed, commitment.Validator), commitment.SeedHash)) {
+				return fmt.Errorf("revealed seed of validator %s does not match the seed hash in commit-reveal round %s", commitment.Validator, reqID)
+			}
+		}
+	}
+
+	return nil
+}
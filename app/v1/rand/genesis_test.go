@@ -4,6 +4,9 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
+	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/store"
 	sdk "github.com/irisnet/irishub/types"
@@ -13,24 +16,34 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey) {
+func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.TransientStoreKey) {
 	db := dbm.NewMemDB()
 	randKey := sdk.NewKVStoreKey("randkey")
+	accountKey := sdk.NewKVStoreKey("accountKey")
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTkey := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(randKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(accountKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTkey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
-	return ms, randKey
+	return ms, randKey, accountKey, paramsKey, paramsTkey
 }
 
 func TestExportRandGenesis(t *testing.T) {
-	ms, randKey := setupMultiStore()
+	ms, randKey, accountKey, paramsKey, paramsTkey := setupMultiStore()
 
 	cdc := codec.New()
 	RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
 
-	keeper := NewKeeper(cdc, randKey, DefaultCodespace)
+	pk := params.NewKeeper(cdc, paramsKey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
+	keeper := NewKeeper(cdc, randKey, bk, nil, nil, DefaultCodespace, pk.Subspace(DefaultParamSpace))
 
 	// define variables
 	txBytes := []byte("testtx")
@@ -45,9 +58,15 @@ func TestExportRandGenesis(t *testing.T) {
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	ctx = ctx.WithBlockHeight(txHeight).WithTxBytes(txBytes)
 
+	InitGenesis(ctx, keeper, DefaultGenesisStateForTest())
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(1, 18))}
+	bk.AddCoins(ctx, consumer1, coins)
+	bk.AddCoins(ctx, consumer2, coins)
+
 	// request rands
-	keeper.RequestRand(ctx, consumer1, blockInterval1)
-	keeper.RequestRand(ctx, consumer2, blockInterval2)
+	keeper.RequestRand(ctx, consumer1, blockInterval1, RandSourcePRNG)
+	keeper.RequestRand(ctx, consumer2, blockInterval2, RandSourcePRNG)
 
 	// get the pending requests from queue
 	storedRequests := make(map[int64][]Request)
@@ -64,6 +83,7 @@ func TestExportRandGenesis(t *testing.T) {
 	exportedGenesis := ExportGenesis(ctx, keeper)
 	exportedRequests := exportedGenesis.PendingRandRequests
 	require.Equal(t, 2, len(exportedRequests))
+	require.Equal(t, DefaultParamsForTest(), exportedGenesis.Params)
 
 	// assert that exported requests are consistant with the requests in queue
 	for height, requests := range exportedRequests {
@@ -73,3 +93,80 @@ func TestExportRandGenesis(t *testing.T) {
 		require.Equal(t, storedRequests[storedHeight], requests)
 	}
 }
+
+func TestExportSeedRoundsGenesis(t *testing.T) {
+	newKeeper := func() (sdk.Context, Keeper, bank.Keeper) {
+		ms, randKey, accountKey, paramsKey, paramsTkey := setupMultiStore()
+
+		cdc := codec.New()
+		RegisterCodec(cdc)
+		auth.RegisterBaseAccount(cdc)
+
+		pk := params.NewKeeper(cdc, paramsKey, paramsTkey)
+		ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+		bk := bank.NewBaseKeeper(cdc, ak)
+		keeper := NewKeeper(cdc, randKey, bk, nil, nil, DefaultCodespace, pk.Subspace(DefaultParamSpace))
+
+		ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+		return ctx, keeper, bk
+	}
+
+	txHeight := int64(10000)
+	newBlockHeight := txHeight + 105
+	consumer := sdk.AccAddress([]byte("consumer"))
+	validator1 := sdk.ValAddress([]byte("validator1"))
+	validator2 := sdk.ValAddress([]byte("validator2"))
+	seed := []byte("seed")
+
+	ctx, keeper, bk := newKeeper()
+	ctx = ctx.WithBlockHeight(txHeight).WithTxBytes([]byte("testtx"))
+	InitGenesis(ctx, keeper, DefaultGenesisStateForTest())
+	bk.AddCoins(ctx, consumer, sdk.Coins{sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(1, 18))})
+
+	_, err := keeper.RequestRand(ctx, consumer, 100, RandSourceCommitReveal)
+	require.Nil(t, err)
+	request := NewRequest(txHeight, consumer, sdk.SHA256([]byte("testtx")), RandSourceCommitReveal, sdk.Coins{DefaultParamsForTest().ServiceFee})
+	reqID := GenerateRequestID(request)
+
+	// one validator has revealed the seed and the other has not
+	revealed := SeedCommitment{Validator: validator1, SeedHash: GenerateSeedHash(seed, validator1), CommitHeight: txHeight + 1, Seed: seed, RevealHeight: txHeight + 101}
+	keeper.SetSeedCommitment(ctx, reqID, revealed)
+	keeper.SetSeedCommitment(ctx, reqID, SeedCommitment{Validator: validator2, SeedHash: GenerateSeedHash(seed, validator2), CommitHeight: txHeight + 2})
+	participation, err := keeper.GetParticipation(ctx, reqID)
+	require.Nil(t, err)
+
+	// the deadlines are rebased the same as the request height
+	ctx = ctx.WithBlockHeight(newBlockHeight)
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(exportedGenesis))
+	require.Len(t, exportedGenesis.SeedRounds, 1)
+	exportedRound := exportedGenesis.SeedRounds[0].Round
+	require.Equal(t, txHeight+100-newBlockHeight+1, exportedRound.CommitDeadline)
+	require.Equal(t, txHeight+110-newBlockHeight+1, exportedRound.RevealDeadline)
+	require.Equal(t, []Request{request}, exportedGenesis.PendingRandRequests[strconv.FormatInt(exportedRound.RevealDeadline, 10)])
+	require.Equal(t, participation.Commitments, exportedGenesis.SeedRounds[0].Commitments)
+
+	// the round and the commitments are imported
+	ctx, keeper, _ = newKeeper()
+	ctx = ctx.WithBlockHeight(1)
+	InitGenesis(ctx, keeper, exportedGenesis)
+	importedRound, found := keeper.GetSeedRound(ctx, reqID)
+	require.True(t, found)
+	require.Equal(t, exportedRound, importedRound)
+	require.Equal(t, participation.Commitments, keeper.GetSeedCommitments(ctx, reqID))
+
+	// invalid rounds
+	invalidGenesis := exportedGenesis
+	invalidGenesis.SeedRounds = append(exportedGenesis.SeedRounds, exportedGenesis.SeedRounds[0])
+	require.NotNil(t, ValidateGenesis(invalidGenesis))
+
+	invalidGenesis.SeedRounds = []Participation{{Round: SeedRound{ReqID: reqID, CommitDeadline: exportedRound.CommitDeadline, RevealDeadline: exportedRound.RevealDeadline + 1}}}
+	require.NotNil(t, ValidateGenesis(invalidGenesis))
+
+	invalidGenesis.SeedRounds = []Participation{{Round: SeedRound{ReqID: []byte("unknown"), CommitDeadline: exportedRound.CommitDeadline, RevealDeadline: exportedRound.RevealDeadline}}}
+	require.NotNil(t, ValidateGenesis(invalidGenesis))
+
+	revealed.Seed = []byte("other seed")
+	invalidGenesis.SeedRounds = []Participation{{Round: exportedRound, Commitments: []SeedCommitment{revealed}}}
+	require.NotNil(t, ValidateGenesis(invalidGenesis))
+}
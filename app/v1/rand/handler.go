@@ -1,6 +1,8 @@
 package rand
 
 import (
+	"encoding/hex"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -10,6 +12,10 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgRequestRand:
 			return handleMsgRequestRand(ctx, k, msg)
+		case MsgCommitRandSeed:
+			return handleMsgCommitRandSeed(ctx, k, msg)
+		case MsgRevealRandSeed:
+			return handleMsgRevealRandSeed(ctx, k, msg)
 		default:
 			return sdk.ErrTxDecode("invalid message parsed in rand module").Result()
 		}
@@ -20,7 +26,35 @@ func NewHandler(k Keeper) sdk.Handler {
 
 // handleMsgRequestRand handles MsgRequestRand
 func handleMsgRequestRand(ctx sdk.Context, k Keeper, msg MsgRequestRand) sdk.Result {
-	tags, err := k.RequestRand(ctx, msg.Consumer, msg.BlockInterval)
+	tags, err := k.RequestRand(ctx, msg.Consumer, msg.BlockInterval, msg.Source)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgCommitRandSeed handles MsgCommitRandSeed
+func handleMsgCommitRandSeed(ctx sdk.Context, k Keeper, msg MsgCommitRandSeed) sdk.Result {
+	reqID, _ := hex.DecodeString(msg.ReqID)
+
+	tags, err := k.CommitSeed(ctx, reqID, msg.Validator, msg.SeedHash)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// handleMsgRevealRandSeed handles MsgRevealRandSeed
+func handleMsgRevealRandSeed(ctx sdk.Context, k Keeper, msg MsgRevealRandSeed) sdk.Result {
+	reqID, _ := hex.DecodeString(msg.ReqID)
+
+	tags, err := k.RevealSeed(ctx, reqID, msg.Validator, msg.Seed)
 	if err != nil {
 		return err.Result()
 	}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/rand/internal/types"
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
@@ -14,16 +16,25 @@ import (
 type Keeper struct {
 	storeKey sdk.StoreKey
 	cdc      *codec.Codec
+	bk       types.BankKeeper
+	sk       types.StakeKeeper
+	slk      types.SlashingKeeper
 
 	// codespace
 	codespace sdk.CodespaceType
+	// params subspace
+	paramSpace params.Subspace
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, bk types.BankKeeper, sk types.StakeKeeper, slk types.SlashingKeeper, codespace sdk.CodespaceType, paramSpace params.Subspace) Keeper {
 	return Keeper{
-		storeKey:  key,
-		cdc:       cdc,
-		codespace: codespace,
+		storeKey:   key,
+		cdc:        cdc,
+		bk:         bk,
+		sk:         sk,
+		slk:        slk,
+		codespace:  codespace,
+		paramSpace: paramSpace.WithTypeTable(types.ParamTypeTable()),
 	}
 }
 
@@ -38,25 +49,55 @@ func (k Keeper) GetCdc() *codec.Codec {
 }
 
 // RequestRand requests a random number
-func (k Keeper) RequestRand(ctx sdk.Context, consumer sdk.AccAddress, blockInterval uint64) (sdk.Tags, sdk.Error) {
+func (k Keeper) RequestRand(ctx sdk.Context, consumer sdk.AccAddress, blockInterval uint64, source types.RandSource) (sdk.Tags, sdk.Error) {
 	currentHeight := ctx.BlockHeight()
 	destHeight := currentHeight + int64(blockInterval)
 
+	if len(source) == 0 {
+		source = types.RandSourcePRNG
+	}
+
+	params := k.GetParamSet(ctx)
+
+	// limit the pending requests of the consumer
+	if pending := k.GetPendingRequestNum(ctx, consumer); pending >= params.MaxPendingRequests {
+		return nil, types.ErrTooManyRequests(k.codespace, fmt.Sprintf("the consumer %s has %d pending requests", consumer, pending))
+	}
+
+	// escrow the service fee
+	serviceFee := sdk.Coins{}
+	if !params.ServiceFee.IsZero() {
+		serviceFee = sdk.Coins{params.ServiceFee}
+		if _, err := k.bk.SendCoins(ctx, consumer, auth.RandRequestCoinsAccAddr, serviceFee); err != nil {
+			return nil, err
+		}
+	}
+
 	// get tx hash
 	txHash := sdk.SHA256(ctx.TxBytes())
 
 	// build request
-	request := types.NewRequest(currentHeight, consumer, txHash)
+	request := types.NewRequest(currentHeight, consumer, txHash, source, serviceFee)
 
 	// generate the request id
 	reqID := types.GenerateRequestID(request)
 
+	// the seeds are committed until the block interval elapses and revealed in
+	// the following blocks, after which the random number is generated
+	if source == types.RandSourceCommitReveal {
+		revealDeadline := destHeight + types.RevealBlockInterval
+		k.SetSeedRound(ctx, types.NewSeedRound(reqID, destHeight, revealDeadline))
+
+		destHeight = revealDeadline
+	}
+
 	// add to the queue
 	k.EnqueueRandRequest(ctx, destHeight, reqID, request)
 
 	reqTags := sdk.NewTags(
 		types.TagReqID, []byte(hex.EncodeToString(reqID)),
 		types.TagRandHeight, []byte(fmt.Sprintf("%d", destHeight)),
+		types.TagSource, []byte(source),
 	)
 
 	return reqTags, nil
@@ -76,14 +117,68 @@ func (k Keeper) EnqueueRandRequest(ctx sdk.Context, height int64, reqID []byte,
 
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(request)
 	store.Set(KeyRandRequestQueue(height, reqID), bz)
+
+	// index the request by the consumer
+	store.Set(KeyConsumerRequest(request.Consumer, reqID), k.cdc.MustMarshalBinaryLengthPrefixed(height))
 }
 
 // DequeueRandRequest removes the random number request by the specified height and request id
 func (k Keeper) DequeueRandRequest(ctx sdk.Context, height int64, reqID []byte) {
 	store := ctx.KVStore(k.storeKey)
 
-	// delete the key
+	bz := store.Get(KeyRandRequestQueue(height, reqID))
+	if bz == nil {
+		return
+	}
+
+	var request types.Request
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &request)
+
+	// delete the keys
 	store.Delete(KeyRandRequestQueue(height, reqID))
+	store.Delete(KeyConsumerRequest(request.Consumer, reqID))
+}
+
+// GetPendingRequestNum returns the number of the pending requests of the consumer
+func (k Keeper) GetPendingRequestNum(ctx sdk.Context, consumer sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, KeyConsumerRequestSubspace(consumer))
+	defer iterator.Close()
+
+	num := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		num++
+	}
+
+	return num
+}
+
+// GetPendingRequestsByConsumer retrieves the pending requests of the consumer
+func (k Keeper) GetPendingRequestsByConsumer(ctx sdk.Context, consumer sdk.AccAddress) []types.Request {
+	store := ctx.KVStore(k.storeKey)
+
+	subspace := KeyConsumerRequestSubspace(consumer)
+	iterator := sdk.KVStorePrefixIterator(store, subspace)
+	defer iterator.Close()
+
+	requests := make([]types.Request, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var height int64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &height)
+
+		reqID := iterator.Key()[len(subspace):]
+		bz := store.Get(KeyRandRequestQueue(height, reqID))
+		if bz == nil {
+			continue
+		}
+
+		var request types.Request
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &request)
+		requests = append(requests, request)
+	}
+
+	return requests
 }
 
 // GetRand retrieves the random number by the specified request id
@@ -143,3 +238,15 @@ func (k Keeper) IterateRandRequestQueue(ctx sdk.Context, op func(h int64, r type
 		}
 	}
 }
+
+// get rand params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// set rand params from the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/rand/internal/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// PayServiceFee pays the escrowed service fee of a fulfilled request. The fee is shared
+// equally by the fulfillers, and the remainder goes to the community pool
+func (k Keeper) PayServiceFee(ctx sdk.Context, request types.Request, fulfillers []sdk.AccAddress) sdk.Error {
+	if request.ServiceFee.IsZero() {
+		return nil
+	}
+
+	communityFee := request.ServiceFee
+	if len(fulfillers) > 0 {
+		share := sdk.Coins{}
+		for _, coin := range request.ServiceFee {
+			share = append(share, sdk.NewCoin(coin.Denom, coin.Amount.Div(sdk.NewInt(int64(len(fulfillers))))))
+		}
+
+		if !share.IsZero() {
+			for _, fulfiller := range fulfillers {
+				if _, err := k.bk.SendCoins(ctx, auth.RandRequestCoinsAccAddr, fulfiller, share); err != nil {
+					return err
+				}
+				communityFee = communityFee.Sub(share)
+			}
+		}
+	}
+
+	if communityFee.IsZero() {
+		return nil
+	}
+
+	if _, err := k.bk.SendCoins(ctx, auth.RandRequestCoinsAccAddr, auth.CommunityTaxCoinsAccAddr, communityFee); err != nil {
+		return err
+	}
+	ctx.CoinFlowTags().AppendCoinFlowTag(ctx, auth.RandRequestCoinsAccAddr.String(), auth.CommunityTaxCoinsAccAddr.String(), communityFee.String(), sdk.CommunityTaxCollectFlow, "")
+
+	return nil
+}
+
+// RefundServiceFee refunds the escrowed service fee of a failed request to the consumer
+func (k Keeper) RefundServiceFee(ctx sdk.Context, request types.Request) sdk.Error {
+	if request.ServiceFee.IsZero() {
+		return nil
+	}
+
+	_, err := k.bk.SendCoins(ctx, auth.RandRequestCoinsAccAddr, request.Consumer, request.ServiceFee)
+	return err
+}
@@ -2,12 +2,17 @@ package keeper
 
 import (
 	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
 )
 
 var (
 	KeyDelimiter           = []byte(":")                 // key delimiter
 	PrefixRand             = []byte("rands:")            // key prefix for the random number
 	PrefixRandRequestQueue = []byte("randRequestQueue:") // key prefix for the random number request queue
+	PrefixSeedRound        = []byte("seedRounds:")       // key prefix for the commit-reveal round
+	PrefixSeedCommitment   = []byte("seedCommitments:")  // key prefix for the seed commitment
+	PrefixConsumerRequest  = []byte("consumerRequests:") // key prefix for the pending requests of a consumer
 )
 
 // KeyRand returns the key for a random number by the specified request id
@@ -24,3 +29,28 @@ func KeyRandRequestQueue(height int64, reqID []byte) []byte {
 func KeyRandRequestQueueSubspace(height int64) []byte {
 	return []byte(fmt.Sprintf("randRequestQueue:%d:", height))
 }
+
+// KeySeedRound returns the key for the commit-reveal round by the specified request id
+func KeySeedRound(reqID []byte) []byte {
+	return append(append([]byte{}, PrefixSeedRound...), reqID...)
+}
+
+// KeySeedCommitment returns the key for the seed commitment by the given request id and validator
+func KeySeedCommitment(reqID []byte, validator sdk.ValAddress) []byte {
+	return append(KeySeedCommitmentSubspace(reqID), validator...)
+}
+
+// KeySeedCommitmentSubspace returns the key prefix for iterating through all seed commitments of the specified request
+func KeySeedCommitmentSubspace(reqID []byte) []byte {
+	return append(append([]byte{}, PrefixSeedCommitment...), reqID...)
+}
+
+// KeyConsumerRequest returns the key for the pending request of a consumer by the given request id
+func KeyConsumerRequest(consumer sdk.AccAddress, reqID []byte) []byte {
+	return append(KeyConsumerRequestSubspace(consumer), reqID...)
+}
+
+// KeyConsumerRequestSubspace returns the key prefix for iterating through all pending requests of the consumer
+func KeyConsumerRequestSubspace(consumer sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("consumerRequests:%s:", consumer.String()))
+}
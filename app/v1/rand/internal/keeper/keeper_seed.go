@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/rand/internal/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// SetSeedRound stores the commit-reveal round
+func (k Keeper) SetSeedRound(ctx sdk.Context, round types.SeedRound) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(round)
+	store.Set(KeySeedRound(round.ReqID), bz)
+}
+
+// GetSeedRound retrieves the commit-reveal round by the specified request id
+func (k Keeper) GetSeedRound(ctx sdk.Context, reqID []byte) (round types.SeedRound, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(KeySeedRound(reqID))
+	if bz == nil {
+		return round, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &round)
+	return round, true
+}
+
+// SetSeedCommitment stores the seed commitment of a validator
+func (k Keeper) SetSeedCommitment(ctx sdk.Context, reqID []byte, commitment types.SeedCommitment) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(commitment)
+	store.Set(KeySeedCommitment(reqID, commitment.Validator), bz)
+}
+
+// GetSeedCommitment retrieves the seed commitment of a validator
+func (k Keeper) GetSeedCommitment(ctx sdk.Context, reqID []byte, validator sdk.ValAddress) (commitment types.SeedCommitment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(KeySeedCommitment(reqID, validator))
+	if bz == nil {
+		return commitment, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &commitment)
+	return commitment, true
+}
+
+// GetSeedCommitments retrieves all the seed commitments of the specified request, ordered by the validator address
+func (k Keeper) GetSeedCommitments(ctx sdk.Context, reqID []byte) []types.SeedCommitment {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, KeySeedCommitmentSubspace(reqID))
+	defer iterator.Close()
+
+	commitments := make([]types.SeedCommitment, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.SeedCommitment
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &commitment)
+
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments
+}
+
+// GetParticipation retrieves the participation record of the validators in the commit-reveal round of the specified request
+func (k Keeper) GetParticipation(ctx sdk.Context, reqID []byte) (types.Participation, sdk.Error) {
+	round, found := k.GetSeedRound(ctx, reqID)
+	if !found {
+		return types.Participation{}, types.ErrInvalidReqID(k.codespace, fmt.Sprintf("no commit-reveal round for the request id: %s", hex.EncodeToString(reqID)))
+	}
+
+	return types.Participation{
+		Round:       round,
+		Commitments: k.GetSeedCommitments(ctx, reqID),
+	}, nil
+}
+
+// CommitSeed commits the seed hash of a bonded validator to the commit-reveal round of a request
+func (k Keeper) CommitSeed(ctx sdk.Context, reqID []byte, validator sdk.ValAddress, seedHash []byte) (sdk.Tags, sdk.Error) {
+	round, found := k.GetSeedRound(ctx, reqID)
+	if !found {
+		return nil, types.ErrInvalidReqID(k.codespace, fmt.Sprintf("no commit-reveal round for the request id: %s", hex.EncodeToString(reqID)))
+	}
+
+	if ctx.BlockHeight() > round.CommitDeadline {
+		return nil, types.ErrInvalidPeriod(k.codespace, fmt.Sprintf("the commit period ended at height %d", round.CommitDeadline))
+	}
+
+	val := k.sk.Validator(ctx, validator)
+	if val == nil || val.GetStatus() != sdk.Bonded {
+		return nil, types.ErrInvalidValidator(k.codespace, fmt.Sprintf("%s is not a bonded validator", validator))
+	}
+
+	if _, found := k.GetSeedCommitment(ctx, reqID, validator); found {
+		return nil, types.ErrSeedCommitted(k.codespace, fmt.Sprintf("the validator %s has committed a seed", validator))
+	}
+
+	k.SetSeedCommitment(ctx, reqID, types.NewSeedCommitment(validator, seedHash, ctx.BlockHeight()))
+
+	return sdk.NewTags(
+		types.TagReqID, []byte(hex.EncodeToString(reqID)),
+		types.TagValidator, []byte(validator.String()),
+	), nil
+}
+
+// RevealSeed reveals the seed committed by the validator
+func (k Keeper) RevealSeed(ctx sdk.Context, reqID []byte, validator sdk.ValAddress, seed []byte) (sdk.Tags, sdk.Error) {
+	round, found := k.GetSeedRound(ctx, reqID)
+	if !found {
+		return nil, types.ErrInvalidReqID(k.codespace, fmt.Sprintf("no commit-reveal round for the request id: %s", hex.EncodeToString(reqID)))
+	}
+
+	if ctx.BlockHeight() <= round.CommitDeadline || ctx.BlockHeight() > round.RevealDeadline {
+		return nil, types.ErrInvalidPeriod(k.codespace, fmt.Sprintf("the seeds can only be revealed between height %d and %d", round.CommitDeadline+1, round.RevealDeadline))
+	}
+
+	commitment, found := k.GetSeedCommitment(ctx, reqID, validator)
+	if !found {
+		return nil, types.ErrSeedNotCommitted(k.codespace, fmt.Sprintf("the validator %s has not committed a seed", validator))
+	}
+
+	if commitment.Revealed() {
+		return nil, types.ErrInvalidSeed(k.codespace, fmt.Sprintf("the validator %s has revealed the seed", validator))
+	}
+
+	if !bytes.Equal(types.GenerateSeedHash(seed, validator), commitment.SeedHash) {
+		return nil, types.ErrInvalidSeed(k.codespace, "the seed does not match the committed seed hash")
+	}
+
+	commitment.Seed = seed
+	commitment.RevealHeight = ctx.BlockHeight()
+	k.SetSeedCommitment(ctx, reqID, commitment)
+
+	return sdk.NewTags(
+		types.TagReqID, []byte(hex.EncodeToString(reqID)),
+		types.TagValidator, []byte(validator.String()),
+	), nil
+}
+
+// CloseSeedRound closes the commit-reveal round of the request: the validators which have
+// committed but not revealed the seeds are slashed, and the revealed commitments are returned
+func (k Keeper) CloseSeedRound(ctx sdk.Context, round types.SeedRound) (revealed []types.SeedCommitment, tags sdk.Tags) {
+	for _, commitment := range k.GetSeedCommitments(ctx, round.ReqID) {
+		if commitment.Revealed() {
+			revealed = append(revealed, commitment)
+			continue
+		}
+
+		ctx.Logger().Info("Validator did not reveal the committed seed",
+			"validator", commitment.Validator.String(), "request_id", hex.EncodeToString(round.ReqID))
+		tags = tags.AppendTags(k.slk.HandleMissingRandReveal(ctx, commitment.Validator, round.RevealDeadline))
+	}
+
+	return revealed, tags
+}
@@ -3,34 +3,64 @@ package keeper
 import (
 	"testing"
 
+	"github.com/irisnet/irishub/app/v1/auth"
+	"github.com/irisnet/irishub/app/v1/bank"
+	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/app/v1/rand/internal/types"
+	stake "github.com/irisnet/irishub/app/v1/stake/types"
 	"github.com/irisnet/irishub/codec"
 	"github.com/irisnet/irishub/store"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey) {
+var (
+	serviceFee   = sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(1, 17))
+	initialCoins = sdk.Coins{sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(1, 18))}
+)
+
+func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.TransientStoreKey) {
 	db := dbm.NewMemDB()
 	randKey := sdk.NewKVStoreKey("randkey")
+	accountKey := sdk.NewKVStoreKey("accountKey")
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTkey := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(randKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(accountKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTkey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
-	return ms, randKey
+	return ms, randKey, accountKey, paramsKey, paramsTkey
 }
 
-func TestRequestRandKeeper(t *testing.T) {
-	ms, randKey := setupMultiStore()
+// setupKeeper creates a rand keeper with the test params and a bank keeper to fund the accounts
+func setupKeeper(sk types.StakeKeeper, slk types.SlashingKeeper) (sdk.Context, Keeper, bank.Keeper, *sdk.KVStoreKey, *codec.Codec) {
+	ms, randKey, accountKey, paramsKey, paramsTkey := setupMultiStore()
 
 	cdc := codec.New()
 	types.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, paramsKey, paramsTkey)
+	ak := auth.NewAccountKeeper(cdc, accountKey, auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, ak)
 
-	keeper := NewKeeper(cdc, randKey, types.DefaultCodespace)
+	keeper := NewKeeper(cdc, randKey, bk, sk, slk, types.DefaultCodespace, pk.Subspace(types.DefaultParamSpace))
+	keeper.SetParamSet(ctx, types.DefaultParamsForTest())
+
+	return ctx, keeper, bk, randKey, cdc
+}
+
+func TestRequestRandKeeper(t *testing.T) {
+	ctx, keeper, bk, randKey, cdc := setupKeeper(nil, nil)
 
 	// define variables
 	txBytes := []byte("testtx")
@@ -38,9 +68,10 @@ func TestRequestRandKeeper(t *testing.T) {
 	blockInterval := uint64(100)
 	destHeight := txHeight + int64(blockInterval)
 	consumer := sdk.AccAddress([]byte("consumer"))
+	_, _, err := bk.AddCoins(ctx, consumer, initialCoins)
+	require.Nil(t, err)
 
 	// build context
-	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	ctx = ctx.WithBlockHeight(txHeight).WithTxBytes(txBytes)
 	require.Equal(t, txHeight, ctx.BlockHeight())
 	require.Equal(t, txBytes, ctx.TxBytes())
@@ -54,11 +85,15 @@ func TestRequestRandKeeper(t *testing.T) {
 	require.True(t, len(requests) == 0)
 
 	// request a rand
-	_, err := keeper.RequestRand(ctx, consumer, blockInterval)
+	_, err = keeper.RequestRand(ctx, consumer, blockInterval, types.RandSourcePRNG)
 	require.Nil(t, err)
 
+	// the service fee is escrowed
+	require.Equal(t, initialCoins.Sub(sdk.Coins{serviceFee}), bk.GetCoins(ctx, consumer))
+	require.Equal(t, sdk.Coins{serviceFee}, bk.GetCoins(ctx, auth.RandRequestCoinsAccAddr))
+
 	// get request id
-	reqID := types.GenerateRequestID(types.NewRequest(txHeight, consumer, sdk.SHA256(txBytes)))
+	reqID := types.GenerateRequestID(types.NewRequest(txHeight, consumer, sdk.SHA256(txBytes), types.RandSourcePRNG, sdk.Coins{serviceFee}))
 
 	// get the pending request and assert the result is not nil
 	store := ctx.KVStore(randKey)
@@ -71,8 +106,184 @@ func TestRequestRandKeeper(t *testing.T) {
 	require.Equal(t, txHeight, request.Height)
 	require.Equal(t, consumer, request.Consumer)
 	require.Equal(t, sdk.SHA256(txBytes), request.TxHash)
+	require.Equal(t, sdk.Coins{serviceFee}, request.ServiceFee)
 
 	// get the rand and assert the result is nil
 	bz = store.Get(KeyRand(reqID))
 	require.Nil(t, bz)
+
+	// the pending requests are indexed by the consumer
+	require.Equal(t, []types.Request{request}, keeper.GetPendingRequestsByConsumer(ctx, consumer))
+
+	// the fee of the fulfilled request goes to the community pool
+	require.Nil(t, keeper.PayServiceFee(ctx, request, nil))
+	require.Equal(t, sdk.Coins{serviceFee}, bk.GetCoins(ctx, auth.CommunityTaxCoinsAccAddr))
+
+	keeper.DequeueRandRequest(ctx, destHeight, reqID)
+	require.Equal(t, 0, len(keeper.GetPendingRequestsByConsumer(ctx, consumer)))
+}
+
+func TestRequestRandRateLimit(t *testing.T) {
+	ctx, keeper, bk, _, _ := setupKeeper(nil, nil)
+
+	consumer := sdk.AccAddress([]byte("consumer"))
+	_, _, err := bk.AddCoins(ctx, consumer, initialCoins)
+	require.Nil(t, err)
+
+	// the consumer can have at most MaxPendingRequests pending requests
+	maxPendingRequests := types.DefaultParamsForTest().MaxPendingRequests
+	for i := uint64(0); i < maxPendingRequests; i++ {
+		ctx = ctx.WithBlockHeight(int64(100 + i)).WithTxBytes([]byte("testtx"))
+		_, err = keeper.RequestRand(ctx, consumer, 10, types.RandSourcePRNG)
+		require.Nil(t, err)
+	}
+	require.Equal(t, maxPendingRequests, keeper.GetPendingRequestNum(ctx, consumer))
+
+	ctx = ctx.WithBlockHeight(200)
+	_, err = keeper.RequestRand(ctx, consumer, 10, types.RandSourcePRNG)
+	require.NotNil(t, err)
+
+	// the other consumers are not affected
+	consumer2 := sdk.AccAddress([]byte("consumer2"))
+	_, _, err = bk.AddCoins(ctx, consumer2, initialCoins)
+	require.Nil(t, err)
+
+	_, err = keeper.RequestRand(ctx, consumer2, 10, types.RandSourcePRNG)
+	require.Nil(t, err)
+
+	// the consumer without enough balance for the service fee
+	consumer3 := sdk.AccAddress([]byte("consumer3"))
+	_, err = keeper.RequestRand(ctx, consumer3, 10, types.RandSourcePRNG)
+	require.NotNil(t, err)
+}
+
+// mockStakeKeeper returns the validators which are bonded
+type mockStakeKeeper struct {
+	validators map[string]stake.Validator
+}
+
+func (sk mockStakeKeeper) Validator(ctx sdk.Context, address sdk.ValAddress) sdk.Validator {
+	validator, found := sk.validators[address.String()]
+	if !found {
+		return nil
+	}
+	return validator
+}
+
+// mockSlashingKeeper records the validators slashed
+type mockSlashingKeeper struct {
+	slashed *[]sdk.ValAddress
+}
+
+func (slk mockSlashingKeeper) HandleMissingRandReveal(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64) sdk.Tags {
+	*slk.slashed = append(*slk.slashed, valAddr)
+	return nil
+}
+
+func TestCommitRevealSeedKeeper(t *testing.T) {
+	// three bonded validators and an unbonded one
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress([]byte("validator1")),
+		sdk.ValAddress([]byte("validator2")),
+		sdk.ValAddress([]byte("validator3")),
+		sdk.ValAddress([]byte("validator4")),
+	}
+	validators := make(map[string]stake.Validator)
+	for i, valAddr := range valAddrs {
+		validator := stake.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), stake.Description{})
+		if i < 3 {
+			validator.Status = sdk.Bonded
+		}
+		validators[valAddr.String()] = validator
+	}
+
+	var slashed []sdk.ValAddress
+	ctx, keeper, bk, randKey, _ := setupKeeper(mockStakeKeeper{validators}, mockSlashingKeeper{&slashed})
+
+	txBytes := []byte("testtx")
+	txHeight := int64(10000)
+	blockInterval := uint64(10)
+	commitDeadline := txHeight + int64(blockInterval)
+	revealDeadline := commitDeadline + types.RevealBlockInterval
+	consumer := sdk.AccAddress([]byte("consumer"))
+	_, _, err := bk.AddCoins(ctx, consumer, initialCoins)
+	require.Nil(t, err)
+
+	ctx = ctx.WithBlockHeight(txHeight).WithTxBytes(txBytes)
+
+	// request a rand from the commit-reveal source
+	_, err = keeper.RequestRand(ctx, consumer, blockInterval, types.RandSourceCommitReveal)
+	require.Nil(t, err)
+
+	reqID := types.GenerateRequestID(types.NewRequest(txHeight, consumer, sdk.SHA256(txBytes), types.RandSourceCommitReveal, sdk.Coins{serviceFee}))
+
+	// the request is handled after the reveal period
+	store := ctx.KVStore(randKey)
+	require.NotNil(t, store.Get(KeyRandRequestQueue(revealDeadline, reqID)))
+
+	round, found := keeper.GetSeedRound(ctx, reqID)
+	require.True(t, found)
+	require.Equal(t, commitDeadline, round.CommitDeadline)
+	require.Equal(t, revealDeadline, round.RevealDeadline)
+
+	seeds := [][]byte{[]byte("seed1"), []byte("seed2"), []byte("seed3"), []byte("seed4")}
+
+	// commit the seeds
+	ctx = ctx.WithBlockHeight(txHeight + 1)
+	for i := 0; i < 3; i++ {
+		_, err = keeper.CommitSeed(ctx, reqID, valAddrs[i], types.GenerateSeedHash(seeds[i], valAddrs[i]))
+		require.Nil(t, err)
+	}
+
+	// duplicate commitment
+	_, err = keeper.CommitSeed(ctx, reqID, valAddrs[0], types.GenerateSeedHash(seeds[0], valAddrs[0]))
+	require.NotNil(t, err)
+
+	// unbonded validator
+	_, err = keeper.CommitSeed(ctx, reqID, valAddrs[3], types.GenerateSeedHash(seeds[3], valAddrs[3]))
+	require.NotNil(t, err)
+
+	// the seeds can not be revealed in the commit period
+	_, err = keeper.RevealSeed(ctx, reqID, valAddrs[0], seeds[0])
+	require.NotNil(t, err)
+
+	// the commit period ended
+	ctx = ctx.WithBlockHeight(commitDeadline + 1)
+	_, err = keeper.CommitSeed(ctx, reqID, valAddrs[3], types.GenerateSeedHash(seeds[3], valAddrs[3]))
+	require.NotNil(t, err)
+
+	// a seed not matching the commitment
+	_, err = keeper.RevealSeed(ctx, reqID, valAddrs[0], seeds[1])
+	require.NotNil(t, err)
+
+	// reveal the seeds of the first two validators
+	for i := 0; i < 2; i++ {
+		_, err = keeper.RevealSeed(ctx, reqID, valAddrs[i], seeds[i])
+		require.Nil(t, err)
+	}
+
+	participation, err := keeper.GetParticipation(ctx, reqID)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(participation.Commitments))
+
+	// close the round, the validator not revealing the seed is slashed
+	ctx = ctx.WithBlockHeight(revealDeadline + 1)
+	revealed, _ := keeper.CloseSeedRound(ctx, round)
+	require.Equal(t, 2, len(revealed))
+	require.Equal(t, seeds[0], revealed[0].Seed)
+	require.Equal(t, seeds[1], revealed[1].Seed)
+	require.Equal(t, []sdk.ValAddress{valAddrs[2]}, slashed)
+
+	// the fee is shared by the validators revealing the seeds
+	var request types.Request
+	cdc := keeper.GetCdc()
+	cdc.MustUnmarshalBinaryLengthPrefixed(store.Get(KeyRandRequestQueue(revealDeadline, reqID)), &request)
+
+	fulfillers := []sdk.AccAddress{sdk.AccAddress(valAddrs[0]), sdk.AccAddress(valAddrs[1])}
+	require.Nil(t, keeper.PayServiceFee(ctx, request, fulfillers))
+
+	share := sdk.Coins{sdk.NewCoin(sdk.IrisAtto, serviceFee.Amount.Div(sdk.NewInt(2)))}
+	require.Equal(t, share, bk.GetCoins(ctx, fulfillers[0]))
+	require.Equal(t, share, bk.GetCoins(ctx, fulfillers[1]))
+	require.True(t, bk.GetCoins(ctx, auth.RandRequestCoinsAccAddr).IsZero())
 }
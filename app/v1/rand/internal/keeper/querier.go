@@ -17,6 +17,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryRand(ctx, req, k)
 		case types.QueryRandRequestQueue:
 			return queryRandRequestQueue(ctx, req, k)
+		case types.QueryParticipation:
+			return queryParticipation(ctx, req, k)
+		case types.QueryConsumerRequests:
+			return queryConsumerRequests(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown rand query endpoint")
 		}
@@ -48,6 +52,48 @@ func queryRand(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, s
 	return bz, nil
 }
 
+func queryParticipation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRandParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	reqID, err := hex.DecodeString(params.ReqID)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	participation, err2 := keeper.GetParticipation(ctx, reqID)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, participation)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+
+	return bz, nil
+}
+
+func queryConsumerRequests(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryConsumerRequestsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	requests := keeper.GetPendingRequestsByConsumer(ctx, params.Consumer)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, requests)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+
+	return bz, nil
+}
+
 func queryRandRequestQueue(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryRandRequestQueueParams
 	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
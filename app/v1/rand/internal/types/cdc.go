@@ -7,9 +7,13 @@ import (
 // Register concrete types on codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRequestRand{}, "irishub/rand/MsgRequestRand", nil)
+	cdc.RegisterConcrete(MsgCommitRandSeed{}, "irishub/rand/MsgCommitRandSeed", nil)
+	cdc.RegisterConcrete(MsgRevealRandSeed{}, "irishub/rand/MsgRevealRandSeed", nil)
 
 	cdc.RegisterConcrete(&Rand{}, "irishub/rand/Rand", nil)
 	cdc.RegisterConcrete(&Request{}, "irishub/rand/Request", nil)
+	cdc.RegisterConcrete(&SeedRound{}, "irishub/rand/SeedRound", nil)
+	cdc.RegisterConcrete(&SeedCommitment{}, "irishub/rand/SeedCommitment", nil)
 }
 
 var msgCdc = codec.New()
@@ -1,4 +1,4 @@
-//nolint
+// nolint
 package types
 
 import (
@@ -9,9 +9,17 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = "rand"
 
-	CodeInvalidConsumer sdk.CodeType = 100
-	CodeInvalidReqID    sdk.CodeType = 101
-	CodeInvalidHeight   sdk.CodeType = 102
+	CodeInvalidConsumer  sdk.CodeType = 100
+	CodeInvalidReqID     sdk.CodeType = 101
+	CodeInvalidHeight    sdk.CodeType = 102
+	CodeInvalidSource    sdk.CodeType = 103
+	CodeInvalidSeedHash  sdk.CodeType = 104
+	CodeInvalidSeed      sdk.CodeType = 105
+	CodeInvalidValidator sdk.CodeType = 106
+	CodeInvalidPeriod    sdk.CodeType = 107
+	CodeSeedCommitted    sdk.CodeType = 108
+	CodeSeedNotCommitted sdk.CodeType = 109
+	CodeTooManyRequests  sdk.CodeType = 110
 )
 
 //----------------------------------------
@@ -28,3 +36,35 @@ func ErrInvalidReqID(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrInvalidHeight(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidHeight, msg)
 }
+
+func ErrInvalidSource(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSource, msg)
+}
+
+func ErrInvalidSeedHash(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSeedHash, msg)
+}
+
+func ErrInvalidSeed(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSeed, msg)
+}
+
+func ErrInvalidValidator(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, msg)
+}
+
+func ErrInvalidPeriod(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPeriod, msg)
+}
+
+func ErrSeedCommitted(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeSeedCommitted, msg)
+}
+
+func ErrSeedNotCommitted(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeSeedNotCommitted, msg)
+}
+
+func ErrTooManyRequests(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyRequests, msg)
+}
@@ -0,0 +1,18 @@
+package types
+
+import sdk "github.com/irisnet/irishub/types"
+
+// expected bank keeper
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+}
+
+// expected stake keeper
+type StakeKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) sdk.Validator
+}
+
+// expected slashing keeper
+type SlashingKeeper interface {
+	HandleMissingRandReveal(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64) sdk.Tags
+}
@@ -2,5 +2,7 @@ package types
 
 // GenesisState contains all rand state that must be provided at genesis
 type GenesisState struct {
+	Params              Params               `json:"params"` // rand params
 	PendingRandRequests map[string][]Request // pending rand requests: height->[]Request
+	SeedRounds          []Participation      `json:"seed_rounds"` // open commit-reveal rounds of the pending requests
 }
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	sdk "github.com/irisnet/irishub/types"
 )
 
@@ -13,18 +16,22 @@ const (
 )
 
 var _ sdk.Msg = &MsgRequestRand{}
+var _ sdk.Msg = &MsgCommitRandSeed{}
+var _ sdk.Msg = &MsgRevealRandSeed{}
 
 // MsgRequestRand represents a msg for requesting a random number
 type MsgRequestRand struct {
-	Consumer      sdk.AccAddress `json:"consumer"`       // request address
-	BlockInterval uint64         `json:"block-interval"` // block interval after which the requested random number will be generated
+	Consumer      sdk.AccAddress `json:"consumer"`         // request address
+	BlockInterval uint64         `json:"block-interval"`   // block interval after which the requested random number will be generated
+	Source        RandSource     `json:"source,omitempty"` // source of the random number, PRNG by default
 }
 
 // NewMsgRequestRand constructs a MsgRequestRand
-func NewMsgRequestRand(consumer sdk.AccAddress, blockInterval uint64) MsgRequestRand {
+func NewMsgRequestRand(consumer sdk.AccAddress, blockInterval uint64, source RandSource) MsgRequestRand {
 	return MsgRequestRand{
 		Consumer:      consumer,
 		BlockInterval: blockInterval,
+		Source:        source,
 	}
 }
 
@@ -40,6 +47,15 @@ func (msg MsgRequestRand) ValidateBasic() sdk.Error {
 		return ErrInvalidConsumer(DefaultCodespace, "the consumer address must be specified")
 	}
 
+	if !msg.Source.IsValid() {
+		return ErrInvalidSource(DefaultCodespace, fmt.Sprintf("invalid random number source: %s", msg.Source))
+	}
+
+	// the validators need at least one block to commit the seeds
+	if msg.Source == RandSourceCommitReveal && msg.BlockInterval == 0 {
+		return ErrInvalidHeight(DefaultCodespace, "the block interval must be greater than 0 for the commit-reveal source")
+	}
+
 	return nil
 }
 
@@ -57,3 +73,115 @@ func (msg MsgRequestRand) GetSignBytes() []byte {
 func (msg MsgRequestRand) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Consumer}
 }
+
+//______________________________________________________________________
+
+// MsgCommitRandSeed represents a msg for committing the seed hash of a validator to a random number request
+type MsgCommitRandSeed struct {
+	ReqID     string         `json:"req_id"`    // the id of the random number request
+	Validator sdk.ValAddress `json:"validator"` // the operator address of the validator
+	SeedHash  []byte         `json:"seed_hash"` // the hash of the seed, see GenerateSeedHash
+}
+
+// NewMsgCommitRandSeed constructs a MsgCommitRandSeed
+func NewMsgCommitRandSeed(reqID string, validator sdk.ValAddress, seedHash []byte) MsgCommitRandSeed {
+	return MsgCommitRandSeed{
+		ReqID:     reqID,
+		Validator: validator,
+		SeedHash:  seedHash,
+	}
+}
+
+// Implements Msg.
+func (msg MsgCommitRandSeed) Route() string { return MsgRoute }
+
+// Implements Msg.
+func (msg MsgCommitRandSeed) Type() string { return "commit_rand_seed" }
+
+// Implements Msg.
+func (msg MsgCommitRandSeed) ValidateBasic() sdk.Error {
+	if err := CheckReqID(msg.ReqID); err != nil {
+		return err
+	}
+
+	if len(msg.Validator) == 0 {
+		return ErrInvalidValidator(DefaultCodespace, "the validator address must be specified")
+	}
+
+	if len(msg.SeedHash) != sha256.Size {
+		return ErrInvalidSeedHash(DefaultCodespace, fmt.Sprintf("the seed hash must be %d bytes", sha256.Size))
+	}
+
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgCommitRandSeed) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgCommitRandSeed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Validator)}
+}
+
+//______________________________________________________________________
+
+// MsgRevealRandSeed represents a msg for revealing the seed committed by a validator
+type MsgRevealRandSeed struct {
+	ReqID     string         `json:"req_id"`    // the id of the random number request
+	Validator sdk.ValAddress `json:"validator"` // the operator address of the validator
+	Seed      []byte         `json:"seed"`      // the seed committed
+}
+
+// NewMsgRevealRandSeed constructs a MsgRevealRandSeed
+func NewMsgRevealRandSeed(reqID string, validator sdk.ValAddress, seed []byte) MsgRevealRandSeed {
+	return MsgRevealRandSeed{
+		ReqID:     reqID,
+		Validator: validator,
+		Seed:      seed,
+	}
+}
+
+// Implements Msg.
+func (msg MsgRevealRandSeed) Route() string { return MsgRoute }
+
+// Implements Msg.
+func (msg MsgRevealRandSeed) Type() string { return "reveal_rand_seed" }
+
+// Implements Msg.
+func (msg MsgRevealRandSeed) ValidateBasic() sdk.Error {
+	if err := CheckReqID(msg.ReqID); err != nil {
+		return err
+	}
+
+	if len(msg.Validator) == 0 {
+		return ErrInvalidValidator(DefaultCodespace, "the validator address must be specified")
+	}
+
+	if len(msg.Seed) == 0 || len(msg.Seed) > MaxSeedLength {
+		return ErrInvalidSeed(DefaultCodespace, fmt.Sprintf("the seed length must be between 1 and %d bytes", MaxSeedLength))
+	}
+
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgRevealRandSeed) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgRevealRandSeed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Validator)}
+}
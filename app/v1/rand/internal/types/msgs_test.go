@@ -11,19 +11,22 @@ import (
 var (
 	emptyAddr     sdk.AccAddress
 	testAddr      = sdk.AccAddress([]byte("testAddr"))
+	testValAddr   = sdk.ValAddress([]byte("testValAddr"))
 	blockInterval = uint64(10)
+	testReqID     = "0d6e4e4e3ab1b3b5b5d1a4b5e3c2f3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0"
 )
 
 func TestNewMsgRequestRand(t *testing.T) {
-	msg := NewMsgRequestRand(testAddr, blockInterval)
+	msg := NewMsgRequestRand(testAddr, blockInterval, RandSourceCommitReveal)
 
 	require.Equal(t, testAddr, msg.Consumer)
 	require.Equal(t, blockInterval, msg.BlockInterval)
+	require.Equal(t, RandSourceCommitReveal, msg.Source)
 }
 
 func TestMsgRequestRandRoute(t *testing.T) {
 	// build a MsgRequestRand
-	msg := NewMsgRequestRand(testAddr, blockInterval)
+	msg := NewMsgRequestRand(testAddr, blockInterval, RandSourcePRNG)
 
 	require.Equal(t, "rand", msg.Route())
 }
@@ -33,14 +36,19 @@ func TestMsgRequestRandValidation(t *testing.T) {
 		name          string
 		consumer      sdk.AccAddress
 		blockInterval uint64
+		source        RandSource
 		expectPass    bool
 	}{
-		{"empty consumer", emptyAddr, blockInterval, false},
-		{"basic good", testAddr, blockInterval, true},
+		{"empty consumer", emptyAddr, blockInterval, RandSourcePRNG, false},
+		{"invalid source", testAddr, blockInterval, RandSource("unknown"), false},
+		{"no commit period", testAddr, 0, RandSourceCommitReveal, false},
+		{"basic good", testAddr, blockInterval, RandSourcePRNG, true},
+		{"default source", testAddr, blockInterval, "", true},
+		{"commit-reveal source", testAddr, blockInterval, RandSourceCommitReveal, true},
 	}
 
 	for _, td := range testData {
-		msg := NewMsgRequestRand(td.consumer, td.blockInterval)
+		msg := NewMsgRequestRand(td.consumer, td.blockInterval, td.source)
 		if td.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
 		} else {
@@ -50,7 +58,7 @@ func TestMsgRequestRandValidation(t *testing.T) {
 }
 
 func TestMsgRequestRandGetSignBytes(t *testing.T) {
-	var msg = NewMsgRequestRand(testAddr, blockInterval)
+	var msg = NewMsgRequestRand(testAddr, blockInterval, "")
 	res := msg.GetSignBytes()
 
 	expected := "{\"type\":\"irishub/rand/MsgRequestRand\",\"value\":{\"block-interval\":\"10\",\"consumer\":\"faa1w3jhxazpv3j8yxhn3j0\"}}"
@@ -58,9 +66,67 @@ func TestMsgRequestRandGetSignBytes(t *testing.T) {
 }
 
 func TestMsgRequestRandGetSigners(t *testing.T) {
-	var msg = NewMsgRequestRand(testAddr, blockInterval)
+	var msg = NewMsgRequestRand(testAddr, blockInterval, "")
 	res := msg.GetSigners()
 
 	expected := "[7465737441646472]"
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
 }
+
+func TestMsgCommitRandSeedValidation(t *testing.T) {
+	seedHash := GenerateSeedHash([]byte("seed"), testValAddr)
+
+	testData := []struct {
+		name       string
+		reqID      string
+		validator  sdk.ValAddress
+		seedHash   []byte
+		expectPass bool
+	}{
+		{"invalid request id", "0d6e", testValAddr, seedHash, false},
+		{"empty validator", testReqID, sdk.ValAddress{}, seedHash, false},
+		{"invalid seed hash", testReqID, testValAddr, []byte("seed"), false},
+		{"basic good", testReqID, testValAddr, seedHash, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgCommitRandSeed(td.reqID, td.validator, td.seedHash)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
+
+func TestMsgRevealRandSeedValidation(t *testing.T) {
+	testData := []struct {
+		name       string
+		reqID      string
+		validator  sdk.ValAddress
+		seed       []byte
+		expectPass bool
+	}{
+		{"invalid request id", "0d6e", testValAddr, []byte("seed"), false},
+		{"empty validator", testReqID, sdk.ValAddress{}, []byte("seed"), false},
+		{"empty seed", testReqID, testValAddr, []byte{}, false},
+		{"too long seed", testReqID, testValAddr, make([]byte, MaxSeedLength+1), false},
+		{"basic good", testReqID, testValAddr, []byte("seed"), true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgRevealRandSeed(td.reqID, td.validator, td.seed)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
+
+func TestMsgCommitRandSeedGetSigners(t *testing.T) {
+	var msg = NewMsgCommitRandSeed(testReqID, testValAddr, GenerateSeedHash([]byte("seed"), testValAddr))
+	res := msg.GetSigners()
+
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(testValAddr)}, res)
+}
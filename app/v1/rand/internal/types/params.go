@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/irisnet/irishub/app/v1/params"
 	"github.com/irisnet/irishub/codec"
@@ -14,6 +15,12 @@ const (
 	DefaultParamSpace = "rand"
 )
 
+// parameter keys
+var (
+	KeyServiceFee         = []byte("ServiceFee")
+	KeyMaxPendingRequests = []byte("MaxPendingRequests")
+)
+
 // ParamTable for rand module
 func ParamTypeTable() params.TypeTable {
 	return params.NewTypeTable().RegisterParamSet(&Params{})
@@ -21,10 +28,15 @@ func ParamTypeTable() params.TypeTable {
 
 // rand params
 type Params struct {
+	ServiceFee         sdk.Coin `json:"service_fee"`          // fee charged for each random number request, e.g., 0.1*10^18iris-atto
+	MaxPendingRequests uint64   `json:"max_pending_requests"` // max number of pending requests of a consumer, e.g., 10
 }
 
 func (p Params) String() string {
-	return ""
+	return fmt.Sprintf(`Rand Params:
+  rand/ServiceFee:          %s
+  rand/MaxPendingRequests:  %d`,
+		p.ServiceFee.String(), p.MaxPendingRequests)
 }
 
 // Implements params.ParamSet
@@ -33,18 +45,48 @@ func (p *Params) GetParamSpace() string {
 }
 
 func (p *Params) KeyValuePairs() params.KeyValuePairs {
-	return params.KeyValuePairs{}
+	return params.KeyValuePairs{
+		{KeyServiceFee, &p.ServiceFee},
+		{KeyMaxPendingRequests, &p.MaxPendingRequests},
+	}
 }
 
 func (p *Params) Validate(key string, value string) (interface{}, sdk.Error) {
 	switch key {
+	case string(KeyServiceFee):
+		fee, err := sdk.ParseCoin(value)
+		if err != nil || fee.Denom != sdk.IrisAtto {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateServiceFee(fee); err != nil {
+			return nil, err
+		}
+		return fee, nil
+	case string(KeyMaxPendingRequests):
+		maxPendingRequests, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateMaxPendingRequests(maxPendingRequests); err != nil {
+			return nil, err
+		}
+		return maxPendingRequests, nil
 	default:
-		return nil, nil
+		return nil, sdk.NewError(params.DefaultCodespace, params.CodeInvalidKey, fmt.Sprintf("%s is an invalid key", key))
 	}
 }
 
 func (p *Params) StringFromBytes(cdc *codec.Codec, key string, bytes []byte) (string, error) {
-	return "", fmt.Errorf("this method is not implemented")
+	switch key {
+	case string(KeyServiceFee):
+		err := cdc.UnmarshalJSON(bytes, &p.ServiceFee)
+		return p.ServiceFee.String(), err
+	case string(KeyMaxPendingRequests):
+		err := cdc.UnmarshalJSON(bytes, &p.MaxPendingRequests)
+		return strconv.FormatUint(p.MaxPendingRequests, 10), err
+	default:
+		return "", fmt.Errorf("%s is not existed", key)
+	}
 }
 
 func (p *Params) ReadOnly() bool {
@@ -53,14 +95,49 @@ func (p *Params) ReadOnly() bool {
 
 // default rand module params
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		ServiceFee:         sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(1, 17)), // 0.1iris
+		MaxPendingRequests: 10,
+	}
 }
 
 // default rand module params for test
 func DefaultParamsForTest() Params {
-	return Params{}
+	return Params{
+		ServiceFee:         sdk.NewCoin(sdk.IrisAtto, sdk.NewIntWithDecimal(1, 17)), // 0.1iris
+		MaxPendingRequests: 2,
+	}
 }
 
 func ValidateParams(p Params) error {
+	if err := validateServiceFee(p.ServiceFee); err != nil {
+		return err
+	}
+	if err := validateMaxPendingRequests(p.MaxPendingRequests); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateServiceFee(v sdk.Coin) sdk.Error {
+	if v.Denom != sdk.IrisAtto || v.IsNegative() {
+		return sdk.NewError(
+			params.DefaultCodespace,
+			params.CodeInvalidRandServiceFee,
+			fmt.Sprintf("Rand service fee [%s] should be a non-negative amount of %s", v.String(), sdk.IrisAtto),
+		)
+	}
+	return nil
+}
+
+func validateMaxPendingRequests(v uint64) sdk.Error {
+	if v == 0 {
+		return sdk.NewError(
+			params.DefaultCodespace,
+			params.CodeInvalidMaxPendingRequests,
+			fmt.Sprintf("Max pending requests [%d] should be greater than 0", v),
+		)
+	}
 	return nil
 }
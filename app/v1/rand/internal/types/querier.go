@@ -1,11 +1,17 @@
 package types
 
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
 const (
 	QueryRand             = "rand"
 	QueryRandRequestQueue = "queue"
+	QueryParticipation    = "participation"
+	QueryConsumerRequests = "consumer_requests"
 )
 
-// QueryRandParams is the query parameters for 'custom/rand/rand'
+// QueryRandParams is the query parameters for 'custom/rand/rand' and 'custom/rand/participation'
 type QueryRandParams struct {
 	ReqID string
 }
@@ -14,3 +20,8 @@ type QueryRandParams struct {
 type QueryRandRequestQueueParams struct {
 	Height int64
 }
+
+// QueryConsumerRequestsParams is the query parameters for 'custom/rand/consumer_requests'
+type QueryConsumerRequestsParams struct {
+	Consumer sdk.AccAddress
+}
@@ -7,19 +7,48 @@ import (
 	sdk "github.com/irisnet/irishub/types"
 )
 
+// RandSource is the source from which a random number is generated
+type RandSource string
+
+const (
+	RandSourcePRNG         RandSource = "prng"          // pseudo-random number generated from the block
+	RandSourceCommitReveal RandSource = "commit-reveal" // combined from the seeds committed and revealed by the bonded validators
+)
+
+// RandSourceFromString converts the string to a RandSource, an empty string stands for PRNG
+func RandSourceFromString(str string) (RandSource, error) {
+	source := RandSource(str)
+	if !source.IsValid() {
+		return "", fmt.Errorf("invalid random number source: %s", str)
+	}
+	if len(source) == 0 {
+		return RandSourcePRNG, nil
+	}
+	return source, nil
+}
+
+// IsValid returns true if the source is supported, an empty source stands for PRNG
+func (rs RandSource) IsValid() bool {
+	return rs == "" || rs == RandSourcePRNG || rs == RandSourceCommitReveal
+}
+
 // Request represents a request for a random number
 type Request struct {
-	Height   int64          `json:"height"`   // the height of the block in which the request tx is included
-	Consumer sdk.AccAddress `json:"consumer"` // the request address
-	TxHash   []byte         `json:"txhash"`   // the request tx hash
+	Height     int64          `json:"height"`           // the height of the block in which the request tx is included
+	Consumer   sdk.AccAddress `json:"consumer"`         // the request address
+	TxHash     []byte         `json:"txhash"`           // the request tx hash
+	Source     RandSource     `json:"source,omitempty"` // the source of the random number
+	ServiceFee sdk.Coins      `json:"service_fee"`      // the service fee escrowed for the request
 }
 
 // NewRequest constructs a request
-func NewRequest(height int64, consumer sdk.AccAddress, txHash []byte) Request {
+func NewRequest(height int64, consumer sdk.AccAddress, txHash []byte, source RandSource, serviceFee sdk.Coins) Request {
 	return Request{
-		Height:   height,
-		Consumer: consumer,
-		TxHash:   txHash,
+		Height:     height,
+		Consumer:   consumer,
+		TxHash:     txHash,
+		Source:     source,
+		ServiceFee: serviceFee,
 	}
 }
 
@@ -28,8 +57,10 @@ func (r Request) String() string {
 	return fmt.Sprintf(`Request:
   Height:            %d
   Consumer:          %s
-  TxHash:            %s`,
-		r.Height, r.Consumer.String(), hex.EncodeToString(r.TxHash))
+  TxHash:            %s
+  Source:            %s
+  ServiceFee:        %s`,
+		r.Height, r.Consumer.String(), hex.EncodeToString(r.TxHash), r.Source, r.ServiceFee.String())
 }
 
 // Requests is a set of requests
@@ -43,7 +74,7 @@ func (rs Requests) String() string {
 
 	var str string
 	for _, r := range rs {
-		str += fmt.Sprintf("Request:\n  Height: %d, Consumer: %s, TxHash: %s", r.Height, r.Consumer.String(), hex.EncodeToString(r.TxHash))
+		str += fmt.Sprintf("Request:\n  Height: %d, Consumer: %s, TxHash: %s, Source: %s", r.Height, r.Consumer.String(), hex.EncodeToString(r.TxHash), r.Source)
 	}
 
 	return str
@@ -46,3 +46,33 @@ func (p PRNG) GetRand() sdk.Rat {
 
 	return rand
 }
+
+// CommitRevealRNG represents a random number implementation for RNG based on the seeds revealed by the validators
+type CommitRevealRNG struct {
+	RequestTxHash []byte   // hash of the request tx
+	Seeds         [][]byte // seeds revealed by the validators, in a deterministic order
+}
+
+// MakeCommitRevealRNG constructs a CommitRevealRNG
+func MakeCommitRevealRNG(requestTxHash []byte, seeds [][]byte) CommitRevealRNG {
+	return CommitRevealRNG{
+		RequestTxHash: requestTxHash,
+		Seeds:         seeds,
+	}
+}
+
+// GetRand implements RNG
+func (c CommitRevealRNG) GetRand() sdk.Rat {
+	bz := make([]byte, 0)
+
+	bz = append(bz, c.RequestTxHash...)
+	for _, seed := range c.Seeds {
+		bz = append(bz, sdk.SHA256(seed)...)
+	}
+
+	seed := new(big.Int).SetBytes(sdk.SHA256(bz))
+	precision := new(big.Int).Exp(big.NewInt(10), big.NewInt(RandPrec), nil)
+
+	// Generate a random number between [0,1) with `RandPrec` precision from seed
+	return sdk.NewRatFromBigInt(new(big.Int).Mod(seed, precision), precision)
+}
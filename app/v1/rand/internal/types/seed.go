@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+const (
+	// MaxSeedLength is the max length of a seed revealed by a validator
+	MaxSeedLength = 64
+
+	// RevealBlockInterval is the number of blocks during which the committed seeds can be revealed
+	RevealBlockInterval = int64(10)
+)
+
+// SeedRound represents the commit-reveal round of a random number request.
+// The validators commit the seed hashes until CommitDeadline, and then
+// reveal the seeds until RevealDeadline
+type SeedRound struct {
+	ReqID          []byte `json:"req_id"`          // the random number request id
+	CommitDeadline int64  `json:"commit_deadline"` // the last height at which the seed hashes can be committed
+	RevealDeadline int64  `json:"reveal_deadline"` // the last height at which the seeds can be revealed
+}
+
+// NewSeedRound constructs a SeedRound
+func NewSeedRound(reqID []byte, commitDeadline, revealDeadline int64) SeedRound {
+	return SeedRound{
+		ReqID:          reqID,
+		CommitDeadline: commitDeadline,
+		RevealDeadline: revealDeadline,
+	}
+}
+
+// SeedCommitment represents a seed committed by a validator
+type SeedCommitment struct {
+	Validator    sdk.ValAddress `json:"validator"`     // the operator address of the validator
+	SeedHash     []byte         `json:"seed_hash"`     // the committed seed hash
+	CommitHeight int64          `json:"commit_height"` // the height at which the seed hash is committed
+	Seed         []byte         `json:"seed"`          // the revealed seed, empty if not revealed
+	RevealHeight int64          `json:"reveal_height"` // the height at which the seed is revealed
+}
+
+// NewSeedCommitment constructs a SeedCommitment
+func NewSeedCommitment(validator sdk.ValAddress, seedHash []byte, commitHeight int64) SeedCommitment {
+	return SeedCommitment{
+		Validator:    validator,
+		SeedHash:     seedHash,
+		CommitHeight: commitHeight,
+	}
+}
+
+// Revealed returns true if the seed has been revealed
+func (sc SeedCommitment) Revealed() bool {
+	return len(sc.Seed) > 0
+}
+
+// String implements fmt.Stringer
+func (sc SeedCommitment) String() string {
+	return fmt.Sprintf(`SeedCommitment:
+  Validator:         %s
+  SeedHash:          %s
+  CommitHeight:      %d
+  Seed:              %s
+  RevealHeight:      %d`,
+		sc.Validator.String(), hex.EncodeToString(sc.SeedHash), sc.CommitHeight, hex.EncodeToString(sc.Seed), sc.RevealHeight)
+}
+
+// Participation represents the participation record of the validators in a commit-reveal round
+type Participation struct {
+	Round       SeedRound        `json:"round"`
+	Commitments []SeedCommitment `json:"commitments"`
+}
+
+// String implements fmt.Stringer
+func (p Participation) String() string {
+	str := fmt.Sprintf(`Participation:
+  ReqID:             %s
+  CommitDeadline:    %d
+  RevealDeadline:    %d
+  Commitments:`,
+		hex.EncodeToString(p.Round.ReqID), p.Round.CommitDeadline, p.Round.RevealDeadline)
+
+	for _, c := range p.Commitments {
+		str += fmt.Sprintf("\n    Validator: %s, CommitHeight: %d, Revealed: %t", c.Validator.String(), c.CommitHeight, c.Revealed())
+	}
+
+	return str
+}
+
+// GenerateSeedHash generates the seed hash committed by the validator.
+// The hash is bound to the validator so that a commitment can not be copied by the others
+func GenerateSeedHash(seed []byte, validator sdk.ValAddress) []byte {
+	bz := make([]byte, 0)
+
+	bz = append(bz, seed...)
+	bz = append(bz, validator...)
+
+	return sdk.SHA256(bz)
+}
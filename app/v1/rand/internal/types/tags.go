@@ -6,10 +6,14 @@ import (
 )
 
 var (
-	ActionRequestRand = []byte("request_rand")
+	ActionRequestRand    = []byte("request_rand")
+	ActionCommitRandSeed = []byte("commit_rand_seed")
+	ActionRevealRandSeed = []byte("reveal_rand_seed")
 
 	TagAction     = sdk.TagAction
 	TagReqID      = "request-id"
 	TagRandHeight = "rand-height"
 	TagRand       = "rand"
+	TagSource     = "source"
+	TagValidator  = "validator"
 )
@@ -13,6 +13,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/irisnet/irishub/app/v1/mock"
+	"github.com/irisnet/irishub/app/v1/slashing"
 	"github.com/irisnet/irishub/app/v1/stake"
 	sdk "github.com/irisnet/irishub/types"
 )
@@ -25,6 +26,7 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	RegisterCodec(mapp.Cdc)
 
 	keyRand := sdk.NewKVStoreKey("rand")
+	keySlashing := sdk.NewKVStoreKey("slashing")
 
 	sk := stake.NewKeeper(
 		mapp.Cdc,
@@ -32,7 +34,13 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 		mapp.BankKeeper, mapp.ParamsKeeper.Subspace(stake.DefaultParamspace),
 		stake.DefaultCodespace,
 		stake.NopMetrics())
-	rk := NewKeeper(mapp.Cdc, keyRand, DefaultCodespace)
+	slk := slashing.NewKeeper(
+		mapp.Cdc,
+		keySlashing,
+		sk, mapp.ParamsKeeper.Subspace(slashing.DefaultParamspace),
+		slashing.DefaultCodespace,
+		slashing.NopMetrics())
+	rk := NewKeeper(mapp.Cdc, keyRand, mapp.BankKeeper, sk, slk, DefaultCodespace, mapp.ParamsKeeper.Subspace(DefaultParamSpace))
 
 	mapp.Router().AddRoute("rand", []*sdk.KVStoreKey{keyRand}, NewHandler(rk))
 
@@ -40,7 +48,7 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	mapp.SetEndBlocker(getEndBlocker())
 	mapp.SetInitChainer(getInitChainer(mapp, rk, sk))
 
-	require.NoError(t, mapp.CompleteSetup(keyRand))
+	require.NoError(t, mapp.CompleteSetup(keyRand, keySlashing))
 
 	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin(fmt.Sprintf("%d%s", 1042, sdk.Iris))
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{coin})
@@ -80,7 +88,7 @@ func getInitChainer(mapp *mock.App, randKeeper Keeper, stakeKeeper stake.Keeper)
 			panic(err)
 		}
 
-		InitGenesis(ctx, randKeeper, DefaultGenesisState())
+		InitGenesis(ctx, randKeeper, DefaultGenesisStateForTest())
 		return abci.ResponseInitChain{
 			Validators: validators,
 		}
@@ -9,41 +9,78 @@ import (
 func EndBlocker(ctx types.Context, keeper Keeper) (resTags types.Tags) {
 	ctx = ctx.WithLogger(ctx.Logger().With("handler", "endBlock").With("module", "iris/service"))
 	logger := ctx.Logger()
-	// Reset the intra-transaction counter.
-	keeper.SetIntraTxCounter(ctx, 0)
 
 	resTags = types.NewTags()
 	params := keeper.GetParamSet(ctx)
 	slashFraction := params.SlashFraction
 
+	// count the requests responded in this block into the reputations of the providers
+	var respondedRequests []SvcRequest
+	recordIterator := keeper.ResponseRecordIterator(ctx)
+	for ; recordIterator.Valid(); recordIterator.Next() {
+		var req SvcRequest
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(recordIterator.Value(), &req)
+		respondedRequests = append(respondedRequests, req)
+	}
+	recordIterator.Close()
+
+	for _, req := range respondedRequests {
+		keeper.OnRequestResponded(ctx, req)
+		keeper.DeleteResponseRecord(ctx, req)
+	}
+
+	// finalize the multicast requests which reached the quorum
+	var finalizedRequests []SvcRequest
+	finalizationIterator := keeper.MulticastFinalizationIterator(ctx)
+	for ; finalizationIterator.Valid(); finalizationIterator.Next() {
+		var req SvcRequest
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(finalizationIterator.Value(), &req)
+		finalizedRequests = append(finalizedRequests, req)
+	}
+	finalizationIterator.Close()
+
+	for _, req := range finalizedRequests {
+		keeper.CloseMulticastRequest(ctx, req)
+		keeper.DeleteMulticastFinalization(ctx, req)
+
+		resTags = resTags.AppendTag(tags.Action, tags.ActionSvcMulticastFinalized)
+		resTags = resTags.AppendTag(tags.RequestID, []byte(req.RequestID()))
+		logger.Info("Finalize multicast request", "request_id", req.RequestID(), "consumer", req.Consumer.String())
+	}
+
+	// timeout requests issued by request contexts
+	var batchRequests []SvcRequest
+
 	activeIterator := keeper.ActiveRequestQueueIterator(ctx, ctx.BlockHeight())
-	defer activeIterator.Close()
 	for ; activeIterator.Valid(); activeIterator.Next() {
 		var req SvcRequest
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &req)
 
-		// if not Profiling mode,should slash provider
-		slashCoins := types.Coins{}
-		if !req.Profiling {
-			binding, found := keeper.GetServiceBinding(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
-			if found {
-				for _, coin := range binding.Deposit {
-					taxAmount := types.NewDecFromInt(coin.Amount).Mul(slashFraction).TruncateInt()
-					slashCoins = append(slashCoins, types.NewCoin(coin.Denom, taxAmount))
+		// the providers of a multicast request which have not responded are slashed
+		if req.MessagingType() == Multicast {
+			nonResponders := keeper.CloseMulticastRequest(ctx, req)
+			for _, provider := range nonResponders {
+				slashCoins := types.Coins{}
+				if !req.Profiling {
+					slashCoins = slashProvider(ctx, keeper, req, provider, slashFraction)
 				}
+				keeper.OnRequestTimeout(ctx, req, provider)
+				resTags = resTags.AppendTag(tags.Provider, []byte(provider))
+				resTags = resTags.AppendTag(tags.SlashCoins, []byte(slashCoins.String()))
 			}
 
-			slashCoins = slashCoins.Sort()
+			resTags = resTags.AppendTag(tags.Action, tags.ActionSvcCallTimeOut)
+			resTags = resTags.AppendTag(tags.RequestID, []byte(req.RequestID()))
+			logger.Info("Remove timeout multicast request", "request_id", req.RequestID(), "consumer", req.Consumer.String())
+			continue
+		}
 
-			_, err := keeper.ck.BurnCoins(ctx, auth.ServiceDepositCoinsAccAddr, slashCoins)
-			if err != nil {
-				panic(err)
-			}
-			err = keeper.Slash(ctx, binding, slashCoins)
-			if err != nil {
-				panic(err)
-			}
+		// if not Profiling mode,should slash provider
+		slashCoins := types.Coins{}
+		if !req.Profiling {
+			slashCoins = slashProvider(ctx, keeper, req, req.Provider, slashFraction)
 		}
+		keeper.OnRequestTimeout(ctx, req, req.Provider)
 
 		keeper.AddReturnFee(ctx, req.Consumer, req.ServiceFee)
 
@@ -56,7 +93,57 @@ func EndBlocker(ctx types.Context, keeper Keeper) (resTags types.Tags) {
 		resTags = resTags.AppendTag(tags.Provider, []byte(req.Provider))
 		resTags = resTags.AppendTag(tags.SlashCoins, []byte(slashCoins.String()))
 		logger.Info("Remove timeout request", "request_id", req.RequestID(), "consumer", req.Consumer.String())
+
+		if req.RequestContextID != 0 {
+			batchRequests = append(batchRequests, req)
+		}
+	}
+	activeIterator.Close()
+
+	// retry the timeout batches of request contexts
+	for _, req := range batchRequests {
+		resTags = resTags.AppendTags(keeper.OnBatchTimeout(ctx, req))
+	}
+
+	// issue the batches of request contexts scheduled at this block
+	var requestContextIDs []uint64
+	scheduleIterator := keeper.RequestContextScheduleIterator(ctx, ctx.BlockHeight())
+	for ; scheduleIterator.Valid(); scheduleIterator.Next() {
+		var requestContextID uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(scheduleIterator.Value(), &requestContextID)
+		requestContextIDs = append(requestContextIDs, requestContextID)
+	}
+	scheduleIterator.Close()
+
+	for _, requestContextID := range requestContextIDs {
+		resTags = resTags.AppendTags(keeper.IssueBatch(ctx, requestContextID))
 	}
 
+	// Reset the intra-transaction counter.
+	keeper.SetIntraTxCounter(ctx, 0)
+
 	return resTags
-}
\ No newline at end of file
+}
+// slash the deposit of the provider which failed to respond the request
+func slashProvider(ctx types.Context, keeper Keeper, req SvcRequest, provider types.AccAddress, slashFraction types.Dec) types.Coins {
+	slashCoins := types.Coins{}
+	binding, found := keeper.GetServiceBinding(ctx, req.DefChainID, req.DefName, req.BindChainID, provider)
+	if found {
+		for _, coin := range binding.Deposit {
+			taxAmount := types.NewDecFromInt(coin.Amount).Mul(slashFraction).TruncateInt()
+			slashCoins = append(slashCoins, types.NewCoin(coin.Denom, taxAmount))
+		}
+	}
+
+	slashCoins = slashCoins.Sort()
+
+	_, err := keeper.ck.BurnCoins(ctx, auth.ServiceDepositCoinsAccAddr, slashCoins)
+	if err != nil {
+		panic(err)
+	}
+	err = keeper.Slash(ctx, binding, slashCoins)
+	if err != nil {
+		panic(err)
+	}
+	return slashCoins
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// The outputs of the methods with PubKeyEncryption privacy are encrypted by ECIES with the
+// secp256k1 pubkey of the consumer. The ciphertext envelope is laid out as:
+// IV (16) || curve (2) || X length (2) || X (32) || Y length (2) || Y (32) || ciphertext (n*16) || HMAC-SHA256 (32)
+const (
+	ephemeralPubKeyLen = 70
+	minCiphertextLen   = aes.BlockSize + ephemeralPubKeyLen + aes.BlockSize + sha256.Size
+)
+
+var (
+	ciphertextCurve       = []byte{0x02, 0xCA} // secp256k1
+	ciphertextCoordLength = []byte{0x00, 0x20}
+)
+
+// validateEncryptionPubKey checks if the pubkey is a valid compressed secp256k1 pubkey
+func validateEncryptionPubKey(pubKey []byte) error {
+	if len(pubKey) != secp256k1.PubKeySecp256k1Size {
+		return errors.New("the encryption pubkey must be a compressed secp256k1 pubkey")
+	}
+	if _, err := btcec.ParsePubKey(pubKey, btcec.S256()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateCiphertext checks if the output is a well-formed ciphertext envelope
+func ValidateCiphertext(output []byte) error {
+	if len(output) < minCiphertextLen {
+		return errors.New("the ciphertext is too short")
+	}
+	if (len(output)-aes.BlockSize-ephemeralPubKeyLen-sha256.Size)%aes.BlockSize != 0 {
+		return errors.New("the ciphertext is not a multiple of the block size")
+	}
+
+	offset := aes.BlockSize
+	if !bytes.Equal(output[offset:offset+2], ciphertextCurve) {
+		return errors.New("the curve of the ciphertext is not secp256k1")
+	}
+	offset += 2
+	if !bytes.Equal(output[offset:offset+2], ciphertextCoordLength) ||
+		!bytes.Equal(output[offset+34:offset+36], ciphertextCoordLength) {
+		return errors.New("invalid ephemeral pubkey of the ciphertext")
+	}
+
+	// the ephemeral pubkey must be on the curve
+	ephemeral := make([]byte, 0, 65)
+	ephemeral = append(ephemeral, 0x04)
+	ephemeral = append(ephemeral, output[offset+2:offset+34]...)
+	ephemeral = append(ephemeral, output[offset+36:offset+68]...)
+	if _, err := btcec.ParsePubKey(ephemeral, btcec.S256()); err != nil {
+		return errors.New("invalid ephemeral pubkey of the ciphertext")
+	}
+	return nil
+}
+
+// EncryptOutput encrypts the output of a service invocation with the encryption pubkey of the consumer
+func EncryptOutput(pubKey []byte, output []byte) ([]byte, error) {
+	if err := validateEncryptionPubKey(pubKey); err != nil {
+		return nil, err
+	}
+	key, err := btcec.ParsePubKey(pubKey, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return btcec.Encrypt(key, output)
+}
+
+// DecryptOutput decrypts the encrypted output of a service invocation with the private key of the consumer
+func DecryptOutput(privKey crypto.PrivKey, ciphertext []byte) ([]byte, error) {
+	secpPrivKey, ok := privKey.(secp256k1.PrivKeySecp256k1)
+	if !ok {
+		return nil, errors.New("only secp256k1 keys can decrypt the output")
+	}
+	key, _ := btcec.PrivKeyFromBytes(btcec.S256(), secpPrivKey[:])
+	return btcec.Decrypt(key, ciphertext)
+}
+
+// GetEncryptionPubKey returns the encryption pubkey of the given account pubkey
+func GetEncryptionPubKey(pubKey crypto.PubKey) ([]byte, error) {
+	secpPubKey, ok := pubKey.(secp256k1.PubKeySecp256k1)
+	if !ok {
+		return nil, errors.New("only secp256k1 keys can be used to encrypt the output")
+	}
+	return secpPubKey[:], nil
+}
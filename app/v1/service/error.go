@@ -43,6 +43,15 @@ const (
 
 	CodeIntOverflow  sdk.CodeType = 130
 	CodeInvalidInput sdk.CodeType = 131
+
+	CodeRequestContextNotExists    sdk.CodeType = 132
+	CodeInvalidRequestContext      sdk.CodeType = 133
+	CodeInvalidRequestContextState sdk.CodeType = 134
+	CodeNotMatchingConsumer        sdk.CodeType = 135
+	CodeInvalidMulticast           sdk.CodeType = 136
+	CodeDuplicateResponse          sdk.CodeType = 137
+	CodeInvalidEncryptionPubKey    sdk.CodeType = 138
+	CodeInvalidCiphertext          sdk.CodeType = 139
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -197,3 +206,35 @@ func ErrNotProfiler(codespace sdk.CodespaceType, profiler sdk.AccAddress) sdk.Er
 func ErrNoResponseFound(codespace sdk.CodespaceType, requestID string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("response is not existed for request %s", requestID))
 }
+
+func ErrRequestContextNotExists(codespace sdk.CodespaceType, requestContextID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeRequestContextNotExists, fmt.Sprintf("request context [%d] is not existed", requestContextID))
+}
+
+func ErrInvalidRequestContext(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRequestContext, fmt.Sprintf("invalid request context, %s", msg))
+}
+
+func ErrInvalidRequestContextState(codespace sdk.CodespaceType, requestContextID uint64, state RequestContextState) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRequestContextState, fmt.Sprintf("request context [%d] is %s", requestContextID, state))
+}
+
+func ErrNotMatchingConsumer(codespace sdk.CodespaceType, consumer sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotMatchingConsumer, fmt.Sprintf("[%s] is not a matching Consumer", consumer.String()))
+}
+
+func ErrInvalidMulticast(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMulticast, fmt.Sprintf("invalid multicast request, %s", msg))
+}
+
+func ErrDuplicateResponse(codespace sdk.CodespaceType, requestID string, provider sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateResponse, fmt.Sprintf("[%s] has responded the request [%s]", provider.String(), requestID))
+}
+
+func ErrInvalidEncryptionPubKey(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEncryptionPubKey, fmt.Sprintf("invalid encryption pubkey, %s", msg))
+}
+
+func ErrInvalidCiphertext(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCiphertext, fmt.Sprintf("invalid encrypted output, %s", msg))
+}
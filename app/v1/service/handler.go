@@ -1,6 +1,8 @@
 package service
 
 import (
+	"fmt"
+
 	"github.com/irisnet/irishub/app/v1/auth"
 	"github.com/irisnet/irishub/app/v1/service/tags"
 	sdk "github.com/irisnet/irishub/types"
@@ -32,6 +34,14 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSvcWithdrawFees(ctx, k, msg)
 		case MsgSvcWithdrawTax:
 			return handleMsgSvcWithdrawTax(ctx, k, msg)
+		case MsgStartRequestContext:
+			return handleMsgStartRequestContext(ctx, k, msg)
+		case MsgPauseRequestContext:
+			return handleMsgPauseRequestContext(ctx, k, msg)
+		case MsgResumeRequestContext:
+			return handleMsgResumeRequestContext(ctx, k, msg)
+		case MsgKillRequestContext:
+			return handleMsgKillRequestContext(ctx, k, msg)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in service module").Result()
 		}
@@ -106,6 +116,10 @@ func handleMsgSvcRefundDeposit(ctx sdk.Context, k Keeper, msg MsgSvcRefundDeposi
 }
 
 func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Result {
+	if len(msg.Providers) > 0 {
+		return handleMsgSvcMulticastRequest(ctx, k, msg)
+	}
+
 	bind, bindingFound := k.GetServiceBinding(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Provider)
 	if !bindingFound {
 		return ErrSvcBindingNotExists(k.Codespace()).Result()
@@ -114,10 +128,13 @@ func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Resul
 		return ErrSvcBindingNotAvailable(k.Codespace()).Result()
 	}
 
-	_, methodFound := k.GetMethod(ctx, msg.DefChainID, msg.DefName, msg.MethodID)
+	method, methodFound := k.GetMethod(ctx, msg.DefChainID, msg.DefName, msg.MethodID)
 	if !methodFound {
 		return ErrMethodNotExists(k.Codespace(), msg.MethodID).Result()
 	}
+	if err := validateOutputPrivacy(k.Codespace(), method, msg.EncryptionPubKey); err != nil {
+		return err.Result()
+	}
 
 	if msg.Profiling {
 		if _, found := k.gk.GetProfiler(ctx, msg.Consumer); !found {
@@ -131,6 +148,7 @@ func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Resul
 	}
 
 	request := NewSvcRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.Provider, msg.MethodID, msg.Input, msg.ServiceFee, msg.Profiling)
+	request.EncryptionPubKey = msg.EncryptionPubKey
 
 	// request service fee is equal to service binding service fee if not profiling
 	if len(bind.Prices) >= int(msg.MethodID) && !msg.Profiling {
@@ -159,6 +177,70 @@ func handleMsgSvcRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Resul
 	}
 }
 
+func handleMsgSvcMulticastRequest(ctx sdk.Context, k Keeper, msg MsgSvcRequest) sdk.Result {
+	method, methodFound := k.GetMethod(ctx, msg.DefChainID, msg.DefName, msg.MethodID)
+	if !methodFound {
+		return ErrMethodNotExists(k.Codespace(), msg.MethodID).Result()
+	}
+	if err := validateOutputPrivacy(k.Codespace(), method, msg.EncryptionPubKey); err != nil {
+		return err.Result()
+	}
+
+	if msg.Profiling {
+		if _, found := k.gk.GetProfiler(ctx, msg.Consumer); !found {
+			return ErrNotProfiler(k.Codespace(), msg.Consumer).Result()
+		}
+	}
+
+	// the service fee is the maximum fee paid to each provider
+	fees := make([]sdk.Coins, len(msg.Providers))
+	for i, provider := range msg.Providers {
+		bind, bindingFound := k.GetServiceBinding(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, provider)
+		if !bindingFound {
+			return ErrSvcBindingNotExists(k.Codespace()).Result()
+		}
+		if !bind.Available {
+			return ErrSvcBindingNotAvailable(k.Codespace()).Result()
+		}
+
+		//Method id start at 1
+		if len(bind.Prices) >= int(msg.MethodID) {
+			price := sdk.Coins{bind.Prices[msg.MethodID-1]}
+			if !msg.ServiceFee.IsAllGTE(price) {
+				return ErrLtServiceFee(k.Codespace(), price).Result()
+			}
+			if !msg.Profiling {
+				fees[i] = price
+			}
+		}
+	}
+
+	request := NewSvcMulticastRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer,
+		msg.Providers, msg.Quorum, msg.MethodID, msg.Input, msg.ServiceFee, msg.Profiling)
+	request.EncryptionPubKey = msg.EncryptionPubKey
+
+	request, err := k.AddMulticastRequest(ctx, request, fees)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.Logger().Debug("Service multicast request", "def_name", msg.DefName, "def_chain_id", msg.DefChainID,
+		"providers", len(msg.Providers), "quorum", msg.Quorum, "consumer", request.Consumer.String(),
+		"method_id", msg.MethodID, "service_fee", request.ServiceFee, "request_id", request.RequestID())
+
+	resTags := sdk.NewTags(
+		tags.RequestID, []byte(request.RequestID()),
+		tags.Consumer, []byte(request.Consumer.String()),
+		tags.ServiceFee, []byte(request.ServiceFee.String()),
+	)
+	for _, provider := range request.Providers {
+		resTags = resTags.AppendTag(tags.Provider, []byte(provider.String()))
+	}
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
 func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Result {
 	eHeight, rHeight, counter, _ := ConvertRequestID(msg.RequestID)
 	request, found := k.GetActiveRequest(ctx, eHeight, rHeight, counter)
@@ -168,6 +250,18 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 		request.RequestIntraTxCounter = counter
 		return ErrRequestNotActive(k.Codespace(), request.RequestID()).Result()
 	}
+
+	// the outputs of the methods with PubKeyEncryption privacy must be encrypted
+	method, _ := k.GetMethod(ctx, request.DefChainID, request.DefName, request.MethodID)
+	if method.OutputPrivacy == PubKeyEncryption && len(msg.Output) > 0 {
+		if err := ValidateCiphertext(msg.Output); err != nil {
+			return ErrInvalidCiphertext(k.Codespace(), err.Error()).Result()
+		}
+	}
+
+	if request.MessagingType() == Multicast {
+		return handleMsgSvcMulticastResponse(ctx, k, msg, request)
+	}
 	if !(msg.Provider.Equals(request.Provider)) {
 		return ErrNotMatchingProvider(k.Codespace(), request.Provider).Result()
 	}
@@ -183,6 +277,7 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 	// delete request from active request list and expiration list
 	k.DeleteActiveRequest(ctx, request)
 	k.DeleteRequestExpiration(ctx, request)
+	k.AddResponseRecord(ctx, request)
 
 	err := k.AddIncomingFee(ctx, response.Provider, request.ServiceFee)
 	if err != nil {
@@ -191,6 +286,55 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 	ctx.Logger().Debug("Service response", "def_name", "request_id", request.RequestID(),
 		"consumer", response.Consumer.String())
 
+	resTags := sdk.NewTags(
+		tags.RequestID, []byte(request.RequestID()),
+		tags.Consumer, []byte(response.Consumer.String()),
+		tags.Provider, []byte(response.Provider.String()),
+	)
+
+	// notify the request context that the batch is responded
+	if request.RequestContextID != 0 {
+		k.OnBatchResponded(ctx, request)
+		resTags = resTags.AppendTag(tags.RequestContextID, []byte(fmt.Sprintf("%d", request.RequestContextID)))
+		resTags = resTags.AppendTag(tags.BatchCounter, []byte(fmt.Sprintf("%d", request.BatchCounter)))
+	}
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgSvcMulticastResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse, request SvcRequest) sdk.Result {
+	if request.ReqChainID != msg.ReqChainID {
+		return ErrNotMatchingReqChainID(k.Codespace(), msg.ReqChainID).Result()
+	}
+	if k.HasMulticastResponse(ctx, request, msg.Provider) {
+		return ErrDuplicateResponse(k.Codespace(), request.RequestID(), msg.Provider).Result()
+	}
+	providerReq, found := k.GetActiveProviderRequest(ctx, request, msg.Provider)
+	if !found {
+		return ErrNotMatchingProvider(k.Codespace(), msg.Provider).Result()
+	}
+
+	response := NewSvcResponse(msg.ReqChainID, request.ExpirationHeight, request.RequestHeight,
+		request.RequestIntraTxCounter, msg.Provider, request.Consumer, msg.Output, msg.ErrorMsg)
+	k.AddMulticastResponse(ctx, response)
+
+	// the provider is paid as soon as it responds
+	k.DeleteActiveRequest(ctx, providerReq)
+	k.AddResponseRecord(ctx, providerReq)
+	err := k.AddIncomingFee(ctx, response.Provider, providerReq.ServiceFee)
+	if err != nil {
+		return err.Result()
+	}
+
+	// the request is finalized in the end block once the quorum is reached
+	responded := len(k.GetMulticastResponses(ctx, request.ExpirationHeight, request.RequestHeight, request.RequestIntraTxCounter))
+	if responded == int(request.Quorum) {
+		k.AddMulticastFinalization(ctx, request)
+	}
+	ctx.Logger().Debug("Service multicast response", "request_id", request.RequestID(),
+		"provider", response.Provider.String(), "responded", responded)
+
 	resTags := sdk.NewTags(
 		tags.RequestID, []byte(request.RequestID()),
 		tags.Consumer, []byte(response.Consumer.String()),
@@ -201,6 +345,17 @@ func handleMsgSvcResponse(ctx sdk.Context, k Keeper, msg MsgSvcResponse) sdk.Res
 	}
 }
 
+// the consumer must provide the encryption pubkey only if the outputs of the method are encrypted
+func validateOutputPrivacy(codespace sdk.CodespaceType, method MethodProperty, encryptionPubKey []byte) sdk.Error {
+	if method.OutputPrivacy == PubKeyEncryption && len(encryptionPubKey) == 0 {
+		return ErrInvalidEncryptionPubKey(codespace, fmt.Sprintf("the outputs of the method [%d] are encrypted, the encryption pubkey is required", method.ID))
+	}
+	if method.OutputPrivacy != PubKeyEncryption && len(encryptionPubKey) != 0 {
+		return ErrInvalidEncryptionPubKey(codespace, fmt.Sprintf("the outputs of the method [%d] are not encrypted", method.ID))
+	}
+	return nil
+}
+
 func handleMsgSvcRefundFees(ctx sdk.Context, k Keeper, msg MsgSvcRefundFees) sdk.Result {
 	err := k.RefundFee(ctx, msg.Consumer)
 	if err != nil {
@@ -228,3 +383,82 @@ func handleMsgSvcWithdrawTax(ctx sdk.Context, k Keeper, msg MsgSvcWithdrawTax) s
 	}
 	return sdk.Result{}
 }
+
+func handleMsgStartRequestContext(ctx sdk.Context, k Keeper, msg MsgStartRequestContext) sdk.Result {
+	bind, bindingFound := k.GetServiceBinding(ctx, msg.DefChainID, msg.DefName, msg.BindChainID, msg.Provider)
+	if !bindingFound {
+		return ErrSvcBindingNotExists(k.Codespace()).Result()
+	}
+	if !bind.Available {
+		return ErrSvcBindingNotAvailable(k.Codespace()).Result()
+	}
+
+	method, methodFound := k.GetMethod(ctx, msg.DefChainID, msg.DefName, msg.MethodID)
+	if !methodFound {
+		return ErrMethodNotExists(k.Codespace(), msg.MethodID).Result()
+	}
+	if err := validateOutputPrivacy(k.Codespace(), method, msg.EncryptionPubKey); err != nil {
+		return err.Result()
+	}
+
+	//Method id start at 1
+	if len(bind.Prices) >= int(msg.MethodID) && !msg.ServiceFee.IsAllGTE(sdk.Coins{bind.Prices[msg.MethodID-1]}) {
+		return ErrLtServiceFee(k.Codespace(), sdk.Coins{bind.Prices[msg.MethodID-1]}).Result()
+	}
+
+	params := k.GetParamSet(ctx)
+	if msg.Timeout > params.MaxRequestTimeout {
+		return ErrInvalidRequestContext(k.Codespace(), fmt.Sprintf("timeout [%d] must not be greater than %d", msg.Timeout, params.MaxRequestTimeout)).Result()
+	}
+
+	reqCtx := NewRequestContext(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer, msg.Provider,
+		msg.MethodID, msg.Input, msg.ServiceFee, msg.RepeatedFrequency, msg.RepeatedTotal, msg.Timeout, msg.RetryLimit)
+	reqCtx.EncryptionPubKey = msg.EncryptionPubKey
+	reqCtx = k.AddRequestContext(ctx, reqCtx)
+
+	ctx.Logger().Debug("Start request context", "def_name", msg.DefName, "def_chain_id", msg.DefChainID,
+		"provider", msg.Provider.String(), "consumer", msg.Consumer.String(), "method_id", msg.MethodID,
+		"request_context_id", reqCtx.ID)
+
+	resTags := sdk.NewTags(
+		tags.RequestContextID, []byte(fmt.Sprintf("%d", reqCtx.ID)),
+		tags.Provider, []byte(reqCtx.Provider.String()),
+		tags.Consumer, []byte(reqCtx.Consumer.String()),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgPauseRequestContext(ctx sdk.Context, k Keeper, msg MsgPauseRequestContext) sdk.Result {
+	err := k.PauseRequestContext(ctx, msg.RequestContextID, msg.Consumer)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Info("Pause request context", "request_context_id", msg.RequestContextID, "consumer", msg.Consumer.String())
+	return sdk.Result{
+		Tags: sdk.NewTags(tags.RequestContextID, []byte(fmt.Sprintf("%d", msg.RequestContextID))),
+	}
+}
+
+func handleMsgResumeRequestContext(ctx sdk.Context, k Keeper, msg MsgResumeRequestContext) sdk.Result {
+	err := k.ResumeRequestContext(ctx, msg.RequestContextID, msg.Consumer)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Info("Resume request context", "request_context_id", msg.RequestContextID, "consumer", msg.Consumer.String())
+	return sdk.Result{
+		Tags: sdk.NewTags(tags.RequestContextID, []byte(fmt.Sprintf("%d", msg.RequestContextID))),
+	}
+}
+
+func handleMsgKillRequestContext(ctx sdk.Context, k Keeper, msg MsgKillRequestContext) sdk.Result {
+	err := k.KillRequestContext(ctx, msg.RequestContextID, msg.Consumer)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.Logger().Info("Kill request context", "request_context_id", msg.RequestContextID, "consumer", msg.Consumer.String())
+	return sdk.Result{
+		Tags: sdk.NewTags(tags.RequestContextID, []byte(fmt.Sprintf("%d", msg.RequestContextID))),
+	}
+}
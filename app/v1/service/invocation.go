@@ -9,19 +9,24 @@ import (
 )
 
 type SvcRequest struct {
-	DefChainID            string         `json:"def_chain_id"`
-	DefName               string         `json:"def_name"`
-	BindChainID           string         `json:"bind_chain_id"`
-	ReqChainID            string         `json:"req_chain_id"`
-	MethodID              int16          `json:"method_id"`
-	Provider              sdk.AccAddress `json:"provider"`
-	Consumer              sdk.AccAddress `json:"consumer"`
-	Input                 []byte         `json:"input"`
-	ServiceFee            sdk.Coins      `json:"service_fee"`
-	Profiling             bool           `json:"profiling"`                // profiling model will be free of service charges
-	RequestHeight         int64          `json:"request_height"`           // block height of service request
-	RequestIntraTxCounter int16          `json:"request_intra_tx_counter"` // block-local tx index of service request
-	ExpirationHeight      int64          `json:"expiration_height"`        // block height of the service request has expired
+	DefChainID            string           `json:"def_chain_id"`
+	DefName               string           `json:"def_name"`
+	BindChainID           string           `json:"bind_chain_id"`
+	ReqChainID            string           `json:"req_chain_id"`
+	MethodID              int16            `json:"method_id"`
+	Provider              sdk.AccAddress   `json:"provider"`
+	Consumer              sdk.AccAddress   `json:"consumer"`
+	Input                 []byte           `json:"input"`
+	ServiceFee            sdk.Coins        `json:"service_fee"`
+	Profiling             bool             `json:"profiling"`                // profiling model will be free of service charges
+	RequestHeight         int64            `json:"request_height"`           // block height of service request
+	RequestIntraTxCounter int16            `json:"request_intra_tx_counter"` // block-local tx index of service request
+	ExpirationHeight      int64            `json:"expiration_height"`        // block height of the service request has expired
+	RequestContextID      uint64           `json:"request_context_id"`       // id of the request context which issued the request, 0 if none
+	BatchCounter          uint64           `json:"batch_counter"`            // batch of the request context to which the request belongs
+	Providers             []sdk.AccAddress `json:"providers"`                // all the providers of a multicast request
+	Quorum                uint16           `json:"quorum"`                   // number of responses to finalize a multicast request
+	EncryptionPubKey      []byte           `json:"encryption_pubkey"`        // pubkey of the consumer to encrypt the outputs
 }
 
 func NewSvcRequest(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) SvcRequest {
@@ -39,6 +44,21 @@ func NewSvcRequest(defChainID, defName, bindChainID, reqChainID string, consumer
 	}
 }
 
+func NewSvcMulticastRequest(defChainID, defName, bindChainID, reqChainID string, consumer sdk.AccAddress, providers []sdk.AccAddress, quorum uint16, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) SvcRequest {
+	req := NewSvcRequest(defChainID, defName, bindChainID, reqChainID, consumer, nil, methodID, input, serviceFee, profiling)
+	req.Providers = providers
+	req.Quorum = quorum
+	return req
+}
+
+// a request is multicast if it is sent to a set of providers
+func (req SvcRequest) MessagingType() MessagingType {
+	if len(req.Providers) > 0 {
+		return Multicast
+	}
+	return Unicast
+}
+
 // RequestID is of format request expirationHeight-requestHeight-intraTxCounter
 func (req SvcRequest) RequestID() string {
 	return fmt.Sprintf("%d-%d-%d", req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter)
@@ -185,10 +185,12 @@ func (k Keeper) UpdateServiceBinding(ctx sdk.Context, svcBinding SvcBinding) sdk
 		return err
 	}
 
-	if svcBinding.Level.UsableTime != 0 {
+	// the level is measured by the chain once the binding has a reputation
+	_, measured := k.GetReputation(ctx, svcBinding.DefChainID, svcBinding.DefName, svcBinding.BindChainID, svcBinding.Provider)
+	if svcBinding.Level.UsableTime != 0 && !measured {
 		oldBinding.Level.UsableTime = svcBinding.Level.UsableTime
 	}
-	if svcBinding.Level.AvgRspTime != 0 {
+	if svcBinding.Level.AvgRspTime != 0 && !measured {
 		oldBinding.Level.AvgRspTime = svcBinding.Level.AvgRspTime
 	}
 
@@ -318,6 +320,12 @@ func (k Keeper) validateMethodPrices(ctx sdk.Context, svcBinding SvcBinding) sdk
 //__________________________________________________________________________
 
 func (k Keeper) AddRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Error) {
+	params := k.GetParamSet(ctx)
+	return k.addRequest(ctx, req, params.MaxRequestTimeout)
+}
+
+// add a request which expires after the given number of blocks
+func (k Keeper) addRequest(ctx sdk.Context, req SvcRequest, timeout int64) (SvcRequest, sdk.Error) {
 	store := ctx.KVStore(k.storeKey)
 
 	counter := k.GetIntraTxCounter(ctx)
@@ -325,8 +333,7 @@ func (k Keeper) AddRequest(ctx sdk.Context, req SvcRequest) (SvcRequest, sdk.Err
 	req.RequestIntraTxCounter = counter
 	k.SetIntraTxCounter(ctx, counter+1)
 
-	params := k.GetParamSet(ctx)
-	req.ExpirationHeight = req.RequestHeight + params.MaxRequestTimeout
+	req.ExpirationHeight = req.RequestHeight + timeout
 
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(req)
 
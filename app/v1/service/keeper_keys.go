@@ -23,6 +23,17 @@ var (
 
 	serviceFeeTaxKey        = []byte{0x12}
 	serviceSlashFractionKey = []byte{0x13}
+
+	requestContextKey         = []byte{0x14}
+	requestBatchKey           = []byte{0x15}
+	requestContextScheduleKey = []byte{0x16} // key for the request contexts indexed by next batch height
+	requestContextSequenceKey = []byte{0x17} // key for the next request context id
+
+	multicastResponseKey     = []byte{0x18}
+	multicastFinalizationKey = []byte{0x19} // key for the multicast requests which reach the quorum
+
+	reputationKey     = []byte{0x1A}
+	responseRecordKey = []byte{0x1B} // key for the requests responded in the current block
 )
 
 func GetServiceDefinitionKey(chainId, name string) []byte {
@@ -102,6 +113,65 @@ func GetIncomingFeeKey(address sdk.AccAddress) []byte {
 	return append(incomingFeeKey, address.Bytes()...)
 }
 
+func GetMulticastResponseKey(eHeight, rHeight int64, counter int16, provider sdk.AccAddress) []byte {
+	return append(GetMulticastResponsesSubspaceKey(eHeight, rHeight, counter), provider.Bytes()...)
+}
+
+// Key for getting all responses of a multicast request from the store
+func GetMulticastResponsesSubspaceKey(eHeight, rHeight int64, counter int16) []byte {
+	// key is of format prefix(1) || expirationHeight(8) || requestHeight(8) || counterBytes(2)
+	key := GetRequestsByExpirationIndexKey(eHeight, rHeight, counter)
+	key[0] = multicastResponseKey[0]
+	return key
+}
+
+// get the finalization key of a multicast request
+func GetMulticastFinalizationKey(eHeight, rHeight int64, counter int16) []byte {
+	key := GetRequestsByExpirationIndexKey(eHeight, rHeight, counter)
+	key[0] = multicastFinalizationKey[0]
+	return key
+}
+
+func GetReputationKey(defChainId, name, bindChainId string, provider sdk.AccAddress) []byte {
+	return append(reputationKey, getStringsKey([]string{defChainId, name, bindChainId, provider.String()})...)
+}
+
+// Key for getting the reputations of all bindings of a service from the store
+func GetReputationsSubspaceKey(chainId, serviceName string) []byte {
+	return append(append(reputationKey, getStringsKey([]string{chainId, serviceName})...), emptyByte...)
+}
+
+func GetResponseRecordKey(eHeight, rHeight int64, counter int16, provider sdk.AccAddress) []byte {
+	// key is of format prefix(1) || expirationHeight(8) || requestHeight(8) || counterBytes(2) || provider
+	key := GetRequestsByExpirationIndexKey(eHeight, rHeight, counter)
+	key[0] = responseRecordKey[0]
+	return append(key, provider.Bytes()...)
+}
+
+func GetRequestContextKey(requestContextID uint64) []byte {
+	return append(requestContextKey, sdk.Uint64ToBigEndian(requestContextID)...)
+}
+
+func GetRequestBatchKey(requestContextID, batchCounter uint64) []byte {
+	// key is of format prefix(1) || requestContextID(8) || batchCounter(8)
+	return append(GetRequestBatchesSubspaceKey(requestContextID), sdk.Uint64ToBigEndian(batchCounter)...)
+}
+
+// Key for getting all batches of a request context from the store
+func GetRequestBatchesSubspaceKey(requestContextID uint64) []byte {
+	return append(requestBatchKey, sdk.Uint64ToBigEndian(requestContextID)...)
+}
+
+func GetRequestContextScheduleKey(height int64, requestContextID uint64) []byte {
+	// key is of format prefix(1) || batchHeight(8) || requestContextID(8)
+	return append(GetRequestContextSchedulePrefix(height), sdk.Uint64ToBigEndian(requestContextID)...)
+}
+
+// get the schedule prefix for all request contexts of a block height
+func GetRequestContextSchedulePrefix(height int64) []byte {
+	return append(requestContextScheduleKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 func getStringsKey(ss []string) (result []byte) {
 	for _, s := range ss {
 		result = append(append(
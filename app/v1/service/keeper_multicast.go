@@ -0,0 +1,117 @@
+package service
+
+import (
+	"github.com/irisnet/irishub/app/v1/auth"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Add a multicast request, which is sent to each provider with the given fee
+func (k Keeper) AddMulticastRequest(ctx sdk.Context, req SvcRequest, fees []sdk.Coins) (SvcRequest, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+
+	counter := k.GetIntraTxCounter(ctx)
+	req.RequestHeight = ctx.BlockHeight()
+	req.RequestIntraTxCounter = counter
+	k.SetIntraTxCounter(ctx, counter+1)
+
+	params := k.GetParamSet(ctx)
+	req.ExpirationHeight = req.RequestHeight + params.MaxRequestTimeout
+
+	// the request of each provider is charged separately
+	req.ServiceFee = sdk.Coins{}
+	for i, provider := range req.Providers {
+		providerReq := req
+		providerReq.Provider = provider
+		providerReq.ServiceFee = fees[i]
+
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(providerReq)
+		store.Set(GetRequestKey(req.DefChainID, req.DefName, req.BindChainID, provider,
+			req.RequestHeight, req.RequestIntraTxCounter), bz)
+		k.AddActiveRequest(ctx, providerReq)
+
+		req.ServiceFee = req.ServiceFee.Add(fees[i])
+	}
+
+	_, err := k.ck.SendCoins(ctx, req.Consumer, auth.ServiceRequestCoinsAccAddr, req.ServiceFee)
+	if err != nil {
+		return req, err
+	}
+	k.AddRequestExpiration(ctx, req)
+	k.metrics.ActiveRequests.Add(1)
+	return req, nil
+}
+
+// Get the request of a multicast request sent to the provider if not responded
+func (k Keeper) GetActiveProviderRequest(ctx sdk.Context, req SvcRequest, provider sdk.AccAddress) (providerReq SvcRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetActiveRequestKey(req.DefChainID, req.DefName, req.BindChainID, provider,
+		req.RequestHeight, req.RequestIntraTxCounter))
+	if value == nil {
+		return providerReq, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &providerReq)
+	return providerReq, true
+}
+
+func (k Keeper) AddMulticastResponse(ctx sdk.Context, resp SvcResponse) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(resp)
+	store.Set(GetMulticastResponseKey(resp.ExpirationHeight, resp.RequestHeight, resp.RequestIntraTxCounter, resp.Provider), bz)
+}
+
+func (k Keeper) HasMulticastResponse(ctx sdk.Context, req SvcRequest, provider sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetMulticastResponseKey(req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter, provider))
+}
+
+// Get all the responses of a multicast request
+func (k Keeper) GetMulticastResponses(ctx sdk.Context, eHeight, rHeight int64, counter int16) []SvcResponse {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetMulticastResponsesSubspaceKey(eHeight, rHeight, counter))
+	defer iterator.Close()
+
+	responses := make([]SvcResponse, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var resp SvcResponse
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &resp)
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// Queue a multicast request which reaches the quorum to be finalized in the end block
+func (k Keeper) AddMulticastFinalization(ctx sdk.Context, req SvcRequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(req)
+	store.Set(GetMulticastFinalizationKey(req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter), bz)
+}
+
+func (k Keeper) DeleteMulticastFinalization(ctx sdk.Context, req SvcRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetMulticastFinalizationKey(req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter))
+}
+
+// Returns an iterator for all the multicast requests to be finalized
+func (k Keeper) MulticastFinalizationIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, multicastFinalizationKey)
+}
+
+// Close a multicast request, the fees of the providers which have not responded
+// are returned to the consumer. Returns the providers which have not responded
+func (k Keeper) CloseMulticastRequest(ctx sdk.Context, req SvcRequest) (nonResponders []sdk.AccAddress) {
+	for _, provider := range req.Providers {
+		providerReq, found := k.GetActiveProviderRequest(ctx, req, provider)
+		if !found {
+			continue
+		}
+
+		k.AddReturnFee(ctx, req.Consumer, providerReq.ServiceFee)
+		k.DeleteActiveRequest(ctx, providerReq)
+		nonResponders = append(nonResponders, provider)
+	}
+
+	k.DeleteRequestExpiration(ctx, req)
+	k.metrics.ActiveRequests.Add(-1)
+	return nonResponders
+}
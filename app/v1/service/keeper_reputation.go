@@ -0,0 +1,121 @@
+package service
+
+import (
+	"sort"
+
+	sdk "github.com/irisnet/irishub/types"
+)
+
+func (k Keeper) SetReputation(ctx sdk.Context, reputation Reputation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(reputation)
+	store.Set(GetReputationKey(reputation.DefChainID, reputation.DefName, reputation.BindChainID, reputation.Provider), bz)
+}
+
+func (k Keeper) GetReputation(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) (reputation Reputation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetReputationKey(defChainID, defName, bindChainID, provider))
+	if bz == nil {
+		return reputation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &reputation)
+	return reputation, true
+}
+
+// Record a request responded in the current block, which is counted into the
+// reputation of the binding in the end block
+func (k Keeper) AddResponseRecord(ctx sdk.Context, req SvcRequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(req)
+	store.Set(GetResponseRecordKey(req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter, req.Provider), bz)
+}
+
+func (k Keeper) DeleteResponseRecord(ctx sdk.Context, req SvcRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetResponseRecordKey(req.ExpirationHeight, req.RequestHeight, req.RequestIntraTxCounter, req.Provider))
+}
+
+// Returns an iterator for all the requests responded in the current block
+func (k Keeper) ResponseRecordIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, responseRecordKey)
+}
+
+// Count a request responded at the current block height into the reputation of the provider
+func (k Keeper) OnRequestResponded(ctx sdk.Context, req SvcRequest) {
+	reputation := k.getOrNewReputation(ctx, req.DefChainID, req.DefName, req.BindChainID, req.Provider)
+	reputation.RespondedCount++
+	reputation.TotalResponseBlocks += uint64(ctx.BlockHeight() - req.RequestHeight)
+	k.updateReputation(ctx, reputation)
+}
+
+// Count a request timed out into the reputation of the provider
+func (k Keeper) OnRequestTimeout(ctx sdk.Context, req SvcRequest, provider sdk.AccAddress) {
+	reputation := k.getOrNewReputation(ctx, req.DefChainID, req.DefName, req.BindChainID, provider)
+	reputation.TimeoutCount++
+	k.updateReputation(ctx, reputation)
+}
+
+func (k Keeper) getOrNewReputation(ctx sdk.Context, defChainID, defName, bindChainID string, provider sdk.AccAddress) Reputation {
+	reputation, found := k.GetReputation(ctx, defChainID, defName, bindChainID, provider)
+	if !found {
+		reputation = NewReputation(defChainID, defName, bindChainID, provider)
+	}
+	return reputation
+}
+
+// save the reputation and update the level of the binding by the measured metrics
+func (k Keeper) updateReputation(ctx sdk.Context, reputation Reputation) {
+	reputation = reputation.recalculate()
+	k.SetReputation(ctx, reputation)
+
+	binding, found := k.GetServiceBinding(ctx, reputation.DefChainID, reputation.DefName, reputation.BindChainID, reputation.Provider)
+	if !found {
+		return
+	}
+	binding.Level = reputation.measuredLevel(binding.Level)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(binding)
+	store.Set(GetServiceBindingKey(binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider), bz)
+}
+
+// Get the available providers of a service method ranked by their reputations
+func (k Keeper) GetBestProviders(ctx sdk.Context, defChainID, defName string, methodID int16, limit int) []ProviderRank {
+	iterator := k.ServiceBindingsIterator(ctx, defChainID, defName)
+	defer iterator.Close()
+
+	ranks := make([]ProviderRank, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var binding SvcBinding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &binding)
+
+		//Method id start at 1
+		if !binding.Available || len(binding.Prices) < int(methodID) {
+			continue
+		}
+
+		ranks = append(ranks, ProviderRank{
+			Provider:   binding.Provider,
+			Price:      binding.Prices[methodID-1],
+			Level:      binding.Level,
+			Reputation: k.getOrNewReputation(ctx, binding.DefChainID, binding.DefName, binding.BindChainID, binding.Provider),
+		})
+	}
+
+	// the cheaper provider ranks first if the reputations are equal
+	sort.SliceStable(ranks, func(i, j int) bool {
+		if ranks[i].Reputation.betterThan(ranks[j].Reputation) {
+			return true
+		}
+		if ranks[j].Reputation.betterThan(ranks[i].Reputation) {
+			return false
+		}
+		return ranks[i].Price.IsLT(ranks[j].Price)
+	})
+
+	if limit > 0 && len(ranks) > limit {
+		ranks = ranks[:limit]
+	}
+	return ranks
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/irisnet/irishub/app/v1/service/tags"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// Add a request context and schedule its first batch at the current block
+func (k Keeper) AddRequestContext(ctx sdk.Context, reqCtx RequestContext) RequestContext {
+	reqCtx.ID = k.getNextRequestContextID(ctx)
+	reqCtx.BatchCounter = 0
+	reqCtx.BatchRespondedCount = 0
+	reqCtx.BatchTimeoutCount = 0
+	reqCtx.State = ContextRunning
+	reqCtx.NextBatchHeight = ctx.BlockHeight()
+
+	k.SetRequestContext(ctx, reqCtx)
+	k.scheduleRequestContext(ctx, reqCtx)
+	return reqCtx
+}
+
+func (k Keeper) SetRequestContext(ctx sdk.Context, reqCtx RequestContext) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(reqCtx)
+	store.Set(GetRequestContextKey(reqCtx.ID), bz)
+}
+
+func (k Keeper) GetRequestContext(ctx sdk.Context, requestContextID uint64) (reqCtx RequestContext, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetRequestContextKey(requestContextID))
+	if value == nil {
+		return reqCtx, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &reqCtx)
+	return reqCtx, true
+}
+
+// Pause a running request context, no more batches will be issued until it is resumed
+func (k Keeper) PauseRequestContext(ctx sdk.Context, requestContextID uint64, consumer sdk.AccAddress) sdk.Error {
+	reqCtx, err := k.getOwnedRequestContext(ctx, requestContextID, consumer)
+	if err != nil {
+		return err
+	}
+
+	if reqCtx.State != ContextRunning {
+		return ErrInvalidRequestContextState(k.Codespace(), requestContextID, reqCtx.State)
+	}
+
+	k.unscheduleRequestContext(ctx, reqCtx)
+	reqCtx.State = ContextPaused
+	k.SetRequestContext(ctx, reqCtx)
+	return nil
+}
+
+// Resume a paused request context, the next batch is issued no earlier than the current block
+func (k Keeper) ResumeRequestContext(ctx sdk.Context, requestContextID uint64, consumer sdk.AccAddress) sdk.Error {
+	reqCtx, err := k.getOwnedRequestContext(ctx, requestContextID, consumer)
+	if err != nil {
+		return err
+	}
+
+	if reqCtx.State != ContextPaused {
+		return ErrInvalidRequestContextState(k.Codespace(), requestContextID, reqCtx.State)
+	}
+
+	if reqCtx.NextBatchHeight < ctx.BlockHeight() {
+		reqCtx.NextBatchHeight = ctx.BlockHeight()
+	}
+	reqCtx.State = ContextRunning
+	k.SetRequestContext(ctx, reqCtx)
+	k.scheduleRequestContext(ctx, reqCtx)
+	return nil
+}
+
+// Kill a running or paused request context, the timeout batches will not be retried any more
+func (k Keeper) KillRequestContext(ctx sdk.Context, requestContextID uint64, consumer sdk.AccAddress) sdk.Error {
+	reqCtx, err := k.getOwnedRequestContext(ctx, requestContextID, consumer)
+	if err != nil {
+		return err
+	}
+
+	if reqCtx.State != ContextRunning && reqCtx.State != ContextPaused {
+		return ErrInvalidRequestContextState(k.Codespace(), requestContextID, reqCtx.State)
+	}
+
+	if reqCtx.State == ContextRunning {
+		k.unscheduleRequestContext(ctx, reqCtx)
+	}
+	reqCtx.State = ContextKilled
+	k.SetRequestContext(ctx, reqCtx)
+	return nil
+}
+
+func (k Keeper) getOwnedRequestContext(ctx sdk.Context, requestContextID uint64, consumer sdk.AccAddress) (RequestContext, sdk.Error) {
+	reqCtx, found := k.GetRequestContext(ctx, requestContextID)
+	if !found {
+		return reqCtx, ErrRequestContextNotExists(k.Codespace(), requestContextID)
+	}
+	if !consumer.Equals(reqCtx.Consumer) {
+		return reqCtx, ErrNotMatchingConsumer(k.Codespace(), consumer)
+	}
+	return reqCtx, nil
+}
+
+func (k Keeper) scheduleRequestContext(ctx sdk.Context, reqCtx RequestContext) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(reqCtx.ID)
+	store.Set(GetRequestContextScheduleKey(reqCtx.NextBatchHeight, reqCtx.ID), bz)
+}
+
+func (k Keeper) unscheduleRequestContext(ctx sdk.Context, reqCtx RequestContext) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetRequestContextScheduleKey(reqCtx.NextBatchHeight, reqCtx.ID))
+}
+
+// Returns an iterator for all the request contexts of which the next batch is scheduled at the block height
+func (k Keeper) RequestContextScheduleIterator(ctx sdk.Context, height int64) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetRequestContextSchedulePrefix(height))
+}
+
+func (k Keeper) getNextRequestContextID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var requestContextID uint64 = 1
+	if bz := store.Get(requestContextSequenceKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &requestContextID)
+	}
+	store.Set(requestContextSequenceKey, k.cdc.MustMarshalBinaryLengthPrefixed(requestContextID+1))
+	return requestContextID
+}
+
+//__________________________________________________________________________
+
+func (k Keeper) SetRequestBatch(ctx sdk.Context, batch RequestBatch) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(batch)
+	store.Set(GetRequestBatchKey(batch.RequestContextID, batch.BatchCounter), bz)
+}
+
+func (k Keeper) GetRequestBatch(ctx sdk.Context, requestContextID, batchCounter uint64) (batch RequestBatch, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(GetRequestBatchKey(requestContextID, batchCounter))
+	if value == nil {
+		return batch, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &batch)
+	return batch, true
+}
+
+// Returns an iterator for all the batches of a request context
+func (k Keeper) RequestBatchesIterator(ctx sdk.Context, requestContextID uint64) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, GetRequestBatchesSubspaceKey(requestContextID))
+}
+
+// Issue the next batch of a scheduled request context, the context is paused
+// if the batch can not be issued
+func (k Keeper) IssueBatch(ctx sdk.Context, requestContextID uint64) (resTags sdk.Tags) {
+	resTags = sdk.NewTags()
+	reqCtx, found := k.GetRequestContext(ctx, requestContextID)
+	if !found || reqCtx.State != ContextRunning {
+		return resTags
+	}
+
+	k.unscheduleRequestContext(ctx, reqCtx)
+
+	batch := NewRequestBatch(reqCtx.ID, reqCtx.BatchCounter+1)
+	request, err := k.issueBatchRequest(ctx, reqCtx, batch)
+	if err != nil {
+		reqCtx.State = ContextPaused
+		k.SetRequestContext(ctx, reqCtx)
+
+		ctx.Logger().Info("Pause request context", "request_context_id", reqCtx.ID, "reason", err.Error())
+		resTags = resTags.AppendTag(tags.Action, tags.ActionRequestContextPaused)
+		resTags = resTags.AppendTag(tags.RequestContextID, []byte(fmt.Sprintf("%d", reqCtx.ID)))
+		return resTags
+	}
+
+	batch.RequestID = request.RequestID()
+	batch.RequestHeight = request.RequestHeight
+	k.SetRequestBatch(ctx, batch)
+
+	reqCtx.BatchCounter = batch.BatchCounter
+	if reqCtx.isLastBatch() {
+		reqCtx.State = ContextCompleted
+	} else {
+		reqCtx.NextBatchHeight = ctx.BlockHeight() + int64(reqCtx.RepeatedFrequency)
+		k.scheduleRequestContext(ctx, reqCtx)
+	}
+	k.SetRequestContext(ctx, reqCtx)
+
+	resTags = resTags.AppendTag(tags.Action, tags.ActionSvcBatchRequest)
+	resTags = resTags.AppendTag(tags.RequestContextID, []byte(fmt.Sprintf("%d", reqCtx.ID)))
+	resTags = resTags.AppendTag(tags.BatchCounter, []byte(fmt.Sprintf("%d", batch.BatchCounter)))
+	resTags = resTags.AppendTag(tags.RequestID, []byte(request.RequestID()))
+	resTags = resTags.AppendTag(tags.Provider, []byte(request.Provider.String()))
+	return resTags
+}
+
+// Issue a request for the batch, nothing is written if failed
+func (k Keeper) issueBatchRequest(ctx sdk.Context, reqCtx RequestContext, batch RequestBatch) (request SvcRequest, err sdk.Error) {
+	binding, found := k.GetServiceBinding(ctx, reqCtx.DefChainID, reqCtx.DefName, reqCtx.BindChainID, reqCtx.Provider)
+	if !found {
+		return request, ErrSvcBindingNotExists(k.Codespace())
+	}
+	if !binding.Available {
+		return request, ErrSvcBindingNotAvailable(k.Codespace())
+	}
+
+	request = NewSvcRequest(reqCtx.DefChainID, reqCtx.DefName, reqCtx.BindChainID, reqCtx.ReqChainID, reqCtx.Consumer,
+		reqCtx.Provider, reqCtx.MethodID, reqCtx.Input, nil, false)
+	request.RequestContextID = batch.RequestContextID
+	request.BatchCounter = batch.BatchCounter
+	request.EncryptionPubKey = reqCtx.EncryptionPubKey
+
+	//Method id start at 1
+	if len(binding.Prices) >= int(reqCtx.MethodID) {
+		price := sdk.Coins{binding.Prices[reqCtx.MethodID-1]}
+		if !reqCtx.ServiceFee.IsAllGTE(price) {
+			return request, ErrLtServiceFee(k.Codespace(), price)
+		}
+		request.ServiceFee = price
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	request, err = k.addRequest(cacheCtx, request, reqCtx.Timeout)
+	if err != nil {
+		return request, err
+	}
+	write()
+	return request, nil
+}
+
+// Record the response of a request issued by a request context
+func (k Keeper) OnBatchResponded(ctx sdk.Context, request SvcRequest) {
+	batch, found := k.GetRequestBatch(ctx, request.RequestContextID, request.BatchCounter)
+	if !found || batch.RequestID != request.RequestID() {
+		return
+	}
+
+	batch.State = BatchResponded
+	batch.ResponseHeight = ctx.BlockHeight()
+	k.SetRequestBatch(ctx, batch)
+
+	if reqCtx, found := k.GetRequestContext(ctx, request.RequestContextID); found {
+		reqCtx.BatchRespondedCount++
+		k.SetRequestContext(ctx, reqCtx)
+	}
+}
+
+// Retry the timeout request of a request context if the retry limit is not reached,
+// otherwise mark the batch as timeout
+func (k Keeper) OnBatchTimeout(ctx sdk.Context, request SvcRequest) (resTags sdk.Tags) {
+	resTags = sdk.NewTags()
+	batch, found := k.GetRequestBatch(ctx, request.RequestContextID, request.BatchCounter)
+	if !found || batch.RequestID != request.RequestID() {
+		return resTags
+	}
+	reqCtx, found := k.GetRequestContext(ctx, request.RequestContextID)
+	if !found {
+		return resTags
+	}
+
+	retryable := reqCtx.State == ContextRunning || reqCtx.State == ContextCompleted
+	if retryable && batch.Retries < reqCtx.RetryLimit {
+		retry, err := k.issueBatchRequest(ctx, reqCtx, batch)
+		if err == nil {
+			batch.Retries++
+			batch.RequestID = retry.RequestID()
+			batch.RequestHeight = retry.RequestHeight
+			k.SetRequestBatch(ctx, batch)
+
+			resTags = resTags.AppendTag(tags.Action, tags.ActionSvcBatchRetry)
+			resTags = resTags.AppendTag(tags.RequestContextID, []byte(fmt.Sprintf("%d", reqCtx.ID)))
+			resTags = resTags.AppendTag(tags.BatchCounter, []byte(fmt.Sprintf("%d", batch.BatchCounter)))
+			resTags = resTags.AppendTag(tags.RequestID, []byte(retry.RequestID()))
+			return resTags
+		}
+		ctx.Logger().Info("Failed to retry batch", "request_context_id", reqCtx.ID,
+			"batch_counter", batch.BatchCounter, "reason", err.Error())
+	}
+
+	batch.State = BatchTimeout
+	k.SetRequestBatch(ctx, batch)
+
+	reqCtx.BatchTimeoutCount++
+	k.SetRequestContext(ctx, reqCtx)
+	return resTags
+}
@@ -1,11 +1,14 @@
 package service
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/irisnet/irishub/app/v1/service/tags"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 func TestKeeper_service_Definition(t *testing.T) {
@@ -128,6 +131,395 @@ func TestKeeper_service_Call(t *testing.T) {
 	}
 }
 
+func TestKeeper_service_RequestContext(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := NewHandler(keeper)
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.1iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+
+	// start a request context of 3 batches every 5 blocks, each batch times out after 2 blocks
+	ctx = ctx.WithBlockHeight(1)
+	msg := NewMsgStartRequestContext("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{price}, 5, 3, 2, 1)
+	require.True(t, handler(ctx, msg).IsOK())
+
+	// the timeout must not exceed the max request timeout
+	invalidMsg := NewMsgStartRequestContext("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{price}, 200, 3, 150, 1)
+	require.False(t, handler(ctx, invalidMsg).IsOK())
+
+	reqCtx, found := keeper.GetRequestContext(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, ContextRunning, reqCtx.State)
+	require.Equal(t, int64(1), reqCtx.NextBatchHeight)
+
+	// the first batch is issued at the end of the block
+	EndBlocker(ctx, keeper)
+	batch, found := keeper.GetRequestBatch(ctx, 1, 1)
+	require.True(t, found)
+	require.Equal(t, BatchPending, batch.State)
+
+	request := getRequestContextRequest(t, ctx, keeper, addrs[1])
+	require.Equal(t, batch.RequestID, request.RequestID())
+	require.Equal(t, int64(3), request.ExpirationHeight)
+	require.True(t, request.ServiceFee.IsEqual(sdk.Coins{price}))
+
+	// respond the first batch
+	ctx = ctx.WithBlockHeight(2)
+	require.True(t, handler(ctx, NewMsgSvcResponse("testnet", request.RequestID(), addrs[1], []byte("output"), nil)).IsOK())
+	batch, _ = keeper.GetRequestBatch(ctx, 1, 1)
+	require.Equal(t, BatchResponded, batch.State)
+	require.Equal(t, int64(2), batch.ResponseHeight)
+
+	// the second batch times out and is retried
+	ctx = ctx.WithBlockHeight(6)
+	EndBlocker(ctx, keeper)
+	ctx = ctx.WithBlockHeight(8)
+	EndBlocker(ctx, keeper)
+
+	batch, _ = keeper.GetRequestBatch(ctx, 1, 2)
+	require.Equal(t, BatchPending, batch.State)
+	require.Equal(t, uint16(1), batch.Retries)
+	request = getRequestContextRequest(t, ctx, keeper, addrs[1])
+	require.Equal(t, batch.RequestID, request.RequestID())
+
+	binding, _ := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.True(t, binding.Deposit.IsAllLT(sdk.Coins{deposit}))
+
+	// pause the request context, the retry is not retried again after timeout
+	ctx = ctx.WithBlockHeight(9)
+	require.False(t, handler(ctx, NewMsgPauseRequestContext(1, addrs[1])).IsOK())
+	require.True(t, handler(ctx, NewMsgPauseRequestContext(1, addrs[2])).IsOK())
+	ctx = ctx.WithBlockHeight(10)
+	EndBlocker(ctx, keeper)
+	ctx = ctx.WithBlockHeight(11)
+	EndBlocker(ctx, keeper)
+
+	batch, _ = keeper.GetRequestBatch(ctx, 1, 2)
+	require.Equal(t, BatchTimeout, batch.State)
+	reqCtx, _ = keeper.GetRequestContext(ctx, 1)
+	require.Equal(t, ContextPaused, reqCtx.State)
+	require.Equal(t, uint64(2), reqCtx.BatchCounter)
+	require.Equal(t, uint64(1), reqCtx.BatchRespondedCount)
+	require.Equal(t, uint64(1), reqCtx.BatchTimeoutCount)
+
+	// resume the request context, the last batch is issued at the current block
+	ctx = ctx.WithBlockHeight(12)
+	require.True(t, handler(ctx, NewMsgResumeRequestContext(1, addrs[2])).IsOK())
+	EndBlocker(ctx, keeper)
+
+	reqCtx, _ = keeper.GetRequestContext(ctx, 1)
+	require.Equal(t, ContextCompleted, reqCtx.State)
+	require.Equal(t, uint64(3), reqCtx.BatchCounter)
+	require.False(t, handler(ctx, NewMsgKillRequestContext(1, addrs[2])).IsOK())
+
+	// query the batches
+	bz, _ := keeper.cdc.MarshalJSON(QueryRequestContextParams{RequestContextID: 1})
+	res, err := NewQuerier(keeper)(ctx, []string{QueryRequestBatches}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var batches []RequestBatch
+	keeper.cdc.MustUnmarshalJSON(res, &batches)
+	require.Equal(t, 3, len(batches))
+	require.Equal(t, BatchPending, batches[2].State)
+}
+
+func TestKeeper_service_Multicast(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 5)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := NewHandler(keeper)
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.1iris")
+	providers := addrs[1:4]
+	for _, provider := range providers {
+		keeper.ck.AddCoins(ctx, provider, sdk.Coins{coin})
+		svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+			provider, Global, sdk.Coins{deposit}, []sdk.Coin{price},
+			Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+		require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+	}
+	consumer := addrs[4]
+	keeper.ck.AddCoins(ctx, consumer, sdk.Coins{coin})
+
+	// multicast to 3 providers with a quorum of 2
+	ctx = ctx.WithBlockHeight(1)
+	res := handler(ctx, NewMsgSvcMulticastRequest("testnet", "myService", "testnet", "testnet",
+		consumer, providers, 2, 1, []byte("1234"), sdk.Coins{price}, false))
+	require.True(t, res.IsOK())
+	requestID := getTagValue(res.Tags, tags.RequestID)
+
+	eHeight, rHeight, counter, _ := ConvertRequestID(requestID)
+	request, found := keeper.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	require.True(t, found)
+	require.Equal(t, Multicast, request.MessagingType())
+	require.True(t, request.ServiceFee.IsEqual(sdk.Coins{price.Add(price).Add(price)}))
+
+	// each provider receives the request
+	for _, provider := range providers {
+		_, found := keeper.GetActiveProviderRequest(ctx, request, provider)
+		require.True(t, found)
+	}
+
+	// only the providers of the request can respond once
+	ctx = ctx.WithBlockHeight(2)
+	require.False(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, addrs[0], []byte("output"), nil)).IsOK())
+	require.True(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, providers[0], []byte("output"), nil)).IsOK())
+	require.False(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, providers[0], []byte("output"), nil)).IsOK())
+	require.True(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, providers[1], []byte("output"), nil)).IsOK())
+
+	// the request is finalized at the end of the block since the quorum is reached
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	require.False(t, found)
+	require.False(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, providers[2], []byte("output"), nil)).IsOK())
+
+	// the responders are paid and the fee of the non-responder is returned
+	for _, provider := range providers[:2] {
+		_, found := keeper.GetIncomingFee(ctx, provider)
+		require.True(t, found)
+	}
+	_, found = keeper.GetIncomingFee(ctx, providers[2])
+	require.False(t, found)
+	returnedFee, found := keeper.GetReturnFee(ctx, consumer)
+	require.True(t, found)
+	require.True(t, returnedFee.Coins.IsEqual(sdk.Coins{price}))
+
+	// query the responses
+	bz, _ := keeper.cdc.MarshalJSON(QueryResponseParams{ReqChainId: "testnet", RequestId: requestID})
+	resBz, err := NewQuerier(keeper)(ctx, []string{QueryMulticastResponses}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var responses []SvcResponse
+	keeper.cdc.MustUnmarshalJSON(resBz, &responses)
+	require.Equal(t, 2, len(responses))
+
+	// the providers which have not responded are slashed after the request times out
+	ctx = ctx.WithBlockHeight(3)
+	res = handler(ctx, NewMsgSvcMulticastRequest("testnet", "myService", "testnet", "testnet",
+		consumer, providers, 3, 1, []byte("1234"), sdk.Coins{price}, false))
+	require.True(t, res.IsOK())
+	requestID = getTagValue(res.Tags, tags.RequestID)
+	require.True(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, providers[0], []byte("output"), nil)).IsOK())
+
+	eHeight, rHeight, counter, _ = ConvertRequestID(requestID)
+	ctx = ctx.WithBlockHeight(eHeight)
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetActiveRequest(ctx, eHeight, rHeight, counter)
+	require.False(t, found)
+
+	binding, _ := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", providers[0])
+	require.True(t, binding.Deposit.IsEqual(sdk.Coins{deposit}))
+	for _, provider := range providers[1:] {
+		binding, _ := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", provider)
+		require.True(t, binding.Deposit.IsAllLT(sdk.Coins{deposit}))
+	}
+}
+
+func TestKeeper_service_EncryptedOutput(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 3)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := NewHandler(keeper)
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	keeper.ck.AddCoins(ctx, addrs[1], sdk.Coins{coin})
+	keeper.ck.AddCoins(ctx, addrs[2], sdk.Coins{coin})
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		strings.Replace(idlContent, "NoPrivacy", "PubKeyEncryption", 1))
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.1iris")
+	svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+		addrs[1], Global, sdk.Coins{deposit}, []sdk.Coin{price},
+		Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+
+	// the encryption pubkey is required by the method
+	msg := NewMsgSvcRequest("testnet", "myService", "testnet", "testnet",
+		addrs[2], addrs[1], 1, []byte("1234"), sdk.Coins{price}, false)
+	require.False(t, handler(ctx, msg).IsOK())
+
+	privKey := secp256k1.GenPrivKey()
+	msg.EncryptionPubKey, _ = GetEncryptionPubKey(privKey.PubKey())
+	require.Nil(t, msg.ValidateBasic())
+	res := handler(ctx, msg)
+	require.True(t, res.IsOK())
+	requestID := getTagValue(res.Tags, tags.RequestID)
+
+	// the plaintext output is rejected
+	require.False(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, addrs[1], []byte("output"), nil)).IsOK())
+
+	ciphertext, err := EncryptOutput(msg.EncryptionPubKey, []byte("output"))
+	require.NoError(t, err)
+	require.True(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, addrs[1], ciphertext, nil)).IsOK())
+
+	eHeight, rHeight, counter, _ := ConvertRequestID(requestID)
+	response, found := keeper.GetResponse(ctx, "testnet", eHeight, rHeight, counter)
+	require.True(t, found)
+	output, err := DecryptOutput(privKey, response.Output)
+	require.NoError(t, err)
+	require.Equal(t, []byte("output"), output)
+}
+
+func TestKeeper_service_Reputation(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 4)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := NewHandler(keeper)
+
+	serviceDef := NewSvcDef("myService",
+		"testnet",
+		"the service for unit test",
+		[]string{"test", "tutorial"},
+		addrs[0],
+		"unit test author",
+		idlContent)
+	keeper.AddServiceDefinition(ctx, serviceDef)
+	keeper.AddMethods(ctx, serviceDef)
+
+	coin, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1100iris")
+	deposit, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("1000iris")
+	price, _ := sdk.IrisCoinType.ConvertToMinDenomCoin("0.1iris")
+	for _, provider := range addrs[1:3] {
+		keeper.ck.AddCoins(ctx, provider, sdk.Coins{coin})
+		svcBinding := NewSvcBinding(ctx, "testnet", "myService", "testnet",
+			provider, Global, sdk.Coins{deposit}, []sdk.Coin{price},
+			Level{AvgRspTime: 10000, UsableTime: 9999}, true)
+		require.NoError(t, keeper.AddServiceBinding(ctx, svcBinding))
+	}
+	consumer := addrs[3]
+	keeper.ck.AddCoins(ctx, consumer, sdk.Coins{coin})
+
+	// request both providers, only the first one responds
+	ctx = ctx.WithBlockHeight(1)
+	res := handler(ctx, NewMsgSvcRequest("testnet", "myService", "testnet", "testnet",
+		consumer, addrs[1], 1, []byte("1234"), sdk.Coins{price}, false))
+	require.True(t, res.IsOK())
+	requestID := getTagValue(res.Tags, tags.RequestID)
+	res = handler(ctx, NewMsgSvcRequest("testnet", "myService", "testnet", "testnet",
+		consumer, addrs[2], 1, []byte("1234"), sdk.Coins{price}, false))
+	require.True(t, res.IsOK())
+	EndBlocker(ctx, keeper)
+
+	ctx = ctx.WithBlockHeight(3)
+	require.True(t, handler(ctx, NewMsgSvcResponse("testnet", requestID, addrs[1], []byte("output"), nil)).IsOK())
+
+	// the reputation is measured in the end block
+	_, found := keeper.GetReputation(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.False(t, found)
+	EndBlocker(ctx, keeper)
+
+	reputation, found := keeper.GetReputation(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.True(t, found)
+	require.Equal(t, uint64(1), reputation.RespondedCount)
+	require.True(t, reputation.AvgResponseBlocks.Equal(sdk.NewDec(2)))
+	require.True(t, reputation.SuccessRatio.Equal(sdk.OneDec()))
+	binding, _ := keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[1])
+	require.Equal(t, int64(10000), binding.Level.UsableTime)
+	require.Equal(t, int64(2*blockIntervalMilliseconds), binding.Level.AvgRspTime)
+
+	// the request of the second provider times out
+	eHeight, _, _, _ := ConvertRequestID(requestID)
+	ctx = ctx.WithBlockHeight(eHeight)
+	EndBlocker(ctx, keeper)
+
+	reputation, found = keeper.GetReputation(ctx, "testnet", "myService", "testnet", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(1), reputation.TimeoutCount)
+	require.True(t, reputation.SuccessRatio.IsZero())
+	// a zero success ratio keeps the minimum usable time, the declared average response time is kept without any response
+	binding, _ = keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[2])
+	require.Equal(t, int64(1), binding.Level.UsableTime)
+	require.Equal(t, int64(10000), binding.Level.AvgRspTime)
+	require.True(t, validLevel(binding.Level))
+
+	// the provider declared level is ignored once measured
+	update := NewSvcBinding(ctx, "testnet", "myService", "testnet", addrs[2], 0x00, sdk.Coins{},
+		nil, Level{AvgRspTime: 20000, UsableTime: 9999}, true)
+	require.NoError(t, keeper.UpdateServiceBinding(ctx, update))
+	binding, _ = keeper.GetServiceBinding(ctx, "testnet", "myService", "testnet", addrs[2])
+	require.Equal(t, int64(1), binding.Level.UsableTime)
+	require.Equal(t, int64(10000), binding.Level.AvgRspTime)
+
+	// the providers are ranked by their reputations
+	bz, _ := keeper.cdc.MarshalJSON(QueryBestProvidersParams{DefChainID: "testnet", ServiceName: "myService", MethodID: 1})
+	resBz, err := NewQuerier(keeper)(ctx, []string{QueryBestProviders}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var ranks []ProviderRank
+	keeper.cdc.MustUnmarshalJSON(resBz, &ranks)
+	require.Equal(t, 2, len(ranks))
+	require.Equal(t, addrs[1], ranks[0].Provider)
+	require.Equal(t, addrs[2], ranks[1].Provider)
+}
+
+func getTagValue(resTags sdk.Tags, key string) string {
+	for _, tag := range resTags {
+		if string(tag.Key) == key {
+			return string(tag.Value)
+		}
+	}
+	return ""
+}
+
+// get the only active request of the provider
+func getRequestContextRequest(t *testing.T, ctx sdk.Context, keeper Keeper, provider sdk.AccAddress) SvcRequest {
+	iterator := keeper.ActiveBindRequestsIterator(ctx, "testnet", "myService", "testnet", provider)
+	defer iterator.Close()
+	require.True(t, iterator.Valid())
+
+	var request SvcRequest
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &request)
+	require.Equal(t, uint64(1), request.RequestContextID)
+
+	iterator.Next()
+	require.False(t, iterator.Valid())
+	return request
+}
+
 const idlContent = `
 	syntax = "proto3";
 
@@ -14,9 +14,13 @@ const (
 	outputPrivacy = "output_privacy"
 	outputCached  = "output_cached"
 	description   = "description"
+
+	// the max number of providers of a multicast request
+	maxMulticastProviders = 16
 )
 
 var _, _, _, _, _, _, _, _, _, _, _ sdk.Msg = MsgSvcDef{}, MsgSvcBind{}, MsgSvcBindingUpdate{}, MsgSvcDisable{}, MsgSvcEnable{}, MsgSvcRefundDeposit{}, MsgSvcRequest{}, MsgSvcResponse{}, MsgSvcRefundFees{}, MsgSvcWithdrawFees{}, MsgSvcWithdrawTax{}
+var _, _, _, _ sdk.Msg = MsgStartRequestContext{}, MsgPauseRequestContext{}, MsgResumeRequestContext{}, MsgKillRequestContext{}
 
 //______________________________________________________________________
 
@@ -489,6 +493,11 @@ type MsgSvcRequest struct {
 	Input       []byte         `json:"input"`
 	ServiceFee  sdk.Coins      `json:"service_fee"`
 	Profiling   bool           `json:"profiling"`
+
+	Providers []sdk.AccAddress `json:"providers,omitempty"` // all the providers of a multicast request
+	Quorum    uint16           `json:"quorum,omitempty"`    // number of responses to finalize a multicast request
+
+	EncryptionPubKey []byte `json:"encryption_pubkey,omitempty"` // pubkey of the consumer to encrypt the outputs, required by the methods with PubKeyEncryption privacy
 }
 
 func NewMsgSvcRequest(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) MsgSvcRequest {
@@ -506,6 +515,15 @@ func NewMsgSvcRequest(defChainID, defName, bindChainID, reqChainID string, consu
 	}
 }
 
+// NewMsgSvcMulticastRequest creates a request sent to all the given providers,
+// the service fee is the max fee to pay for each provider
+func NewMsgSvcMulticastRequest(defChainID, defName, bindChainID, reqChainID string, consumer sdk.AccAddress, providers []sdk.AccAddress, quorum uint16, methodID int16, input []byte, serviceFee sdk.Coins, profiling bool) MsgSvcRequest {
+	msg := NewMsgSvcRequest(defChainID, defName, bindChainID, reqChainID, consumer, nil, methodID, input, serviceFee, profiling)
+	msg.Providers = providers
+	msg.Quorum = quorum
+	return msg
+}
+
 func (msg MsgSvcRequest) Route() string { return MsgRoute }
 func (msg MsgSvcRequest) Type() string  { return "call_service" }
 
@@ -545,7 +563,11 @@ func (msg MsgSvcRequest) ValidateBasic() sdk.Error {
 	if err := ensureNameLength(msg.DefName); err != nil {
 		return err
 	}
-	if len(msg.Provider) == 0 {
+	if len(msg.Providers) > 0 {
+		if err := validateMulticastProviders(msg.Provider, msg.Providers, msg.Quorum); err != nil {
+			return err
+		}
+	} else if len(msg.Provider) == 0 {
 		return sdk.ErrInvalidAddress(msg.Provider.String())
 	}
 	if len(msg.Consumer) == 0 {
@@ -554,6 +576,34 @@ func (msg MsgSvcRequest) ValidateBasic() sdk.Error {
 	if !msg.ServiceFee.IsValidIrisAtto() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid service fee [%s]", msg.ServiceFee))
 	}
+	if len(msg.EncryptionPubKey) > 0 {
+		if err := validateEncryptionPubKey(msg.EncryptionPubKey); err != nil {
+			return ErrInvalidEncryptionPubKey(DefaultCodespace, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateMulticastProviders(provider sdk.AccAddress, providers []sdk.AccAddress, quorum uint16) sdk.Error {
+	if len(provider) != 0 {
+		return ErrInvalidMulticast(DefaultCodespace, "provider must be empty for a multicast request")
+	}
+	if len(providers) > maxMulticastProviders {
+		return ErrInvalidMulticast(DefaultCodespace, fmt.Sprintf("the number of providers must not be greater than %d", maxMulticastProviders))
+	}
+	seen := make(map[string]bool)
+	for _, p := range providers {
+		if len(p) == 0 {
+			return sdk.ErrInvalidAddress(p.String())
+		}
+		if seen[p.String()] {
+			return ErrInvalidMulticast(DefaultCodespace, fmt.Sprintf("duplicate provider [%s]", p))
+		}
+		seen[p.String()] = true
+	}
+	if quorum == 0 || int(quorum) > len(providers) {
+		return ErrInvalidMulticast(DefaultCodespace, fmt.Sprintf("quorum must be between [1, %d]", len(providers)))
+	}
 	return nil
 }
 
@@ -729,6 +779,184 @@ func (msg MsgSvcWithdrawTax) GetSigners() []sdk.AccAddress {
 
 //______________________________________________________________________
 
+// MsgStartRequestContext - struct for call a service method repeatedly
+type MsgStartRequestContext struct {
+	DefChainID        string         `json:"def_chain_id"`
+	DefName           string         `json:"def_name"`
+	BindChainID       string         `json:"bind_chain_id"`
+	ReqChainID        string         `json:"req_chain_id"`
+	MethodID          int16          `json:"method_id"`
+	Provider          sdk.AccAddress `json:"provider"`
+	Consumer          sdk.AccAddress `json:"consumer"`
+	Input             []byte         `json:"input"`
+	ServiceFee        sdk.Coins      `json:"service_fee"`
+	RepeatedFrequency uint64         `json:"repeated_frequency"`
+	RepeatedTotal     uint64         `json:"repeated_total"`
+	Timeout           int64          `json:"timeout"`
+	RetryLimit        uint16         `json:"retry_limit"`
+	EncryptionPubKey  []byte         `json:"encryption_pubkey,omitempty"`
+}
+
+func NewMsgStartRequestContext(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, repeatedFrequency, repeatedTotal uint64, timeout int64, retryLimit uint16) MsgStartRequestContext {
+	return MsgStartRequestContext{
+		DefChainID:        defChainID,
+		DefName:           defName,
+		BindChainID:       bindChainID,
+		ReqChainID:        reqChainID,
+		Consumer:          consumer,
+		Provider:          provider,
+		MethodID:          methodID,
+		Input:             input,
+		ServiceFee:        serviceFee,
+		RepeatedFrequency: repeatedFrequency,
+		RepeatedTotal:     repeatedTotal,
+		Timeout:           timeout,
+		RetryLimit:        retryLimit,
+	}
+}
+
+func (msg MsgStartRequestContext) Route() string { return MsgRoute }
+func (msg MsgStartRequestContext) Type() string  { return "start_request_context" }
+
+func (msg MsgStartRequestContext) GetSignBytes() []byte {
+	if len(msg.Input) == 0 {
+		msg.Input = nil
+	}
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgStartRequestContext) ValidateBasic() sdk.Error {
+	request := NewMsgSvcRequest(msg.DefChainID, msg.DefName, msg.BindChainID, msg.ReqChainID, msg.Consumer,
+		msg.Provider, msg.MethodID, msg.Input, msg.ServiceFee, false)
+	request.EncryptionPubKey = msg.EncryptionPubKey
+	if err := request.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.RepeatedFrequency == 0 {
+		return ErrInvalidRequestContext(DefaultCodespace, "repeated frequency must be greater than 0")
+	}
+	if msg.Timeout <= 0 {
+		return ErrInvalidRequestContext(DefaultCodespace, "timeout must be greater than 0")
+	}
+	if uint64(msg.Timeout) > msg.RepeatedFrequency {
+		return ErrInvalidRequestContext(DefaultCodespace, fmt.Sprintf("timeout [%d] must not be greater than repeated frequency [%d]", msg.Timeout, msg.RepeatedFrequency))
+	}
+	return nil
+}
+
+func (msg MsgStartRequestContext) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
+// MsgPauseRequestContext - struct for pause a request context
+type MsgPauseRequestContext struct {
+	RequestContextID uint64         `json:"request_context_id"`
+	Consumer         sdk.AccAddress `json:"consumer"`
+}
+
+func NewMsgPauseRequestContext(requestContextID uint64, consumer sdk.AccAddress) MsgPauseRequestContext {
+	return MsgPauseRequestContext{
+		RequestContextID: requestContextID,
+		Consumer:         consumer,
+	}
+}
+
+func (msg MsgPauseRequestContext) Route() string { return MsgRoute }
+func (msg MsgPauseRequestContext) Type() string  { return "pause_request_context" }
+
+func (msg MsgPauseRequestContext) GetSignBytes() []byte {
+	b := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgPauseRequestContext) ValidateBasic() sdk.Error {
+	return validateRequestContextOperation(msg.RequestContextID, msg.Consumer)
+}
+
+func (msg MsgPauseRequestContext) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
+// MsgResumeRequestContext - struct for resume a paused request context
+type MsgResumeRequestContext struct {
+	RequestContextID uint64         `json:"request_context_id"`
+	Consumer         sdk.AccAddress `json:"consumer"`
+}
+
+func NewMsgResumeRequestContext(requestContextID uint64, consumer sdk.AccAddress) MsgResumeRequestContext {
+	return MsgResumeRequestContext{
+		RequestContextID: requestContextID,
+		Consumer:         consumer,
+	}
+}
+
+func (msg MsgResumeRequestContext) Route() string { return MsgRoute }
+func (msg MsgResumeRequestContext) Type() string  { return "resume_request_context" }
+
+func (msg MsgResumeRequestContext) GetSignBytes() []byte {
+	b := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgResumeRequestContext) ValidateBasic() sdk.Error {
+	return validateRequestContextOperation(msg.RequestContextID, msg.Consumer)
+}
+
+func (msg MsgResumeRequestContext) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+//______________________________________________________________________
+
+// MsgKillRequestContext - struct for terminate a request context
+type MsgKillRequestContext struct {
+	RequestContextID uint64         `json:"request_context_id"`
+	Consumer         sdk.AccAddress `json:"consumer"`
+}
+
+func NewMsgKillRequestContext(requestContextID uint64, consumer sdk.AccAddress) MsgKillRequestContext {
+	return MsgKillRequestContext{
+		RequestContextID: requestContextID,
+		Consumer:         consumer,
+	}
+}
+
+func (msg MsgKillRequestContext) Route() string { return MsgRoute }
+func (msg MsgKillRequestContext) Type() string  { return "kill_request_context" }
+
+func (msg MsgKillRequestContext) GetSignBytes() []byte {
+	b := msgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgKillRequestContext) ValidateBasic() sdk.Error {
+	return validateRequestContextOperation(msg.RequestContextID, msg.Consumer)
+}
+
+func (msg MsgKillRequestContext) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Consumer}
+}
+
+func validateRequestContextOperation(requestContextID uint64, consumer sdk.AccAddress) sdk.Error {
+	if requestContextID == 0 {
+		return ErrInvalidRequestContext(DefaultCodespace, "request context id must be greater than 0")
+	}
+	if len(consumer) == 0 {
+		return sdk.ErrInvalidAddress(consumer.String())
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
 func validServiceName(name string) bool {
 	if len(name) == 0 || len(name) > 128 {
 		return false
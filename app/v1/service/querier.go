@@ -13,6 +13,14 @@ const (
 	QueryRequests   = "requests"
 	QueryResponse   = "response"
 	QueryFees       = "fees"
+
+	QueryRequestContext = "request_context"
+	QueryRequestBatches = "request_batches"
+
+	QueryMulticastResponses = "multicast_responses"
+
+	QueryReputation    = "reputation"
+	QueryBestProviders = "best_providers"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -30,6 +38,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryResponse(ctx, req, k)
 		case QueryFees:
 			return queryFees(ctx, req, k)
+		case QueryRequestContext:
+			return queryRequestContext(ctx, req, k)
+		case QueryRequestBatches:
+			return queryRequestBatches(ctx, req, k)
+		case QueryMulticastResponses:
+			return queryMulticastResponses(ctx, req, k)
+		case QueryReputation:
+			return queryReputation(ctx, req, k)
+		case QueryBestProviders:
+			return queryBestProviders(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown service query endpoint")
 		}
@@ -173,6 +191,35 @@ func queryResponse(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sd
 	return bz, nil
 }
 
+func queryMulticastResponses(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryResponseParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	eHeight, rHeight, counter, err := ConvertRequestID(params.RequestId)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	responses := make([]SvcResponse, 0)
+	for _, response := range k.GetMulticastResponses(ctx, eHeight, rHeight, counter) {
+		if response.ReqChainID == params.ReqChainId {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return nil, ErrNoResponseFound(DefaultCodespace, params.RequestId)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, responses)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
 type QueryFeesParams struct {
 	Address sdk.AccAddress
 }
@@ -205,3 +252,98 @@ func queryFees(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Er
 	}
 	return bz, nil
 }
+
+type QueryRequestContextParams struct {
+	RequestContextID uint64
+}
+
+func queryRequestContext(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryRequestContextParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	reqCtx, found := k.GetRequestContext(ctx, params.RequestContextID)
+	if !found {
+		return nil, ErrRequestContextNotExists(DefaultCodespace, params.RequestContextID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, reqCtx)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryRequestBatches(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryRequestContextParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	if _, found := k.GetRequestContext(ctx, params.RequestContextID); !found {
+		return nil, ErrRequestContextNotExists(DefaultCodespace, params.RequestContextID)
+	}
+
+	iterator := k.RequestBatchesIterator(ctx, params.RequestContextID)
+	defer iterator.Close()
+	batches := make([]RequestBatch, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var batch RequestBatch
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &batch)
+		batches = append(batches, batch)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, batches)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+func queryReputation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBindingParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	if _, found := k.GetServiceBinding(ctx, params.DefChainID, params.ServiceName, params.BindChainId, params.Provider); !found {
+		return nil, ErrSvcBindingNotExists(DefaultCodespace)
+	}
+	reputation := k.getOrNewReputation(ctx, params.DefChainID, params.ServiceName, params.BindChainId, params.Provider)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, reputation)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
+
+type QueryBestProvidersParams struct {
+	DefChainID  string
+	ServiceName string
+	MethodID    int16
+	Limit       int // the max number of providers returned, 0 means all
+}
+
+func queryBestProviders(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBestProvidersParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ParseParamsErr(err)
+	}
+
+	if _, found := k.GetMethod(ctx, params.DefChainID, params.ServiceName, params.MethodID); !found {
+		return nil, ErrMethodNotExists(DefaultCodespace, params.MethodID)
+	}
+	ranks := k.GetBestProviders(ctx, params.DefChainID, params.ServiceName, params.MethodID, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, ranks)
+	if err != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return bz, nil
+}
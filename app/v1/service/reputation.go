@@ -0,0 +1,85 @@
+package service
+
+import (
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// the nominal block interval in milliseconds, used to convert the measured response blocks into the average response time
+const blockIntervalMilliseconds = 5000
+
+// Reputation records the performance of a service binding measured by the chain
+type Reputation struct {
+	DefChainID          string         `json:"def_chain_id"`
+	DefName             string         `json:"def_name"`
+	BindChainID         string         `json:"bind_chain_id"`
+	Provider            sdk.AccAddress `json:"provider"`
+	RespondedCount      uint64         `json:"responded_count"`       // the number of requests responded
+	TimeoutCount        uint64         `json:"timeout_count"`         // the number of requests timed out
+	TotalResponseBlocks uint64         `json:"total_response_blocks"` // the total blocks between the requests and responses
+	AvgResponseBlocks   sdk.Dec        `json:"avg_response_blocks"`   // the average blocks taken to respond a request
+	SuccessRatio        sdk.Dec        `json:"success_ratio"`         // the ratio of the requests responded
+}
+
+func NewReputation(defChainID, defName, bindChainID string, provider sdk.AccAddress) Reputation {
+	return Reputation{
+		DefChainID:        defChainID,
+		DefName:           defName,
+		BindChainID:       bindChainID,
+		Provider:          provider,
+		AvgResponseBlocks: sdk.ZeroDec(),
+		SuccessRatio:      sdk.ZeroDec(),
+	}
+}
+
+// update the average response blocks and the success ratio from the counters
+func (r Reputation) recalculate() Reputation {
+	r.AvgResponseBlocks = sdk.ZeroDec()
+	if r.RespondedCount > 0 {
+		r.AvgResponseBlocks = sdk.NewDec(int64(r.TotalResponseBlocks)).Quo(sdk.NewDec(int64(r.RespondedCount)))
+	}
+
+	r.SuccessRatio = sdk.ZeroDec()
+	if total := r.RespondedCount + r.TimeoutCount; total > 0 {
+		r.SuccessRatio = sdk.NewDec(int64(r.RespondedCount)).Quo(sdk.NewDec(int64(total)))
+	}
+	return r
+}
+
+// the level measured from the reputation, the measured values are kept in the
+// range accepted for a level: a provider without any response yet keeps the
+// declared average response time, and a zero success ratio is counted as the
+// minimum usable time 1
+func (r Reputation) measuredLevel(declared Level) Level {
+	level := declared
+	if r.RespondedCount > 0 {
+		level.AvgRspTime = r.AvgResponseBlocks.MulInt(sdk.NewInt(blockIntervalMilliseconds)).TruncateInt64()
+		if level.AvgRspTime < 1 {
+			level.AvgRspTime = 1
+		}
+	}
+	level.UsableTime = r.SuccessRatio.MulInt(sdk.NewInt(10000)).TruncateInt64()
+	if level.UsableTime < 1 {
+		level.UsableTime = 1
+	}
+	return level
+}
+
+// is the reputation better than the other one? A higher success ratio ranks first,
+// then the lower average response blocks and the fewer timeouts
+func (r Reputation) betterThan(other Reputation) bool {
+	if !r.SuccessRatio.Equal(other.SuccessRatio) {
+		return r.SuccessRatio.GT(other.SuccessRatio)
+	}
+	if !r.AvgResponseBlocks.Equal(other.AvgResponseBlocks) {
+		return r.AvgResponseBlocks.LT(other.AvgResponseBlocks)
+	}
+	return r.TimeoutCount < other.TimeoutCount
+}
+
+// ProviderRank is a provider of a service method ranked by its reputation
+type ProviderRank struct {
+	Provider   sdk.AccAddress `json:"provider"`
+	Price      sdk.Coin       `json:"price"`
+	Level      Level          `json:"level"`
+	Reputation Reputation     `json:"reputation"`
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/pkg/errors"
+)
+
+// RequestContext defines the repeated invocations of a service method, which are issued
+// in batches at a fixed block interval
+type RequestContext struct {
+	ID                  uint64              `json:"id"`
+	DefChainID          string              `json:"def_chain_id"`
+	DefName             string              `json:"def_name"`
+	BindChainID         string              `json:"bind_chain_id"`
+	ReqChainID          string              `json:"req_chain_id"`
+	MethodID            int16               `json:"method_id"`
+	Provider            sdk.AccAddress      `json:"provider"`
+	Consumer            sdk.AccAddress      `json:"consumer"`
+	Input               []byte              `json:"input"`
+	ServiceFee          sdk.Coins           `json:"service_fee"`           // the maximum service fee paid for each batch
+	RepeatedFrequency   uint64              `json:"repeated_frequency"`    // the block interval between two batches
+	RepeatedTotal       uint64              `json:"repeated_total"`        // the total number of batches, 0 means unlimited
+	Timeout             int64               `json:"timeout"`               // the number of blocks a batch request waits for the response
+	RetryLimit          uint16              `json:"retry_limit"`           // the maximum number of retries of a timeout batch
+	BatchCounter        uint64              `json:"batch_counter"`         // the number of batches issued
+	BatchRespondedCount uint64              `json:"batch_responded_count"` // the number of batches responded
+	BatchTimeoutCount   uint64              `json:"batch_timeout_count"`   // the number of batches failed after all retries
+	NextBatchHeight     int64               `json:"next_batch_height"`     // block height at which the next batch will be issued
+	State               RequestContextState `json:"state"`
+	EncryptionPubKey    []byte              `json:"encryption_pubkey"` // pubkey of the consumer to encrypt the outputs
+}
+
+func NewRequestContext(defChainID, defName, bindChainID, reqChainID string, consumer, provider sdk.AccAddress, methodID int16, input []byte, serviceFee sdk.Coins, repeatedFrequency, repeatedTotal uint64, timeout int64, retryLimit uint16) RequestContext {
+	return RequestContext{
+		DefChainID:        defChainID,
+		DefName:           defName,
+		BindChainID:       bindChainID,
+		ReqChainID:        reqChainID,
+		MethodID:          methodID,
+		Provider:          provider,
+		Consumer:          consumer,
+		Input:             input,
+		ServiceFee:        serviceFee,
+		RepeatedFrequency: repeatedFrequency,
+		RepeatedTotal:     repeatedTotal,
+		Timeout:           timeout,
+		RetryLimit:        retryLimit,
+		State:             ContextRunning,
+	}
+}
+
+// is all the batches of the request context issued?
+func (rc RequestContext) isLastBatch() bool {
+	return rc.RepeatedTotal > 0 && rc.BatchCounter >= rc.RepeatedTotal
+}
+
+// RequestBatch records the invocation of a single batch of a request context
+type RequestBatch struct {
+	RequestContextID uint64            `json:"request_context_id"`
+	BatchCounter     uint64            `json:"batch_counter"`
+	RequestID        string            `json:"request_id"`      // id of the latest request issued for the batch
+	RequestHeight    int64             `json:"request_height"`  // block height of the latest request
+	ResponseHeight   int64             `json:"response_height"` // block height of the response, 0 if not responded
+	Retries          uint16            `json:"retries"`
+	State            RequestBatchState `json:"state"`
+}
+
+func NewRequestBatch(requestContextID, batchCounter uint64) RequestBatch {
+	return RequestBatch{
+		RequestContextID: requestContextID,
+		BatchCounter:     batchCounter,
+		State:            BatchPending,
+	}
+}
+
+//______________________________________________________________________
+
+type RequestContextState byte
+
+const (
+	ContextRunning   RequestContextState = 0x00
+	ContextPaused    RequestContextState = 0x01
+	ContextCompleted RequestContextState = 0x02
+	ContextKilled    RequestContextState = 0x03
+)
+
+// String to RequestContextState byte, Returns ff if invalid.
+func RequestContextStateFromString(str string) (RequestContextState, error) {
+	switch str {
+	case "Running":
+		return ContextRunning, nil
+	case "Paused":
+		return ContextPaused, nil
+	case "Completed":
+		return ContextCompleted, nil
+	case "Killed":
+		return ContextKilled, nil
+	default:
+		return RequestContextState(0xff), errors.Errorf("'%s' is not a valid request context state", str)
+	}
+}
+
+// For Printf / Sprintf, returns the state name when using %s
+func (state RequestContextState) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", state.String())))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(state))))
+	}
+}
+
+// Turns RequestContextState byte to String
+func (state RequestContextState) String() string {
+	switch state {
+	case ContextRunning:
+		return "Running"
+	case ContextPaused:
+		return "Paused"
+	case ContextCompleted:
+		return "Completed"
+	case ContextKilled:
+		return "Killed"
+	default:
+		return ""
+	}
+}
+
+// Marshals to JSON using string
+func (state RequestContextState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(state.String())
+}
+
+// Unmarshals from JSON
+func (state *RequestContextState) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := RequestContextStateFromString(s)
+	if err != nil {
+		return err
+	}
+	*state = bz2
+	return nil
+}
+
+//______________________________________________________________________
+
+type RequestBatchState byte
+
+const (
+	BatchPending   RequestBatchState = 0x00
+	BatchResponded RequestBatchState = 0x01
+	BatchTimeout   RequestBatchState = 0x02
+)
+
+// String to RequestBatchState byte, Returns ff if invalid.
+func RequestBatchStateFromString(str string) (RequestBatchState, error) {
+	switch str {
+	case "Pending":
+		return BatchPending, nil
+	case "Responded":
+		return BatchResponded, nil
+	case "Timeout":
+		return BatchTimeout, nil
+	default:
+		return RequestBatchState(0xff), errors.Errorf("'%s' is not a valid request batch state", str)
+	}
+}
+
+// For Printf / Sprintf, returns the state name when using %s
+func (state RequestBatchState) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", state.String())))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(state))))
+	}
+}
+
+// Turns RequestBatchState byte to String
+func (state RequestBatchState) String() string {
+	switch state {
+	case BatchPending:
+		return "Pending"
+	case BatchResponded:
+		return "Responded"
+	case BatchTimeout:
+		return "Timeout"
+	default:
+		return ""
+	}
+}
+
+// Marshals to JSON using string
+func (state RequestBatchState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(state.String())
+}
+
+// Unmarshals from JSON
+func (state *RequestBatchState) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := RequestBatchStateFromString(s)
+	if err != nil {
+		return err
+	}
+	*state = bz2
+	return nil
+}
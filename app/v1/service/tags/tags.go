@@ -5,7 +5,11 @@ import (
 )
 
 var (
-	ActionSvcCallTimeOut = []byte("service-call-expiration")
+	ActionSvcCallTimeOut        = []byte("service-call-expiration")
+	ActionSvcBatchRequest       = []byte("service-batch-request")
+	ActionSvcBatchRetry         = []byte("service-batch-retry")
+	ActionRequestContextPaused  = []byte("request-context-paused")
+	ActionSvcMulticastFinalized = []byte("service-multicast-finalized")
 
 	Action = sdk.TagAction
 
@@ -14,4 +18,7 @@ var (
 	RequestID  = "request-id"
 	ServiceFee = "service-fee"
 	SlashCoins = "service-slash-coins"
+
+	RequestContextID = "request-context-id"
+	BatchCounter     = "batch-counter"
 )
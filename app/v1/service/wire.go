@@ -17,6 +17,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSvcRefundFees{}, "irishub/service/MsgSvcRefundFees", nil)
 	cdc.RegisterConcrete(MsgSvcWithdrawFees{}, "irishub/service/MsgSvcWithdrawFees", nil)
 	cdc.RegisterConcrete(MsgSvcWithdrawTax{}, "irishub/service/MsgSvcWithdrawTax", nil)
+	cdc.RegisterConcrete(MsgStartRequestContext{}, "irishub/service/MsgStartRequestContext", nil)
+	cdc.RegisterConcrete(MsgPauseRequestContext{}, "irishub/service/MsgPauseRequestContext", nil)
+	cdc.RegisterConcrete(MsgResumeRequestContext{}, "irishub/service/MsgResumeRequestContext", nil)
+	cdc.RegisterConcrete(MsgKillRequestContext{}, "irishub/service/MsgKillRequestContext", nil)
 
 	cdc.RegisterConcrete(SvcDef{}, "irishub/service/SvcDef", nil)
 	cdc.RegisterConcrete(MethodProperty{}, "irishub/service/MethodProperty", nil)
@@ -25,6 +29,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(SvcResponse{}, "irishub/service/SvcResponse", nil)
 	cdc.RegisterConcrete(IncomingFee{}, "irishub/service/IncomingFee", nil)
 	cdc.RegisterConcrete(ReturnedFee{}, "irishub/service/ReturnedFee", nil)
+	cdc.RegisterConcrete(RequestContext{}, "irishub/service/RequestContext", nil)
+	cdc.RegisterConcrete(RequestBatch{}, "irishub/service/RequestBatch", nil)
+	cdc.RegisterConcrete(Reputation{}, "irishub/service/Reputation", nil)
 
 	cdc.RegisterConcrete(&Params{}, "irishub/service/Params", nil)
 }
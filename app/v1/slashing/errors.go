@@ -16,6 +16,7 @@ const (
 	CodeValidatorJailed       CodeType = 102
 	CodeValidatorNotJailed    CodeType = 103
 	CodeMissingSelfDelegation CodeType = 104
+	CodeSelfDelegationTooLow  CodeType = 105
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -37,3 +38,7 @@ func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingSelfDelegation, "validator has no self-delegation; cannot be unjailed")
 }
+
+func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfDelegationTooLow, "validator's self-delegation is less than its minimum self-delegation; cannot be unjailed")
+}
@@ -1,6 +1,8 @@
 package slashing
 
 import (
+	"fmt"
+
 	"github.com/irisnet/irishub/app/v1/stake/types"
 	sdk "github.com/irisnet/irishub/types"
 )
@@ -11,6 +13,7 @@ type GenesisState struct {
 	SigningInfos    map[string]ValidatorSigningInfo `json:"signing_infos"`
 	MissedBlocks    map[string][]MissedBlock        `json:"missed_blocks"`
 	SlashingPeriods []ValidatorSlashingPeriod       `json:"slashing_periods"`
+	RotatedConsKeys []RotatedConsKey                `json:"rotated_cons_keys"`
 }
 
 // MissedBlock
@@ -26,6 +29,7 @@ func DefaultGenesisState() GenesisState {
 		SigningInfos:    make(map[string]ValidatorSigningInfo),
 		MissedBlocks:    make(map[string][]MissedBlock),
 		SlashingPeriods: []ValidatorSlashingPeriod{},
+		RotatedConsKeys: []RotatedConsKey{},
 	}
 }
 
@@ -62,6 +66,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, sdata types.
 		keeper.SetValidatorSlashingPeriod(ctx, slashingPeriod)
 	}
 
+	for _, rotated := range data.RotatedConsKeys {
+		keeper.addPubkey(ctx, rotated.PubKey)
+		keeper.setConsAddrRotation(ctx, rotated.Rotation)
+	}
+
 	keeper.paramspace.SetParamSet(ctx, &data.Params)
 }
 
@@ -94,11 +103,22 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
+	rotatedConsKeys := []RotatedConsKey{}
+	keeper.iterateConsAddrRotations(ctx, func(rotation ConsAddrRotation) (stop bool) {
+		pubkey, err := keeper.getPubkey(ctx, rotation.OldConsAddr.Bytes())
+		if err != nil {
+			panic(err)
+		}
+		rotatedConsKeys = append(rotatedConsKeys, RotatedConsKey{Rotation: rotation, PubKey: pubkey})
+		return false
+	})
+
 	return GenesisState{
 		Params:          params,
 		SigningInfos:    signingInfos,
 		MissedBlocks:    missedBlocks,
 		SlashingPeriods: slashingPeriods,
+		RotatedConsKeys: rotatedConsKeys,
 	}
 }
 
@@ -107,5 +127,23 @@ func ValidateGenesis(data GenesisState) error {
 	if err != nil {
 		return err
 	}
+	return validateRotatedConsKeys(data.RotatedConsKeys)
+}
+
+func validateRotatedConsKeys(rotatedConsKeys []RotatedConsKey) error {
+	addrMap := make(map[string]bool, len(rotatedConsKeys))
+	for _, rotated := range rotatedConsKeys {
+		rotation := rotated.Rotation
+		if rotated.PubKey == nil || !sdk.GetConsAddress(rotated.PubKey).Equals(rotation.OldConsAddr) {
+			return fmt.Errorf("the pubkey of the rotated consensus address %s does not match", rotation.OldConsAddr)
+		}
+		if rotation.NewConsAddr.Empty() || rotation.NewConsAddr.Equals(rotation.OldConsAddr) {
+			return fmt.Errorf("invalid new consensus address %s of the rotated consensus address %s", rotation.NewConsAddr, rotation.OldConsAddr)
+		}
+		if addrMap[rotation.OldConsAddr.String()] {
+			return fmt.Errorf("duplicate rotated consensus address %s", rotation.OldConsAddr)
+		}
+		addrMap[rotation.OldConsAddr.String()] = true
+	}
 	return nil
 }
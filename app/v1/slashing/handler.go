@@ -30,6 +30,14 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		return ErrMissingSelfDelegation(k.codespace).Result()
 	}
 
+	// cannot be unjailed if the self-delegation is below the declared minimum
+	if validator.GetDelegatorShares().IsPositive() {
+		selfDelTokens := selfDel.GetShares().Mul(validator.GetTokens()).Quo(validator.GetDelegatorShares()).TruncateInt()
+		if selfDelTokens.LT(validator.GetMinSelfDelegation()) {
+			return ErrSelfDelegationTooLowToUnjail(k.codespace).Result()
+		}
+	}
+
 	if !validator.GetJailed() {
 		return ErrValidatorNotJailed(k.codespace).Result()
 	}
@@ -44,6 +44,11 @@ func (k Keeper) onValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	k.addPubkey(ctx, validator.GetConsPubKey())
 }
 
+// When a validator's consensus key is rotated, move its slashing records to the new address.
+func (k Keeper) onValidatorConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	k.rotateConsAddr(ctx, valAddr, oldConsAddr, newConsAddr)
+}
+
 // When a validator is removed, delete the address-pubkey relation.
 func (k Keeper) onValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
@@ -83,6 +88,11 @@ func (h Hooks) OnValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.onValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	h.k.onValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) OnValidatorPowerDidChange(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 }
@@ -41,7 +41,8 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	logger := ctx.Logger()
 	time := ctx.BlockHeader().Time
 	age := ctx.BlockHeight() - infractionHeight
-	consAddr := sdk.ConsAddress(addr)
+	// the infractions of a rotated consensus key are punished on the current one
+	consAddr := k.resolveConsAddr(ctx, sdk.ConsAddress(addr))
 
 	// To resolve https://github.com/irisnet/irishub/issues/1334
 	// Unbonding period is calculated by time however evidence age is calculated by height.
@@ -110,7 +111,8 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) (tags sdk.Tags) {
 	logger := ctx.Logger()
 	height := ctx.BlockHeight()
-	consAddr := sdk.ConsAddress(addr)
+	// a rotated consensus key keeps signing until the validator update is applied by Tendermint
+	consAddr := k.resolveConsAddr(ctx, sdk.ConsAddress(addr))
 	pubkey, err := k.getPubkey(ctx, addr)
 	if err != nil {
 		panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr))
@@ -183,7 +185,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 func (k Keeper) handleProposerCensorship(ctx sdk.Context, addr crypto.Address, infractionHeight int64) (tags sdk.Tags) {
 	logger := ctx.Logger()
 	time := ctx.BlockHeader().Time
-	consAddr := sdk.ConsAddress(addr)
+	consAddr := k.resolveConsAddr(ctx, sdk.ConsAddress(addr))
 	_, err := k.getPubkey(ctx, addr)
 	if err != nil {
 		panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr))
@@ -225,6 +227,22 @@ func (k Keeper) handleProposerCensorship(ctx sdk.Context, addr crypto.Address, i
 	return
 }
 
+// Punish a validator which has committed a seed to a random number request but not revealed it.
+// The validator is slashed by the downtime fraction but not jailed
+func (k Keeper) HandleMissingRandReveal(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64) (tags sdk.Tags) {
+	logger := ctx.Logger()
+
+	validator := k.validatorSet.Validator(ctx, valAddr)
+	if validator == nil || validator.GetStatus() == sdk.Unbonded {
+		return
+	}
+	logger.Info("The validator did not reveal the committed rand seed",
+		"validator", valAddr.String(), "infraction_height", infractionHeight)
+
+	distributionHeight := infractionHeight - stake.ValidatorUpdateDelay
+	return k.validatorSet.Slash(ctx, validator.GetConsAddr(), distributionHeight, validator.GetPower().RoundInt64(), k.SlashFractionDowntime(ctx))
+}
+
 func (k Keeper) addPubkey(ctx sdk.Context, pubkey crypto.PubKey) {
 	addr := pubkey.Address()
 	k.setAddrPubkeyRelation(ctx, addr, pubkey)
@@ -13,6 +13,7 @@ var (
 	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
+	ConsAddrRotationKey             = []byte{0x05} // Prefix for the rotated consensus addresses
 )
 
 // stored by *Tendermint* address (not operator address)
@@ -57,3 +58,8 @@ func GetValidatorSlashingPeriodKey(v sdk.ConsAddress, startHeight int64) []byte
 func getAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
 }
+
+// stored by the rotated *Tendermint* address
+func GetConsAddrRotationKey(v sdk.ConsAddress) []byte {
+	return append(ConsAddrRotationKey, v.Bytes()...)
+}
@@ -0,0 +1,147 @@
+package slashing
+
+import (
+	"fmt"
+
+	stake "github.com/irisnet/irishub/app/v1/stake/types"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// ConsAddrRotation maps a rotated consensus address of a validator to its current one,
+// the infractions of the rotated address stay punishable during the evidence window
+type ConsAddrRotation struct {
+	OldConsAddr sdk.ConsAddress `json:"old_cons_addr"`
+	NewConsAddr sdk.ConsAddress `json:"new_cons_addr"`
+	Height      int64           `json:"height"` // height at which the consensus key was rotated
+}
+
+func (r ConsAddrRotation) String() string {
+	return fmt.Sprintf("Consensus address %s rotated to %s at height %d", r.OldConsAddr, r.NewConsAddr, r.Height)
+}
+
+// RotatedConsKey is a rotated consensus key in the evidence window, exported with its pubkey
+// so that the evidences of the rotated address can still be verified after the import
+type RotatedConsKey struct {
+	Rotation ConsAddrRotation `json:"rotation"`
+	PubKey   crypto.PubKey    `json:"pubkey"`
+}
+
+// the last height of the evidence window of the rotated address, the rotated key keeps signing the
+// blocks until the validator update is applied by Tendermint
+func (k Keeper) getRotationExpiryHeight(ctx sdk.Context, rotation ConsAddrRotation) int64 {
+	return rotation.Height + stake.ValidatorUpdateDelay + 1 + k.MaxEvidenceAge(ctx)
+}
+
+func (k Keeper) getConsAddrRotation(ctx sdk.Context, address sdk.ConsAddress) (rotation ConsAddrRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetConsAddrRotationKey(address))
+	if bz == nil {
+		return rotation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rotation)
+	return rotation, true
+}
+
+func (k Keeper) setConsAddrRotation(ctx sdk.Context, rotation ConsAddrRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rotation)
+	store.Set(GetConsAddrRotationKey(rotation.OldConsAddr), bz)
+}
+
+// iterate over the rotated consensus addresses
+func (k Keeper) iterateConsAddrRotations(ctx sdk.Context, handler func(rotation ConsAddrRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, ConsAddrRotationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rotation ConsAddrRotation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rotation)
+		if handler(rotation) {
+			break
+		}
+	}
+}
+
+// the rotated address is only resolved while the stake module keeps it reserved for the validator,
+// so that a released address claimed by another validator is never resolved to the rotated one
+func (k Keeper) isRotatedConsAddrReserved(ctx sdk.Context, rotation ConsAddrRotation) bool {
+	validator := k.validatorSet.ValidatorByConsAddr(ctx, rotation.OldConsAddr)
+	return validator != nil && validator.GetConsAddr().Equals(rotation.NewConsAddr)
+}
+
+// the evidence window of the rotated address ends with the evidence age or with its reservation
+func (k Keeper) isConsAddrRotationExpired(ctx sdk.Context, rotation ConsAddrRotation) bool {
+	return ctx.BlockHeight() > k.getRotationExpiryHeight(ctx, rotation) || !k.isRotatedConsAddrReserved(ctx, rotation)
+}
+
+// get the current consensus address of a validator by any of its consensus addresses in the evidence window
+func (k Keeper) resolveConsAddr(ctx sdk.Context, address sdk.ConsAddress) sdk.ConsAddress {
+	rotation, found := k.getConsAddrRotation(ctx, address)
+	if !found || k.isConsAddrRotationExpired(ctx, rotation) {
+		return address
+	}
+	return rotation.NewConsAddr
+}
+
+// the signing info, the missed blocks and the slashing periods are moved to the new consensus address,
+// and the old one is resolved to it during the evidence window
+func (k Keeper) rotateConsAddr(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	validator := k.validatorSet.Validator(ctx, valAddr)
+	k.addPubkey(ctx, validator.GetConsPubKey())
+
+	if signInfo, found := k.getValidatorSigningInfo(ctx, oldConsAddr); found {
+		k.SetValidatorSigningInfo(ctx, newConsAddr, signInfo)
+		k.iterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) bool {
+			k.setValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+			return false
+		})
+	}
+
+	var slashingPeriods []ValidatorSlashingPeriod
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorSlashingPeriodPrefix(oldConsAddr))
+	for ; iter.Valid(); iter.Next() {
+		slashingPeriods = append(slashingPeriods, k.unmarshalSlashingPeriodKeyValue(iter.Key(), iter.Value()))
+	}
+	iter.Close()
+	for _, slashingPeriod := range slashingPeriods {
+		slashingPeriod.ValidatorAddr = newConsAddr
+		k.SetValidatorSlashingPeriod(ctx, slashingPeriod)
+	}
+
+	// the addresses rotated before are resolved to the new address as well,
+	// unless the new address is one of them which becomes current again
+	store.Delete(GetConsAddrRotationKey(newConsAddr))
+	var rotations []ConsAddrRotation
+	k.iterateConsAddrRotations(ctx, func(rotation ConsAddrRotation) bool {
+		if rotation.NewConsAddr.Equals(oldConsAddr) {
+			rotations = append(rotations, rotation)
+		}
+		return false
+	})
+	for _, rotation := range rotations {
+		rotation.NewConsAddr = newConsAddr
+		k.setConsAddrRotation(ctx, rotation)
+	}
+	k.setConsAddrRotation(ctx, ConsAddrRotation{OldConsAddr: oldConsAddr, NewConsAddr: newConsAddr, Height: ctx.BlockHeight()})
+}
+
+// remove the rotated consensus addresses whose evidence window has passed, the address-pubkey
+// relation is kept since the evidences are checked against it before their age
+func (k Keeper) pruneConsAddrRotations(ctx sdk.Context) {
+	var expired []ConsAddrRotation
+	k.iterateConsAddrRotations(ctx, func(rotation ConsAddrRotation) bool {
+		if k.isConsAddrRotationExpired(ctx, rotation) {
+			expired = append(expired, rotation)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, rotation := range expired {
+		store.Delete(GetConsAddrRotationKey(rotation.OldConsAddr))
+		store.Delete(GetValidatorSigningInfoKey(rotation.OldConsAddr))
+		k.clearValidatorMissedBlockBitArray(ctx, rotation.OldConsAddr)
+	}
+}
@@ -0,0 +1,125 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/stake"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that the infractions of a rotated consensus key are slashed during the evidence window
+func TestHandleDoubleSignRotatedConsPubKey(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	// validator added pre-genesis
+	ctx = ctx.WithBlockHeight(-1)
+	amtInt := sdk.NewIntWithDecimal(100, 18)
+	power := amtInt.Div(sdk.NewIntWithDecimal(1, 18)).Int64()
+	operatorAddr, oldPk, newPk := addrs[0], pks[0], pks[1]
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, oldPk, amtInt))
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+
+	// handle a signature to set signing info
+	keeper.handleValidatorSignature(ctx, oldPk.Address(), power, true)
+
+	// rotate the consensus pubkey at the end of the block
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgRotateConsPubKey(operatorAddr, newPk))
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+	require.Equal(t, newPk, sk.Validator(ctx, operatorAddr).GetConsPubKey())
+
+	// the signing info is moved to the new consensus address
+	signInfo, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.Equal(t, int64(-1), signInfo.StartHeight)
+
+	// double sign with the old consensus key
+	ctx = ctx.WithBlockHeight(1)
+	keeper.handleDoubleSign(ctx, oldPk.Address(), 0, power)
+	require.True(t, sk.Validator(ctx, operatorAddr).GetJailed())
+	signInfo, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.True(t, signInfo.JailedUntil.After(ctx.BlockHeader().Time))
+
+	// the old consensus address is no longer resolved past the evidence window
+	require.Equal(t, sdk.ConsAddress(newPk.Address()), keeper.resolveConsAddr(ctx, sdk.ConsAddress(oldPk.Address())))
+	rotation, found := keeper.getConsAddrRotation(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.True(t, found)
+	ctx = ctx.WithBlockHeight(keeper.getRotationExpiryHeight(ctx, rotation) + 1)
+	require.Equal(t, sdk.ConsAddress(oldPk.Address()), keeper.resolveConsAddr(ctx, sdk.ConsAddress(oldPk.Address())))
+	keeper.pruneConsAddrRotations(ctx)
+	_, found = keeper.getConsAddrRotation(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.False(t, found)
+	_, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.False(t, found)
+}
+
+// creates a validator and rotates its consensus pubkey
+func setupRotatedValidator(t *testing.T) (sdk.Context, stake.Keeper, Keeper) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	ctx = ctx.WithBlockHeight(-1)
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addrs[0], pks[0], sdk.NewIntWithDecimal(100, 18)))
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+	keeper.handleValidatorSignature(ctx, pks[0].Address(), 100, true)
+
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgRotateConsPubKey(addrs[0], pks[1]))
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+	return ctx, sk, keeper
+}
+
+// Test that the rotated consensus address is no longer resolved once the stake module releases it
+func TestResolveReleasedConsAddr(t *testing.T) {
+	ctx, sk, keeper := setupRotatedValidator(t)
+	oldConsAddr, newConsAddr := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	ctx = ctx.WithBlockHeight(1)
+	require.Equal(t, newConsAddr, keeper.resolveConsAddr(ctx, oldConsAddr))
+
+	// the address is released within the evidence window
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(sk.UnbondingTime(ctx)))
+	sk.ReleaseAllMatureRotatedConsAddrs(ctx)
+	rotation, found := keeper.getConsAddrRotation(ctx, oldConsAddr)
+	require.True(t, found)
+	require.True(t, ctx.BlockHeight() <= keeper.getRotationExpiryHeight(ctx, rotation))
+	require.Equal(t, oldConsAddr, keeper.resolveConsAddr(ctx, oldConsAddr))
+
+	keeper.pruneConsAddrRotations(ctx)
+	_, found = keeper.getConsAddrRotation(ctx, oldConsAddr)
+	require.False(t, found)
+}
+
+func TestExportGenesisRotatedConsKeys(t *testing.T) {
+	ctx, _, keeper := setupRotatedValidator(t)
+	oldConsAddr, newConsAddr := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+
+	genesisState := ExportGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(genesisState))
+	require.Len(t, genesisState.RotatedConsKeys, 1)
+	require.Equal(t, pks[0], genesisState.RotatedConsKeys[0].PubKey)
+	require.Equal(t, oldConsAddr, genesisState.RotatedConsKeys[0].Rotation.OldConsAddr)
+	require.Equal(t, newConsAddr, genesisState.RotatedConsKeys[0].Rotation.NewConsAddr)
+
+	// the rotation and the rotated pubkey are imported
+	ctx, _, _, _, keeper = createTestInput(t, keeperTestParams())
+	InitGenesis(ctx, keeper, genesisState, stake.DefaultGenesisState())
+	rotation, found := keeper.getConsAddrRotation(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, genesisState.RotatedConsKeys[0].Rotation, rotation)
+	pubkey, err := keeper.getPubkey(ctx, pks[0].Address())
+	require.Nil(t, err)
+	require.Equal(t, pks[0], pubkey)
+
+	// invalid rotated keys
+	rotated := genesisState.RotatedConsKeys[0]
+	genesisState.RotatedConsKeys = []RotatedConsKey{rotated, rotated}
+	require.NotNil(t, ValidateGenesis(genesisState))
+	genesisState.RotatedConsKeys = []RotatedConsKey{{Rotation: rotated.Rotation, PubKey: pks[1]}}
+	require.NotNil(t, ValidateGenesis(genesisState))
+	rotated.Rotation.NewConsAddr = oldConsAddr
+	genesisState.RotatedConsKeys = []RotatedConsKey{rotated}
+	require.NotNil(t, ValidateGenesis(genesisState))
+}
@@ -92,7 +92,7 @@ func createTestInput(t *testing.T, defaults Params) (sdk.Context, bank.Keeper, s
 	sk.SetHooks(keeper.Hooks())
 
 	require.NotPanics(t, func() {
-		InitGenesis(ctx, keeper, GenesisState{defaults, nil, nil, nil}, genesis)
+		InitGenesis(ctx, keeper, GenesisState{defaults, nil, nil, nil, nil}, genesis)
 	})
 
 	return ctx, ck, sk, paramstore, keeper
@@ -122,6 +122,8 @@ func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt
 		ValidatorAddr: address,
 		PubKey:        pubKey,
 		Delegation:    sdk.NewCoin(stakeTypes.StakeDenom, amt),
+
+		MinSelfDelegation: sdk.OneInt(),
 	}
 }
 
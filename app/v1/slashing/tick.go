@@ -36,6 +36,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) (tags
 		}
 	}
 
+	// Forget the rotated consensus keys whose infractions can no longer be punished
+	sk.pruneConsAddrRotations(ctx)
+
 	return
 }
 
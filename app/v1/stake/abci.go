@@ -20,7 +20,15 @@ func EndBlocker(ctx types.Context, k keeper.Keeper) (validatorUpdates []types2.V
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
+	//
+	// NOTE: ApplyConsPubKeyRotations has to come before ApplyAndReturnValidatorSetUpdates,
+	// the updates of the rotated validators are then rewritten to replace their old pubkeys.
+	rotations := k.ApplyConsPubKeyRotations(ctx)
 	validatorUpdates = k.ApplyAndReturnValidatorSetUpdates(ctx)
+	validatorUpdates = k.AppendConsPubKeyRotationUpdates(ctx, validatorUpdates, rotations)
+
+	// Release the consensus addresses rotated out for the unbonding period.
+	k.ReleaseAllMatureRotatedConsAddrs(ctx)
 
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
@@ -70,6 +70,25 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 		keeper.InsertRedelegationQueue(ctx, red)
 	}
 
+	// the share token supplies are restored from the accounts
+	for _, shareToken := range data.ShareTokens {
+		keeper.SetShareToken(ctx, shareToken)
+	}
+	keeper.SetLastShareTokenID(ctx, data.LastShareTokenID)
+
+	// the consensus addresses of the pending and past rotations stay reserved for their validators
+	for _, rotation := range data.ConsPubKeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+		keeper.ReserveConsAddr(ctx, sdk.GetConsAddress(rotation.NewConsPubKey), rotation.ValidatorAddr)
+	}
+	for _, rotationTime := range data.LastConsPubKeyRotationTimes {
+		keeper.SetLastConsPubKeyRotationTime(ctx, rotationTime.ValidatorAddr, rotationTime.Time)
+	}
+	for _, rotated := range data.RotatedConsAddrs {
+		keeper.InsertRotatedConsAddrQueue(ctx, rotated.ReleaseTime, rotated.ConsAddr)
+		keeper.ReserveConsAddr(ctx, rotated.ConsAddr, rotated.ValidatorAddr)
+	}
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -109,6 +128,9 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return false
 	})
 
+	shareTokens := keeper.GetAllShareTokens(ctx)
+	lastShareTokenID := keeper.GetLastShareTokenID(ctx)
+
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power sdk.Int) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.LastValidatorPower{addr, power})
@@ -116,15 +138,20 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		BondedPool:           pool,
-		Params:               params,
-		LastTotalPower:       lastTotalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           validators,
-		Bonds:                bonds,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		BondedPool:                  pool,
+		Params:                      params,
+		LastTotalPower:              lastTotalPower,
+		LastValidatorPowers:         lastValidatorPowers,
+		Validators:                  validators,
+		Bonds:                       bonds,
+		UnbondingDelegations:        unbondingDelegations,
+		Redelegations:               redelegations,
+		ShareTokens:                 shareTokens,
+		LastShareTokenID:            lastShareTokenID,
+		Exported:                    true,
+		ConsPubKeyRotations:         keeper.GetAllConsPubKeyRotations(ctx),
+		LastConsPubKeyRotationTimes: keeper.GetAllLastConsPubKeyRotationTimes(ctx),
+		RotatedConsAddrs:            keeper.GetAllRotatedConsAddrs(ctx),
 	}
 }
 
@@ -154,6 +181,14 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateShareTokens(data.ShareTokens, data.LastShareTokenID)
+	if err != nil {
+		return err
+	}
+	err = validateGenesisStateConsPubKeyRotations(data)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -176,3 +211,88 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	}
 	return
 }
+
+func validateGenesisStateShareTokens(shareTokens []types.ShareToken, lastShareTokenID uint64) (err error) {
+	if lastShareTokenID > types.MaxShareTokenID {
+		return fmt.Errorf("last share token id %d exceeds the maximum %d", lastShareTokenID, types.MaxShareTokenID)
+	}
+	valMap := make(map[string]bool, len(shareTokens))
+	denomMap := make(map[string]bool, len(shareTokens))
+	for _, shareToken := range shareTokens {
+		if !types.IsShareTokenDenom(shareToken.Denom) {
+			return fmt.Errorf("invalid share token denom in genesis state: %s", shareToken.Denom)
+		}
+		if valMap[shareToken.ValidatorAddr.String()] {
+			return fmt.Errorf("duplicate share token in genesis state for validator %s", shareToken.ValidatorAddr)
+		}
+		if denomMap[shareToken.Denom] {
+			return fmt.Errorf("duplicate share token in genesis state: %s", shareToken.Denom)
+		}
+		valMap[shareToken.ValidatorAddr.String()] = true
+		denomMap[shareToken.Denom] = true
+	}
+	if uint64(len(shareTokens)) > lastShareTokenID {
+		return fmt.Errorf("last share token id %d is lower than the number of share tokens %d", lastShareTokenID, len(shareTokens))
+	}
+	return
+}
+
+func validateGenesisStateConsPubKeyRotations(data types.GenesisState) (err error) {
+	valMap := make(map[string]types.Validator, len(data.Validators))
+	consAddrMap := make(map[string]bool, len(data.Validators))
+	for _, val := range data.Validators {
+		valMap[val.OperatorAddr.String()] = val
+		consAddrMap[val.ConsAddress().String()] = true
+	}
+
+	rotationMap := make(map[string]bool, len(data.ConsPubKeyRotations))
+	for _, rotation := range data.ConsPubKeyRotations {
+		val, found := valMap[rotation.ValidatorAddr.String()]
+		if !found {
+			return fmt.Errorf("consensus pubkey rotation in genesis state for unknown validator %s", rotation.ValidatorAddr)
+		}
+		if rotationMap[rotation.ValidatorAddr.String()] {
+			return fmt.Errorf("duplicate consensus pubkey rotation in genesis state for validator %s", rotation.ValidatorAddr)
+		}
+		if rotation.OldConsPubKey == nil || !rotation.OldConsPubKey.Equals(val.ConsPubKey) {
+			return fmt.Errorf("consensus pubkey rotation in genesis state does not match the pubkey of validator %s", rotation.ValidatorAddr)
+		}
+		if rotation.NewConsPubKey == nil {
+			return fmt.Errorf("consensus pubkey rotation in genesis state has no new pubkey for validator %s", rotation.ValidatorAddr)
+		}
+		newConsAddr := sdk.GetConsAddress(rotation.NewConsPubKey).String()
+		if consAddrMap[newConsAddr] {
+			return fmt.Errorf("consensus pubkey rotation in genesis state to a used consensus address %s", newConsAddr)
+		}
+		rotationMap[rotation.ValidatorAddr.String()] = true
+		consAddrMap[newConsAddr] = true
+	}
+
+	timeMap := make(map[string]bool, len(data.LastConsPubKeyRotationTimes))
+	for _, rotationTime := range data.LastConsPubKeyRotationTimes {
+		if _, found := valMap[rotationTime.ValidatorAddr.String()]; !found {
+			return fmt.Errorf("last consensus pubkey rotation time in genesis state for unknown validator %s", rotationTime.ValidatorAddr)
+		}
+		if timeMap[rotationTime.ValidatorAddr.String()] {
+			return fmt.Errorf("duplicate last consensus pubkey rotation time in genesis state for validator %s", rotationTime.ValidatorAddr)
+		}
+		timeMap[rotationTime.ValidatorAddr.String()] = true
+	}
+
+	rotatedMap := make(map[string]bool, len(data.RotatedConsAddrs))
+	for _, rotated := range data.RotatedConsAddrs {
+		val, found := valMap[rotated.ValidatorAddr.String()]
+		if !found {
+			return fmt.Errorf("rotated consensus address %s in genesis state for unknown validator %s", rotated.ConsAddr, rotated.ValidatorAddr)
+		}
+		if rotatedMap[rotated.ConsAddr.String()] {
+			return fmt.Errorf("duplicate rotated consensus address in genesis state: %s", rotated.ConsAddr)
+		}
+		// the validator may have rotated back to the address
+		if consAddrMap[rotated.ConsAddr.String()] && !val.ConsAddress().Equals(rotated.ConsAddr) {
+			return fmt.Errorf("rotated consensus address %s in genesis state is used by another validator", rotated.ConsAddr)
+		}
+		rotatedMap[rotated.ConsAddr.String()] = true
+	}
+	return
+}
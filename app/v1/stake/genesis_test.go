@@ -3,6 +3,7 @@ package stake
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -111,6 +112,7 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0] = types.NewValidator(sdk.ValAddress(pk.Address()), pk, types.NewDescription("", "", "", ""))
 	genValidators1[0].Tokens = sdk.OneDec()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
+	genValidator := genValidators1[0]
 
 	tests := []struct {
 		name    string
@@ -132,6 +134,37 @@ func TestValidateGenesis(t *testing.T) {
 			(*data).Validators[0].Jailed = true
 			(*data).Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate genesis consensus pubkey rotations
+		{"rotation of unknown validator", func(data *types.GenesisState) {
+			(*data).ConsPubKeyRotations = []types.ConsPubKeyRotation{{ValidatorAddr: genValidator.OperatorAddr, OldConsPubKey: pk, NewConsPubKey: keep.PKs[0]}}
+		}, true},
+		{"rotation from another pubkey", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{genValidator}
+			(*data).ConsPubKeyRotations = []types.ConsPubKeyRotation{{ValidatorAddr: genValidator.OperatorAddr, OldConsPubKey: keep.PKs[1], NewConsPubKey: keep.PKs[0]}}
+		}, true},
+		{"rotation to a used pubkey", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{genValidator}
+			(*data).ConsPubKeyRotations = []types.ConsPubKeyRotation{{ValidatorAddr: genValidator.OperatorAddr, OldConsPubKey: pk, NewConsPubKey: pk}}
+		}, true},
+		{"duplicate last rotation time", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{genValidator}
+			rotationTime := types.LastConsPubKeyRotationTime{ValidatorAddr: genValidator.OperatorAddr}
+			(*data).LastConsPubKeyRotationTimes = []types.LastConsPubKeyRotationTime{rotationTime, rotationTime}
+		}, true},
+		{"rotated address of unknown validator", func(data *types.GenesisState) {
+			(*data).RotatedConsAddrs = []types.RotatedConsAddr{{ConsAddr: sdk.GetConsAddress(keep.PKs[0]), ValidatorAddr: genValidator.OperatorAddr}}
+		}, true},
+		{"rotated address used by another validator", func(data *types.GenesisState) {
+			other := genValidator
+			other.OperatorAddr = sdk.ValAddress(keep.Addrs[0])
+			other.ConsPubKey = keep.PKs[0]
+			(*data).Validators = []types.Validator{genValidator, other}
+			(*data).RotatedConsAddrs = []types.RotatedConsAddr{{ConsAddr: sdk.GetConsAddress(keep.PKs[0]), ValidatorAddr: genValidator.OperatorAddr}}
+		}, true},
+		{"rotated address", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{genValidator}
+			(*data).RotatedConsAddrs = []types.RotatedConsAddr{{ConsAddr: sdk.GetConsAddress(keep.PKs[0]), ValidatorAddr: genValidator.OperatorAddr}}
+		}, false},
 	}
 
 	for _, tt := range tests {
@@ -146,3 +179,60 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestExportGenesisConsPubKeyRotations(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, sdk.NewIntWithDecimal(1000, 18))
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+
+	pool := keeper.GetPool(ctx)
+	pool.BondedPool.BondedTokens = sdk.NewDecFromInt(sdk.NewIntWithDecimal(2, 18))
+	validators := make([]Validator, 2)
+	for i := range validators {
+		validators[i] = types.NewValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], Description{})
+		validators[i].Status = sdk.Bonded
+		validators[i].Tokens = sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18))
+		validators[i].DelegatorShares = sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18))
+	}
+	_, err := InitGenesis(ctx, keeper, types.NewGenesisState(pool.BondedPool, keeper.GetParams(ctx), validators, nil))
+	require.NoError(t, err)
+
+	// the first validator has rotated its pubkey and the rotation of the second one is pending
+	_, sdkErr := keeper.RotateConsPubKey(ctx, validators[0].OperatorAddr, keep.PKs[2])
+	require.Nil(t, sdkErr)
+	keeper.ApplyConsPubKeyRotations(ctx)
+	_, sdkErr = keeper.RotateConsPubKey(ctx, validators[1].OperatorAddr, keep.PKs[3])
+	require.Nil(t, sdkErr)
+
+	genesisState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genesisState))
+	require.Len(t, genesisState.ConsPubKeyRotations, 1)
+	require.Len(t, genesisState.LastConsPubKeyRotationTimes, 2)
+	releaseTime := ctx.BlockHeader().Time.Add(keeper.UnbondingTime(ctx))
+	require.Equal(t, []types.RotatedConsAddr{{
+		ConsAddr:      sdk.GetConsAddress(keep.PKs[0]),
+		ValidatorAddr: validators[0].OperatorAddr,
+		ReleaseTime:   releaseTime,
+	}}, genesisState.RotatedConsAddrs)
+
+	// the rotation state is imported with the reserved consensus addresses
+	ctx, _, keeper = keep.CreateTestInput(t, false, sdk.NewIntWithDecimal(1000, 18))
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+	_, err = InitGenesis(ctx, keeper, genesisState)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ConsPubKeyRotations, keeper.GetAllConsPubKeyRotations(ctx))
+	require.Equal(t, genesisState.LastConsPubKeyRotationTimes, keeper.GetAllLastConsPubKeyRotationTimes(ctx))
+	require.Equal(t, genesisState.RotatedConsAddrs, keeper.GetAllRotatedConsAddrs(ctx))
+	for _, pk := range keep.PKs[:4] {
+		_, found := keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk))
+		require.True(t, found)
+	}
+
+	// the rotations are still rate limited
+	_, sdkErr = keeper.RotateConsPubKey(ctx, validators[0].OperatorAddr, keep.PKs[4])
+	require.NotNil(t, sdkErr)
+
+	// the rotated address is released after the unbonding time
+	keeper.ReleaseAllMatureRotatedConsAddrs(ctx.WithBlockTime(releaseTime))
+	_, found := keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[0]))
+	require.False(t, found)
+}
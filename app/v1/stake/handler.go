@@ -19,12 +19,20 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateValidator(ctx, msg, k)
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
+		case types.MsgRotateConsPubKey:
+			return handleMsgRotateConsPubKey(ctx, msg, k)
 		case types.MsgDelegate:
 			return handleMsgDelegate(ctx, msg, k)
 		case types.MsgBeginRedelegate:
 			return handleMsgBeginRedelegate(ctx, msg, k)
 		case types.MsgBeginUnbonding:
 			return handleMsgBeginUnbonding(ctx, msg, k)
+		case types.MsgCancelUnbondingDelegation:
+			return handleMsgCancelUnbondingDelegation(ctx, msg, k)
+		case types.MsgTokenizeShares:
+			return handleMsgTokenizeShares(ctx, msg, k)
+		case types.MsgRedeemShareTokens:
+			return handleMsgRedeemShareTokens(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -61,6 +69,7 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	}
 
 	validator := NewValidator(msg.ValidatorAddr, msg.PubKey, msg.Description)
+	validator.MinSelfDelegation = msg.MinSelfDelegation
 	commission := NewCommissionWithTime(
 		msg.Commission.Rate, sdk.NewDec(1),
 		sdk.NewDec(1), ctx.BlockHeader().Time,
@@ -137,6 +146,34 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 }
 
+func handleMsgRotateConsPubKey(ctx sdk.Context, msg types.MsgRotateConsPubKey, k keeper.Keeper) sdk.Result {
+	if ctx.ConsensusParams() != nil {
+		tmPubKey := tmtypes.TM2PB.PubKey(msg.PubKey)
+		if !common.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return ErrValidatorPubKeyTypeUnsupported(k.Codespace(), tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes).Result()
+		}
+	}
+
+	// the rotation is applied at the end of the block
+	rotation, err := k.RotateConsPubKey(ctx, msg.ValidatorAddr, msg.PubKey)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.Logger().Info("Rotate validator consensus pubkey", "operator_address", msg.ValidatorAddr.String(),
+		"old_consensus_address", sdk.GetConsAddress(rotation.OldConsPubKey).String(),
+		"new_consensus_address", sdk.GetConsAddress(rotation.NewConsPubKey).String())
+
+	tags := sdk.NewTags(
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+		tags.ConsPubKey, []byte(sdk.MustBech32ifyConsPub(msg.PubKey)),
+	)
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
 func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
 	validator, found := k.GetValidator(ctx, msg.ValidatorAddr)
 	if !found {
@@ -152,6 +189,10 @@ func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper)
 		return ErrValidatorJailed(k.Codespace()).Result()
 	}
 
+	if err := k.CheckValidatorBondedRatio(ctx, validator, sdk.NewDecFromInt(msg.Delegation.Amount), sdk.ZeroDec()); err != nil {
+		return err.Result()
+	}
+
 	_, err := k.Delegate(ctx, msg.DelegatorAddr, msg.Delegation, validator, true)
 	if err != nil {
 		return err.Result()
@@ -184,7 +225,47 @@ func handleMsgBeginUnbonding(ctx sdk.Context, msg types.MsgBeginUnbonding, k kee
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
 
+func handleMsgCancelUnbondingDelegation(ctx sdk.Context, msg types.MsgCancelUnbondingDelegation, k keeper.Keeper) sdk.Result {
+	if msg.Amount.Denom != k.BondDenom() {
+		return ErrBadDenom(k.Codespace()).Result()
+	}
+
+	// the missing validator is reported by CancelUnbondingDelegation
+	if validator, found := k.GetValidator(ctx, msg.ValidatorAddr); found {
+		if err := k.CheckValidatorBondedRatio(ctx, validator, sdk.NewDecFromInt(msg.Amount.Amount), sdk.ZeroDec()); err != nil {
+			return err.Result()
+		}
+	}
+
+	ubd, shares, err := k.CancelUnbondingDelegation(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.Amount.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+		tags.Balance, []byte(ubd.Balance.String()),
+		tags.SharesDst, []byte(shares.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
+
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) sdk.Result {
+	// the missing validators are reported by BeginRedelegation
+	srcValidator, srcFound := k.GetValidator(ctx, msg.ValidatorSrcAddr)
+	dstValidator, dstFound := k.GetValidator(ctx, msg.ValidatorDstAddr)
+	if srcFound && dstFound {
+		tokens := srcValidator.DelegatorShareExRate().Mul(msg.SharesAmount)
+		leavingBondedTokens := sdk.ZeroDec()
+		if srcValidator.Status == sdk.Bonded {
+			leavingBondedTokens = tokens
+		}
+		if err := k.CheckValidatorBondedRatio(ctx, dstValidator, tokens, leavingBondedTokens); err != nil {
+			return err.Result()
+		}
+	}
+
 	red, err := k.BeginRedelegation(ctx, msg.DelegatorAddr, msg.ValidatorSrcAddr,
 		msg.ValidatorDstAddr, msg.SharesAmount)
 	if err != nil {
@@ -204,3 +285,33 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 	)
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
+
+func handleMsgTokenizeShares(ctx sdk.Context, msg types.MsgTokenizeShares, k keeper.Keeper) sdk.Result {
+	shareTokens, err := k.TokenizeShares(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.SharesAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.SrcValidator, []byte(msg.ValidatorAddr.String()),
+		tags.Shares, []byte(msg.SharesAmount.String()),
+		tags.ShareTokens, []byte(shareTokens.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
+
+func handleMsgRedeemShareTokens(ctx sdk.Context, msg types.MsgRedeemShareTokens, k keeper.Keeper) sdk.Result {
+	valAddr, shares, err := k.RedeemShareTokens(ctx, msg.DelegatorAddr, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstValidator, []byte(valAddr.String()),
+		tags.Shares, []byte(shares.String()),
+		tags.ShareTokens, []byte(msg.Amount.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 // AllInvariants runs all invariants of the stake module.
-// Currently: total supply, positive power
+// Currently: total supply, positive power, tokenized shares
 func AllInvariants(ck bank.Keeper, k Keeper,
 	f auth.FeeKeeper, d distribution.Keeper,
 	am auth.AccountKeeper) sdk.Invariant {
@@ -40,6 +40,11 @@ func AllInvariants(ck bank.Keeper, k Keeper,
 			return err
 		}
 
+		err = TokenizedSharesInvariant(ck, k, am)(ctx)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	}
 }
@@ -242,3 +247,61 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return nil
 	}
 }
+
+// TokenizedSharesInvariant checks that the supply of each share token is held by the accounts,
+// and that the share tokens in circulation are backed by the delegation of the share token pool
+func TokenizedSharesInvariant(ck bank.Keeper, k Keeper, am auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+
+		defer func() {
+			if r := recover(); r != nil {
+				switch rType := r.(type) {
+				case error:
+					err = rType
+				default:
+					err = fmt.Errorf(string(debug.Stack()))
+				}
+			}
+		}()
+
+		shareTokens := k.GetAllShareTokens(ctx)
+		held := make(map[string]sdk.Int, len(shareTokens))
+		for _, shareToken := range shareTokens {
+			held[shareToken.Denom] = sdk.ZeroInt()
+		}
+		// the burned share tokens are still in the supply, only the redeemed ones are removed
+		am.IterateAccounts(ctx, func(acc auth.Account) bool {
+			for _, coin := range acc.GetCoins() {
+				if amount, ok := held[coin.Denom]; ok {
+					held[coin.Denom] = amount.Add(coin.Amount)
+				}
+			}
+			return false
+		})
+
+		for _, shareToken := range shareTokens {
+			byDenom, found := k.GetShareTokenByDenom(ctx, shareToken.Denom)
+			if !found || !bytes.Equal(byDenom.ValidatorAddr, shareToken.ValidatorAddr) {
+				return fmt.Errorf("share token %s is not indexed by its denom", shareToken.Denom)
+			}
+
+			supply := sdk.ZeroInt()
+			if totalSupply, found := ck.GetTotalSupply(ctx, shareToken.Denom); found {
+				supply = totalSupply.Amount
+			}
+			if !supply.Equal(held[shareToken.Denom]) {
+				return fmt.Errorf("share token supply invariance:\n"+
+					"\tsupply of %s: %v\n"+
+					"\tsum of account share tokens: %v", shareToken.Denom, supply, held[shareToken.Denom])
+			}
+
+			_, found = k.GetDelegation(ctx, shareToken.PoolAddress(), shareToken.ValidatorAddr)
+			if supply.IsPositive() != found {
+				return fmt.Errorf("share token backing invariance:\n"+
+					"\tsupply of %s: %v\n"+
+					"\tshare token pool delegation found: %v", shareToken.Denom, supply, found)
+			}
+		}
+		return nil
+	}
+}
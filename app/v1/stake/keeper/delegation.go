@@ -202,6 +202,22 @@ func (k Keeper) InsertUnbondingQueue(ctx sdk.Context, ubd types.UnbondingDelegat
 	}
 }
 
+// Remove an unbonding delegation from its timeslice in the unbonding queue
+func (k Keeper) RemoveUnbondingQueue(ctx sdk.Context, ubd types.UnbondingDelegation) {
+	timeSlice := k.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime)
+	var remaining []types.DVPair
+	for _, dvPair := range timeSlice {
+		if !dvPair.DelegatorAddr.Equals(ubd.DelegatorAddr) || !dvPair.ValidatorAddr.Equals(ubd.ValidatorAddr) {
+			remaining = append(remaining, dvPair)
+		}
+	}
+	if len(remaining) == 0 {
+		ctx.KVStore(k.storeKey).Delete(GetUnbondingDelegationTimeKey(ubd.MinTime))
+	} else {
+		k.SetUnbondingQueueTimeSlice(ctx, ubd.MinTime, remaining)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UnbondingQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -409,7 +425,7 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Co
 	if subtractAccount {
 		// Account new shares, save
 		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, delAddr.String(), validator.OperatorAddr.String(), bondAmt.String(), sdk.DelegationFlow, "")
-		_, _, err = k.bankKeeper.SubtractCoins(ctx, delegation.DelegatorAddr, sdk.Coins{bondAmt})
+		_, err = k.bankKeeper.DelegateCoins(ctx, delegation.DelegatorAddr, sdk.Coins{bondAmt})
 		if err != nil {
 			return
 		}
@@ -427,6 +443,40 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Co
 	return newShares, nil
 }
 
+// jail the validator if the remaining self-delegation of its operator is empty or below its minimum self-delegation
+func (k Keeper) jailIfSelfDelegationTooLow(ctx sdk.Context, validator types.Validator, delegation types.Delegation) types.Validator {
+	if validator.Jailed || !bytes.Equal(delegation.DelegatorAddr, validator.OperatorAddr) {
+		return validator
+	}
+
+	selfDelegation := delegation.Shares.Mul(validator.DelegatorShareExRate()).TruncateInt()
+	if delegation.Shares.IsZero() || selfDelegation.LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+		return k.mustGetValidator(ctx, validator.OperatorAddr)
+	}
+	return validator
+}
+
+// CheckValidatorBondedRatio checks that the validator does not exceed the maximum share of the bonded tokens
+// once the tokens are delegated to it, an unbonded validator is assumed to get bonded. leavingBondedTokens are
+// the bonded tokens moving away from another validator, as in a redelegation.
+func (k Keeper) CheckValidatorBondedRatio(ctx sdk.Context, validator types.Validator, tokens, leavingBondedTokens sdk.Dec) sdk.Error {
+	maxRatio := k.MaxValidatorBondedRatio(ctx)
+	if maxRatio.GTE(sdk.OneDec()) {
+		return nil
+	}
+
+	valTokens := validator.Tokens.Add(tokens)
+	bondedTokens := k.GetPool(ctx).BondedPool.BondedTokens.Sub(validator.BondedTokens()).Sub(leavingBondedTokens).Add(valTokens)
+	if !bondedTokens.IsPositive() {
+		return nil
+	}
+	if ratio := valTokens.Quo(bondedTokens); ratio.GT(maxRatio) {
+		return types.ErrValidatorBondedRatioExceeded(k.Codespace(), ratio, maxRatio)
+	}
+	return nil
+}
+
 // unbond the the delegation return
 func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
 	shares sdk.Dec) (amount sdk.Dec, err sdk.Error) {
@@ -456,16 +506,12 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	// subtract shares from delegator
 	delegation.Shares = delegation.Shares.Sub(shares)
 
+	// if the delegation is the operator of the validator then
+	// trigger a jail validator when its self-delegation is too low
+	validator = k.jailIfSelfDelegationTooLow(ctx, validator, delegation)
+
 	// remove the delegation
 	if delegation.Shares.IsZero() {
-
-		// if the delegation is the operator of the validator then
-		// trigger a jail validator
-		if bytes.Equal(delegation.DelegatorAddr, validator.OperatorAddr) && !validator.Jailed {
-			k.jailValidator(ctx, validator)
-			validator = k.mustGetValidator(ctx, validator.OperatorAddr)
-		}
-
 		k.RemoveDelegation(ctx, delegation)
 	} else {
 		// Update height
@@ -530,7 +576,7 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	if !ubd.Balance.IsZero() {
 		ctx.CoinFlowTags().AppendCoinFlowTag(ctx, valAddr.String(), ubd.DelegatorAddr.String(), ubd.Balance.String(), sdk.UndelegationFlow, ubd.TxHash)
 	}
-	_, _, err := k.bankKeeper.AddCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
+	_, err := k.bankKeeper.UndelegateCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
 	if err != nil {
 		return err
 	}
@@ -540,6 +586,53 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return nil
 }
 
+// cancel part or all of an unbonding delegation before its completion time,
+// the cancelled tokens are delegated back to the validator they were unbonded from
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, amount sdk.Int) (types.UnbondingDelegation, sdk.Dec, sdk.Error) {
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return ubd, sdk.ZeroDec(), types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+	if !ctx.BlockHeader().Time.Before(ubd.MinTime) {
+		return ubd, sdk.ZeroDec(), types.ErrUnbondingDelegationMature(k.Codespace())
+	}
+
+	// the balance is already reduced by the slashes occurred during the unbonding
+	if amount.GT(ubd.Balance.Amount) {
+		return ubd, sdk.ZeroDec(), types.ErrCancelUnbondingExceedsBalance(k.Codespace(), ubd.Balance)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return ubd, sdk.ZeroDec(), types.ErrNoValidatorFound(k.Codespace())
+	}
+	if validator.Jailed {
+		return ubd, sdk.ZeroDec(), types.ErrValidatorJailed(k.Codespace())
+	}
+
+	// the unbonding tokens are still held by the stake module, the account is not debited
+	newShares, err := k.Delegate(ctx, delAddr, sdk.NewCoin(k.BondDenom(), amount), validator, false)
+	if err != nil {
+		return ubd, sdk.ZeroDec(), err
+	}
+
+	// the initial balance is reduced too, so that later slashes apply only to the remaining tokens
+	ubd.Balance.Amount = ubd.Balance.Amount.Sub(amount)
+	ubd.InitialBalance.Amount = ubd.InitialBalance.Amount.Sub(amount)
+
+	if ubd.Balance.IsZero() {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+		k.RemoveUnbondingQueue(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+	ctx.Logger().Info("Cancel unbonding", "amount", amount.String(),
+		"validator_address", valAddr.String(), "delegator_address", delAddr.String())
+	return ubd, newShares, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (types.Redelegation, sdk.Error) {
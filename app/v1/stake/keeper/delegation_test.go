@@ -830,3 +830,148 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found, "%v", red)
 }
+
+func TestUndelegateBelowMinSelfDelegation(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, sdk.ZeroInt())
+	pool := keeper.GetPool(ctx)
+	pool.BankKeeper.IncreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, sdk.NewIntWithDecimal(20, 18))})
+
+	//create a validator with a self-delegation and a minimum self-delegation
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator.MinSelfDelegation = sdk.NewIntWithDecimal(5, 18)
+	validator, pool, issuedShares := validator.AddTokensFromDel(ctx, pool, sdk.NewIntWithDecimal(10, 18))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	selfDelegation := types.Delegation{
+		DelegatorAddr: sdk.AccAddress(addrVals[0].Bytes()),
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	}
+	keeper.SetDelegation(ctx, selfDelegation)
+
+	// the validator is not jailed while the self-delegation reaches the minimum
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	_, err := keeper.unbond(ctx, val0AccAddr, addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(5, 18)))
+	require.NoError(t, err)
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.False(t, validator.Jailed)
+
+	// the validator is jailed once the self-delegation drops below the minimum
+	_, err = keeper.unbond(ctx, val0AccAddr, addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18)))
+	require.NoError(t, err)
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+}
+
+func TestCheckValidatorBondedRatio(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, sdk.ZeroInt())
+	pool := keeper.GetPool(ctx)
+	pool.BankKeeper.IncreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, sdk.NewIntWithDecimal(40, 18))})
+
+	// create two bonded validators with the same tokens
+	for i := 0; i < 2; i++ {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, pool, _ = validator.AddTokensFromDel(ctx, pool, sdk.NewIntWithDecimal(10, 18))
+		keeper.SetPool(ctx, pool)
+		TestingUpdateValidator(keeper, ctx, validator, true)
+		pool = keeper.GetPool(ctx)
+	}
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	tokens := sdk.NewDecFromInt(sdk.NewIntWithDecimal(10, 18))
+
+	// no limit by default
+	require.Nil(t, keeper.CheckValidatorBondedRatio(ctx, validator, tokens, sdk.ZeroDec()))
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidatorBondedRatio = sdk.NewDecWithPrec(6, 1)
+	keeper.SetParams(ctx, params)
+
+	// 20 of 30 bonded tokens exceed the cap, 15 of 25 do not
+	require.NotNil(t, keeper.CheckValidatorBondedRatio(ctx, validator, tokens, sdk.ZeroDec()))
+	require.Nil(t, keeper.CheckValidatorBondedRatio(ctx, validator, tokens.QuoInt(sdk.NewInt(2)), sdk.ZeroDec()))
+
+	// the bonded tokens redelegated from the other validator leave its bonded tokens, 20 of 20 exceed the cap
+	require.NotNil(t, keeper.CheckValidatorBondedRatio(ctx, validator, tokens, tokens))
+}
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, sdk.ZeroInt())
+	pool := keeper.GetPool(ctx)
+	pool.BankKeeper.IncreaseLoosenToken(ctx, sdk.Coins{sdk.NewCoin(types.StakeDenom, sdk.NewIntWithDecimal(20, 18))})
+
+	//create a validator with a self-delegation and a second delegation
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(ctx, pool, sdk.NewIntWithDecimal(20, 18))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: sdk.AccAddress(addrVals[0].Bytes()),
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares.QuoInt(sdk.NewInt(2)),
+	})
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares.QuoInt(sdk.NewInt(2)),
+	})
+
+	header := ctx.BlockHeader()
+	header.Height = 10
+	header.Time = time.Unix(333, 0)
+	ctx = ctx.WithBlockHeader(header)
+
+	// no unbonding delegation to cancel
+	_, _, err := keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(1, 18))
+	require.Error(t, err)
+
+	_, err = keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(6, 18)))
+	require.NoError(t, err)
+
+	// the cancelled amount can not exceed the balance
+	_, _, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(7, 18))
+	require.Error(t, err)
+
+	// cancel part of the unbonding delegation
+	ubd, _, err := keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(2, 18))
+	require.NoError(t, err)
+	require.True(sdk.IntEq(t, sdk.NewIntWithDecimal(4, 18), ubd.Balance.Amount))
+	require.True(sdk.IntEq(t, sdk.NewIntWithDecimal(4, 18), ubd.InitialBalance.Amount))
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(6, 18)), delegation.Shares)
+	require.Equal(t, 1, len(keeper.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime)))
+
+	// the unbonding delegation is slashed by half, only its remaining balance can be cancelled
+	keeper.slashUnbondingDelegation(ctx, ubd, 0, sdk.NewDecWithPrec(5, 1))
+	_, _, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(4, 18))
+	require.Error(t, err)
+
+	// the mature unbonding delegation can not be cancelled
+	header.Time = ubd.MinTime
+	matureCtx := ctx.WithBlockHeader(header)
+	_, _, err = keeper.CancelUnbondingDelegation(matureCtx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(1, 18))
+	require.Error(t, err)
+
+	// the tokens can not be delegated back to a jailed validator
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	validator.Jailed = true
+	keeper.SetValidator(ctx, validator)
+	_, _, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(1, 18))
+	require.Error(t, err)
+	validator.Jailed = false
+	keeper.SetValidator(ctx, validator)
+
+	// cancelling the whole balance removes the unbonding delegation from the store and the queue
+	_, _, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], sdk.NewIntWithDecimal(2, 18))
+	require.NoError(t, err)
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Equal(t, 0, len(keeper.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime)))
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(8, 18)), delegation.Shares)
+}
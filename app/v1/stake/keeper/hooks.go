@@ -58,3 +58,9 @@ func (k Keeper) OnDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, val
 		k.hooks.OnDelegationRemoved(ctx, delAddr, valAddr)
 	}
 }
+
+func (k Keeper) OnValidatorConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	if k.hooks != nil {
+		k.hooks.OnValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+	}
+}
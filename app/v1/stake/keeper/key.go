@@ -32,9 +32,17 @@ var (
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
 
-	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
-	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
-	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	UnbondingQueueKey       = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey    = []byte{0x42} // prefix for the timestamps in redelegations queue
+	ValidatorQueueKey       = []byte{0x43} // prefix for the timestamps in validator queue
+	RotatedConsAddrQueueKey = []byte{0x44} // prefix for the timestamps in rotated consensus address queue
+
+	ConsPubKeyRotationKey         = []byte{0x51} // prefix for each key to a pending consensus pubkey rotation, by validator operator
+	LastConsPubKeyRotationTimeKey = []byte{0x52} // prefix for each key to the time of the last consensus pubkey rotation, by validator operator
+
+	ShareTokenKey        = []byte{0x61} // prefix for each key to the share token of a validator, by validator operator
+	ShareTokenByDenomKey = []byte{0x62} // prefix for each key to a validator operator, by share token denom
+	LastShareTokenIDKey  = []byte{0x63} // key for the id of the last created share token
 )
 
 const maxDigitsForAccount = 12 // ~220,000,000 atoms created at launch
@@ -118,6 +126,36 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// gets the key for the rotated consensus addresses released at the timestamp
+func GetRotatedConsAddrQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(RotatedConsAddrQueueKey, bz...)
+}
+
+// gets the key for the pending consensus pubkey rotation of a validator
+// VALUE: stake/types.ConsPubKeyRotation
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the time of the last consensus pubkey rotation of a validator
+// VALUE: time.Time
+func GetLastConsPubKeyRotationTimeKey(operatorAddr sdk.ValAddress) []byte {
+	return append(LastConsPubKeyRotationTimeKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the share token of a validator
+// VALUE: stake/types.ShareToken
+func GetShareTokenKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ShareTokenKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the validator of a share token
+// VALUE: validator operator address ([]byte)
+func GetShareTokenByDenomKey(denom string) []byte {
+	return append(ShareTokenByDenomKey, []byte(denom)...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
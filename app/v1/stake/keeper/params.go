@@ -25,10 +25,24 @@ func (k Keeper) MaxValidators(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// ConsPubKeyRotationInterval - Minimum interval between two consensus key rotations of a validator
+func (k Keeper) ConsPubKeyRotationInterval(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyConsPubKeyRotationInterval, &res)
+	return
+}
+
+// MaxValidatorBondedRatio - Maximum share of the bonded tokens a validator can reach by delegations
+func (k Keeper) MaxValidatorBondedRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxValidatorBondedRatio, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.UnbondingTime = k.UnbondingTime(ctx)
 	res.MaxValidators = k.MaxValidators(ctx)
+	res.ConsPubKeyRotationInterval = k.ConsPubKeyRotationInterval(ctx)
+	res.MaxValidatorBondedRatio = k.MaxValidatorBondedRatio(ctx)
 	return
 }
 
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/irisnet/irishub/app/v1/stake/types"
+	sdk "github.com/irisnet/irishub/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// get the pending consensus pubkey rotation of a validator
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetConsPubKeyRotationKey(valAddr))
+	if bz == nil {
+		return rotation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rotation)
+	return rotation, true
+}
+
+// set the pending consensus pubkey rotation of a validator
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rotation)
+	store.Set(GetConsPubKeyRotationKey(rotation.ValidatorAddr), bz)
+}
+
+// delete the pending consensus pubkey rotation of a validator
+func (k Keeper) DeleteConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetConsPubKeyRotationKey(valAddr))
+}
+
+// get all the pending consensus pubkey rotations
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsPubKeyRotation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
+
+// get the time of the last consensus pubkey rotation of a validator
+func (k Keeper) GetLastConsPubKeyRotationTime(ctx sdk.Context, valAddr sdk.ValAddress) (rotationTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetLastConsPubKeyRotationTimeKey(valAddr))
+	if bz == nil {
+		return rotationTime, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rotationTime)
+	return rotationTime, true
+}
+
+// set the time of the last consensus pubkey rotation of a validator
+func (k Keeper) SetLastConsPubKeyRotationTime(ctx sdk.Context, valAddr sdk.ValAddress, rotationTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rotationTime)
+	store.Set(GetLastConsPubKeyRotationTimeKey(valAddr), bz)
+}
+
+// get the times of the last consensus pubkey rotations of all the validators
+func (k Keeper) GetAllLastConsPubKeyRotationTimes(ctx sdk.Context) (rotationTimes []types.LastConsPubKeyRotationTime) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, LastConsPubKeyRotationTimeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotationTime time.Time
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &rotationTime)
+		rotationTimes = append(rotationTimes, types.LastConsPubKeyRotationTime{
+			ValidatorAddr: sdk.ValAddress(iterator.Key()[len(LastConsPubKeyRotationTimeKey):]),
+			Time:          rotationTime,
+		})
+	}
+	return rotationTimes
+}
+
+// ReserveConsAddr points a consensus address other than the current one to the validator,
+// so that no other validator can claim it
+func (k Keeper) ReserveConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorByConsAddrKey(consAddr), valAddr)
+}
+
+// RotateConsPubKey records a rotation of the consensus pubkey of a validator, which is applied
+// at the end of the block. The consensus address of the new pubkey is reserved for the validator
+// right away so that no other validator can claim it in the meantime.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, pubkey crypto.PubKey) (types.ConsPubKeyRotation, sdk.Error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ConsPubKeyRotation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if _, found := k.GetConsPubKeyRotation(ctx, valAddr); found {
+		return types.ConsPubKeyRotation{}, types.ErrConsPubKeyRotationPending(k.Codespace())
+	}
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pubkey)); found {
+		return types.ConsPubKeyRotation{}, types.ErrValidatorPubKeyExists(k.Codespace())
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	if lastTime, found := k.GetLastConsPubKeyRotationTime(ctx, valAddr); found {
+		nextTime := lastTime.Add(k.ConsPubKeyRotationInterval(ctx))
+		if blockTime.Before(nextTime) {
+			return types.ConsPubKeyRotation{}, types.ErrConsPubKeyRotationTooFrequent(k.Codespace(), nextTime)
+		}
+	}
+
+	rotation := types.ConsPubKeyRotation{
+		ValidatorAddr: valAddr,
+		OldConsPubKey: validator.ConsPubKey,
+		NewConsPubKey: pubkey,
+		Height:        ctx.BlockHeight(),
+	}
+	k.SetConsPubKeyRotation(ctx, rotation)
+	k.SetLastConsPubKeyRotationTime(ctx, valAddr, blockTime)
+	k.ReserveConsAddr(ctx, sdk.GetConsAddress(pubkey), valAddr)
+	return rotation, nil
+}
+
+// ApplyConsPubKeyRotations swaps the consensus pubkeys of all the validators with a pending rotation.
+// The consensus address of the old pubkey keeps pointing to the validator for the unbonding time,
+// so that the infractions committed with the old pubkey can still be slashed. The slashing module stops
+// resolving the old address once it is released, so a released address is never slashed for the validator.
+// It has to be called before ApplyAndReturnValidatorSetUpdates, the returned rotations are the ones of
+// the validators known to Tendermint, to be passed to AppendConsPubKeyRotationUpdates.
+func (k Keeper) ApplyConsPubKeyRotations(ctx sdk.Context) (bondedRotations []types.ConsPubKeyRotation) {
+	logger := ctx.Logger()
+	for _, rotation := range k.GetAllConsPubKeyRotations(ctx) {
+		k.DeleteConsPubKeyRotation(ctx, rotation.ValidatorAddr)
+
+		validator := k.mustGetValidator(ctx, rotation.ValidatorAddr)
+		oldConsAddr := validator.ConsAddress()
+		if k.GetLastValidatorPower(ctx, validator.OperatorAddr).IsPositive() {
+			bondedRotations = append(bondedRotations, rotation)
+		}
+
+		validator.ConsPubKey = rotation.NewConsPubKey
+		k.SetValidator(ctx, validator)
+		k.InsertRotatedConsAddrQueue(ctx, ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx)), oldConsAddr)
+		k.OnValidatorConsPubKeyRotated(ctx, validator.OperatorAddr, oldConsAddr, validator.ConsAddress())
+
+		logger.Info("Validator consensus pubkey rotated", "operator_address", validator.OperatorAddr.String(),
+			"old_consensus_address", oldConsAddr.String(), "new_consensus_address", validator.ConsAddress().String())
+	}
+	return bondedRotations
+}
+
+// AppendConsPubKeyRotationUpdates rewrites the validator set updates for the rotated validators known
+// to Tendermint: the old pubkey is removed from the validator set and the new pubkey takes its power.
+func (k Keeper) AppendConsPubKeyRotationUpdates(ctx sdk.Context, updates []abci.ValidatorUpdate,
+	bondedRotations []types.ConsPubKeyRotation) []abci.ValidatorUpdate {
+
+	for _, rotation := range bondedRotations {
+		newPubKey := tmtypes.TM2PB.PubKey(rotation.NewConsPubKey)
+
+		// the new pubkey is unknown to Tendermint, it can not be removed from the validator set
+		hasNewPubKey := false
+		filtered := make([]abci.ValidatorUpdate, 0, len(updates))
+		for _, update := range updates {
+			if equalABCIPubKey(update.PubKey, newPubKey) {
+				if update.Power == 0 {
+					continue
+				}
+				hasNewPubKey = true
+			}
+			filtered = append(filtered, update)
+		}
+		updates = filtered
+
+		if power := k.GetLastValidatorPower(ctx, rotation.ValidatorAddr); !hasNewPubKey && power.IsPositive() {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: newPubKey, Power: power.Int64()})
+		}
+		updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(rotation.OldConsPubKey), Power: 0})
+	}
+	return updates
+}
+
+func equalABCIPubKey(a, b abci.PubKey) bool {
+	return a.Type == b.Type && bytes.Equal(a.Data, b.Data)
+}
+
+// gets a specific rotated consensus address queue timeslice
+func (k Keeper) GetRotatedConsAddrQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (consAddrs []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetRotatedConsAddrQueueTimeKey(timestamp))
+	if bz == nil {
+		return []sdk.ConsAddress{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &consAddrs)
+	return consAddrs
+}
+
+// Sets a specific rotated consensus address queue timeslice.
+func (k Keeper) SetRotatedConsAddrQueueTimeSlice(ctx sdk.Context, timestamp time.Time, consAddrs []sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(consAddrs)
+	store.Set(GetRotatedConsAddrQueueTimeKey(timestamp), bz)
+}
+
+// Insert a rotated consensus address to the appropriate timeslice in the rotated consensus address queue
+func (k Keeper) InsertRotatedConsAddrQueue(ctx sdk.Context, releaseTime time.Time, consAddr sdk.ConsAddress) {
+	timeSlice := k.GetRotatedConsAddrQueueTimeSlice(ctx, releaseTime)
+	k.SetRotatedConsAddrQueueTimeSlice(ctx, releaseTime, append(timeSlice, consAddr))
+}
+
+// get all the rotated consensus addresses which are still reserved, with their validators and release times
+func (k Keeper) GetAllRotatedConsAddrs(ctx sdk.Context) (rotatedConsAddrs []types.RotatedConsAddr) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RotatedConsAddrQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		releaseTime, err := sdk.ParseTimeBytes(iterator.Key()[len(RotatedConsAddrQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+		var consAddrs []sdk.ConsAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &consAddrs)
+		for _, consAddr := range consAddrs {
+			// skip the addresses which no longer point to a validator
+			valAddr := store.Get(GetValidatorByConsAddrKey(consAddr))
+			if valAddr == nil {
+				continue
+			}
+			rotatedConsAddrs = append(rotatedConsAddrs, types.RotatedConsAddr{
+				ConsAddr:      consAddr,
+				ValidatorAddr: valAddr,
+				ReleaseTime:   releaseTime,
+			})
+		}
+	}
+	return rotatedConsAddrs
+}
+
+// ReleaseAllMatureRotatedConsAddrs removes the validator index of the mature rotated consensus addresses
+func (k Keeper) ReleaseAllMatureRotatedConsAddrs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(RotatedConsAddrQueueKey,
+		sdk.InclusiveEndBytes(GetRotatedConsAddrQueueTimeKey(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var consAddrs []sdk.ConsAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &consAddrs)
+		for _, consAddr := range consAddrs {
+			// the validator may have rotated back to the old pubkey in the meantime
+			validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+			if found && bytes.Equal(validator.ConsAddress(), consAddr) {
+				continue
+			}
+			store.Delete(GetValidatorByConsAddrKey(consAddr))
+		}
+		store.Delete(iterator.Key())
+	}
+}
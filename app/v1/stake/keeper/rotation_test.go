@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/irisnet/irishub/app/v1/stake/types"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, sdk.NewIntWithDecimal(1000, 18))
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+
+	// create two validators, only the first one is bonded
+	amts := []int64{100, 50}
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, pool, _ = validator.AddTokensFromDel(ctx, pool, sdk.NewIntWithDecimal(amt, 18))
+		keeper.SetPool(ctx, pool)
+		keeper.SetValidatorByConsAddr(ctx, validator)
+		TestingUpdateValidator(keeper, ctx, validator, true)
+	}
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	keeper.SetParams(ctx, params)
+	require.Equal(t, 1, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+	require.True(t, keeper.GetLastValidatorPower(ctx, addrVals[0]).IsPositive())
+	require.True(t, keeper.GetLastValidatorPower(ctx, addrVals[1]).IsZero())
+
+	// the new pubkey must not be used by another validator
+	_, err := keeper.RotateConsPubKey(ctx, addrVals[0], PKs[1])
+	require.NotNil(t, err)
+	require.Equal(t, types.ErrValidatorPubKeyExists(keeper.Codespace()), err)
+
+	rotation, err := keeper.RotateConsPubKey(ctx, addrVals[0], PKs[2])
+	require.Nil(t, err)
+	require.Equal(t, PKs[0], rotation.OldConsPubKey)
+
+	// the new pubkey is reserved right away, only one rotation can be pending
+	validator, found := keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[2]))
+	require.True(t, found)
+	require.Equal(t, addrVals[0], validator.OperatorAddr)
+	_, err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[3])
+	require.NotNil(t, err)
+
+	// the old pubkey is removed from the validator set and the new one takes its power
+	rotations := keeper.ApplyConsPubKeyRotations(ctx)
+	require.Equal(t, 1, len(rotations))
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	updates = keeper.AppendConsPubKeyRotationUpdates(ctx, updates, rotations)
+	require.Equal(t, 2, len(updates))
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[2]), updates[0].PubKey)
+	require.Equal(t, int64(100), updates[0].Power)
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[0]), updates[1].PubKey)
+	require.Equal(t, int64(0), updates[1].Power)
+
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, PKs[2], validator.ConsPubKey)
+	_, found = keeper.GetConsPubKeyRotation(ctx, addrVals[0])
+	require.False(t, found)
+
+	// the rotations are rate limited
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.ConsPubKeyRotationInterval).Add(-time.Second))
+	_, err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[3])
+	require.NotNil(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Second))
+	_, err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[3])
+	require.Nil(t, err)
+
+	// the rotation of an unbonded validator does not update the validator set
+	_, err = keeper.RotateConsPubKey(ctx, addrVals[1], PKs[4])
+	require.Nil(t, err)
+	rotations = keeper.ApplyConsPubKeyRotations(ctx)
+	require.Equal(t, 1, len(rotations))
+	require.Equal(t, addrVals[0], rotations[0].ValidatorAddr)
+
+	// the old pubkey keeps pointing to the validator for the unbonding time
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(t, found)
+	require.Equal(t, addrVals[0], validator.OperatorAddr)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC().Add(params.UnbondingTime))
+	keeper.ReleaseAllMatureRotatedConsAddrs(ctx)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.False(t, found)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[2]))
+	require.True(t, found)
+	require.Equal(t, addrVals[0], validator.OperatorAddr)
+}
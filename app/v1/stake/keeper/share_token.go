@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"github.com/irisnet/irishub/app/v1/stake/types"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// get the share token of a validator
+func (k Keeper) GetShareToken(ctx sdk.Context, valAddr sdk.ValAddress) (shareToken types.ShareToken, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetShareTokenKey(valAddr))
+	if bz == nil {
+		return shareToken, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &shareToken)
+	return shareToken, true
+}
+
+// get a share token by its denom
+func (k Keeper) GetShareTokenByDenom(ctx sdk.Context, denom string) (shareToken types.ShareToken, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := store.Get(GetShareTokenByDenomKey(denom))
+	if valAddr == nil {
+		return shareToken, false
+	}
+	return k.GetShareToken(ctx, valAddr)
+}
+
+// set the share token of a validator and its denom index
+func (k Keeper) SetShareToken(ctx sdk.Context, shareToken types.ShareToken) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(shareToken)
+	store.Set(GetShareTokenKey(shareToken.ValidatorAddr), bz)
+	store.Set(GetShareTokenByDenomKey(shareToken.Denom), shareToken.ValidatorAddr)
+}
+
+// get all the share tokens
+func (k Keeper) GetAllShareTokens(ctx sdk.Context) (shareTokens []types.ShareToken) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ShareTokenKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var shareToken types.ShareToken
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &shareToken)
+		shareTokens = append(shareTokens, shareToken)
+	}
+	return shareTokens
+}
+
+// get the id of the last created share token
+func (k Keeper) GetLastShareTokenID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(LastShareTokenIDKey)
+	if bz == nil {
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+// set the id of the last created share token
+func (k Keeper) SetLastShareTokenID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	store.Set(LastShareTokenIDKey, bz)
+}
+
+// get the supply of a share token and the tokenized shares backing it
+func (k Keeper) GetShareTokenSupply(ctx sdk.Context, shareToken types.ShareToken) types.ShareTokenSupply {
+	supply := types.ShareTokenSupply{
+		ValidatorAddr: shareToken.ValidatorAddr,
+		Denom:         shareToken.Denom,
+		PoolAddress:   shareToken.PoolAddress(),
+		Supply:        sdk.ZeroInt(),
+		Shares:        sdk.ZeroDec(),
+	}
+	if totalSupply, found := k.bankKeeper.GetTotalSupply(ctx, shareToken.Denom); found {
+		supply.Supply = totalSupply.Amount
+	}
+	if delegation, found := k.GetDelegation(ctx, supply.PoolAddress, shareToken.ValidatorAddr); found {
+		supply.Shares = delegation.Shares
+	}
+	return supply
+}
+
+func (k Keeper) getOrCreateShareToken(ctx sdk.Context, valAddr sdk.ValAddress) (types.ShareToken, sdk.Error) {
+	if shareToken, found := k.GetShareToken(ctx, valAddr); found {
+		return shareToken, nil
+	}
+
+	id := k.GetLastShareTokenID(ctx) + 1
+	if id > types.MaxShareTokenID {
+		return types.ShareToken{}, types.ErrShareTokenLimit(k.Codespace())
+	}
+	shareToken := types.NewShareToken(valAddr, id)
+	k.SetShareToken(ctx, shareToken)
+	k.SetLastShareTokenID(ctx, id)
+	return shareToken, nil
+}
+
+// TokenizeShares moves the shares of a delegation to the share token pool of the validator, and mints
+// the share tokens worth the shares to the delegator
+func (k Keeper) TokenizeShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	shares sdk.Dec) (sdk.Coin, sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the redelegated shares must stay slashable for the infractions of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrTokenizeReceivingRedelegation(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+	if delegation.Shares.LT(shares) {
+		return sdk.Coin{}, types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.QuoInt(sdk.NewIntWithDecimal(1, 18)).RoundInt().String())
+	}
+
+	shareToken, err := k.getOrCreateShareToken(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.compoundShareTokenPool(ctx, validator, shareToken); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the share tokens are minted at the rate of the pool, which grows with the compounded rewards
+	supply := k.GetShareTokenSupply(ctx, shareToken)
+	amount := shares.TruncateInt()
+	if supply.Supply.IsPositive() && supply.Shares.IsPositive() {
+		amount = shares.MulInt(supply.Supply).Quo(supply.Shares).TruncateInt()
+	}
+	if !amount.IsPositive() {
+		return sdk.Coin{}, types.ErrTokenizedSharesTooSmall(k.Codespace())
+	}
+
+	validator = k.mustGetValidator(ctx, valAddr)
+	if err := k.transferDelegationShares(ctx, validator, delAddr, shareToken.PoolAddress(), shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	coin := sdk.NewCoin(shareToken.Denom, amount)
+	if _, found := k.bankKeeper.GetTotalSupply(ctx, shareToken.Denom); !found {
+		k.bankKeeper.SetTotalSupply(ctx, sdk.NewCoin(shareToken.Denom, sdk.ZeroInt()))
+	}
+	if err := k.bankKeeper.IncreaseTotalSupply(ctx, coin); err != nil {
+		return sdk.Coin{}, err
+	}
+	if _, _, err := k.bankKeeper.AddCoins(ctx, delAddr, sdk.Coins{coin}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.Logger().Info("Tokenize shares", "validator_address", valAddr.String(),
+		"delegator_address", delAddr.String(), "shares", shares.String(), "share_tokens", coin.String())
+	return coin, nil
+}
+
+// RedeemShareTokens burns the share tokens of the delegator, and moves the shares they are worth from
+// the share token pool to the delegation of the delegator
+func (k Keeper) RedeemShareTokens(ctx sdk.Context, delAddr sdk.AccAddress,
+	amount sdk.Coin) (valAddr sdk.ValAddress, shares sdk.Dec, err sdk.Error) {
+
+	shareToken, found := k.GetShareTokenByDenom(ctx, amount.Denom)
+	if !found {
+		return nil, sdk.ZeroDec(), types.ErrNoShareToken(k.Codespace(), amount.Denom)
+	}
+	validator, found := k.GetValidator(ctx, shareToken.ValidatorAddr)
+	if !found {
+		return nil, sdk.ZeroDec(), types.ErrNoValidatorFound(k.Codespace())
+	}
+	if err := k.compoundShareTokenPool(ctx, validator, shareToken); err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+
+	supply := k.GetShareTokenSupply(ctx, shareToken)
+	if amount.Amount.GT(supply.Supply) {
+		return nil, sdk.ZeroDec(), types.ErrInsufficientShareTokens(k.Codespace(), sdk.NewCoin(supply.Denom, supply.Supply))
+	}
+	shares = supply.Shares
+	if amount.Amount.LT(supply.Supply) {
+		shares = supply.Shares.MulInt(amount.Amount).QuoInt(supply.Supply)
+	}
+	if !shares.IsPositive() {
+		return nil, sdk.ZeroDec(), types.ErrBadSharesAmount(k.Codespace())
+	}
+
+	if _, _, err := k.bankKeeper.SubtractCoins(ctx, delAddr, sdk.Coins{amount}); err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+	if err := k.bankKeeper.DecreaseTotalSupply(ctx, amount); err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+
+	validator = k.mustGetValidator(ctx, shareToken.ValidatorAddr)
+	if err := k.transferDelegationShares(ctx, validator, shareToken.PoolAddress(), delAddr, shares); err != nil {
+		return nil, sdk.ZeroDec(), err
+	}
+
+	ctx.Logger().Info("Redeem share tokens", "validator_address", validator.OperatorAddr.String(),
+		"delegator_address", delAddr.String(), "shares", shares.String(), "share_tokens", amount.String())
+	return validator.OperatorAddr, shares, nil
+}
+
+// the rewards of the tokenized delegation are withdrawn to the pool address and delegated again,
+// so that they accrue to the share token holders
+func (k Keeper) compoundShareTokenPool(ctx sdk.Context, validator types.Validator, shareToken types.ShareToken) sdk.Error {
+	poolAddr := shareToken.PoolAddress()
+	if _, found := k.GetDelegation(ctx, poolAddr, validator.OperatorAddr); !found {
+		return nil
+	}
+
+	// withdraws the rewards of the delegation
+	k.OnDelegationSharesModified(ctx, poolAddr, validator.OperatorAddr)
+
+	rewards := k.bankKeeper.GetCoins(ctx, poolAddr).AmountOf(k.BondDenom())
+	if !rewards.IsPositive() || validator.DelegatorShareExRate().IsZero() {
+		return nil
+	}
+	_, err := k.Delegate(ctx, poolAddr, sdk.NewCoin(k.BondDenom(), rewards), validator, true)
+	return err
+}
+
+// move delegation shares between two delegators, the tokens of the validator are unchanged
+func (k Keeper) transferDelegationShares(ctx sdk.Context, validator types.Validator,
+	srcAddr, dstAddr sdk.AccAddress, shares sdk.Dec) sdk.Error {
+
+	srcDelegation, found := k.GetDelegation(ctx, srcAddr, validator.OperatorAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+	if srcDelegation.Shares.LT(shares) {
+		return types.ErrNotEnoughDelegationShares(k.Codespace(), srcDelegation.Shares.QuoInt(sdk.NewIntWithDecimal(1, 18)).RoundInt().String())
+	}
+
+	k.OnDelegationSharesModified(ctx, srcAddr, validator.OperatorAddr)
+	srcDelegation.Shares = srcDelegation.Shares.Sub(shares)
+
+	// if the delegation is the operator of the validator then
+	// trigger a jail validator when its self-delegation is too low
+	validator = k.jailIfSelfDelegationTooLow(ctx, validator, srcDelegation)
+
+	if srcDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, srcDelegation)
+	} else {
+		srcDelegation.Height = ctx.BlockHeight()
+		k.SetDelegation(ctx, srcDelegation)
+	}
+
+	dstDelegation, found := k.GetDelegation(ctx, dstAddr, validator.OperatorAddr)
+	if found {
+		k.OnDelegationSharesModified(ctx, dstAddr, validator.OperatorAddr)
+	} else {
+		dstDelegation = types.Delegation{
+			DelegatorAddr: dstAddr,
+			ValidatorAddr: validator.OperatorAddr,
+			Shares:        sdk.ZeroDec(),
+		}
+		k.OnDelegationCreated(ctx, dstAddr, validator.OperatorAddr)
+	}
+	dstDelegation.Shares = dstDelegation.Shares.Add(shares)
+	dstDelegation.Height = ctx.BlockHeight()
+	k.SetDelegation(ctx, dstDelegation)
+	return nil
+}
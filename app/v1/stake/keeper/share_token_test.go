@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/irisnet/irishub/app/v1/stake/types"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, sdk.NewIntWithDecimal(10, 18))
+	pool := keeper.GetPool(ctx)
+
+	//create a validator and a delegator to that validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(ctx, pool, sdk.NewIntWithDecimal(10, 18))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	})
+
+	// can not tokenize more than the delegation shares
+	_, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(11, 18)))
+	require.NotNil(t, err)
+
+	// the first share tokens are minted one for one
+	coin, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(4, 18)))
+	require.Nil(t, err)
+	require.Equal(t, "iris.sh1-min", coin.Denom)
+	require.Equal(t, sdk.NewIntWithDecimal(4, 18), coin.Amount)
+	require.Equal(t, coin.Amount, keeper.bankKeeper.GetCoins(ctx, addrDels[0]).AmountOf(coin.Denom))
+
+	shareToken, found := keeper.GetShareTokenByDenom(ctx, coin.Denom)
+	require.True(t, found)
+	require.Equal(t, addrVals[0], shareToken.ValidatorAddr)
+	supply := keeper.GetShareTokenSupply(ctx, shareToken)
+	require.Equal(t, sdk.NewIntWithDecimal(4, 18), supply.Supply)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(4, 18)), supply.Shares)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(6, 18)), delegation.Shares)
+
+	// the tokens of the validator are unchanged
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares, validator.DelegatorShares)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(10, 18)), validator.Tokens)
+
+	// the share tokens are redeemable by any holder
+	transferred := sdk.NewCoin(coin.Denom, sdk.NewIntWithDecimal(1, 18))
+	_, err = keeper.bankKeeper.SendCoins(ctx, addrDels[0], addrDels[1], sdk.Coins{transferred})
+	require.Nil(t, err)
+	valAddr, shares, err := keeper.RedeemShareTokens(ctx, addrDels[1], transferred)
+	require.Nil(t, err)
+	require.Equal(t, addrVals[0], valAddr)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18)), shares)
+	delegation, found = keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, shares, delegation.Shares)
+	require.True(t, keeper.bankKeeper.GetCoins(ctx, addrDels[1]).AmountOf(coin.Denom).IsZero())
+
+	// the rewards withdrawn to the pool are delegated again, the share tokens are then worth more shares
+	rewards := sdk.Coins{sdk.NewCoin(keeper.BondDenom(), sdk.NewIntWithDecimal(3, 18))}
+	_, _, err = keeper.bankKeeper.AddCoins(ctx, shareToken.PoolAddress(), rewards)
+	require.Nil(t, err)
+	keeper.bankKeeper.IncreaseLoosenToken(ctx, rewards)
+	coin, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(2, 18)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewIntWithDecimal(1, 18), coin.Amount)
+	supply = keeper.GetShareTokenSupply(ctx, shareToken)
+	require.Equal(t, sdk.NewIntWithDecimal(4, 18), supply.Supply)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(8, 18)), supply.Shares)
+
+	// can not redeem more than the supply, nor an unknown token
+	_, _, err = keeper.RedeemShareTokens(ctx, addrDels[0], sdk.NewCoin(coin.Denom, sdk.NewIntWithDecimal(5, 18)))
+	require.NotNil(t, err)
+	_, _, err = keeper.RedeemShareTokens(ctx, addrDels[0], sdk.NewCoin("iris.sh2-min", sdk.NewIntWithDecimal(1, 18)))
+	require.NotNil(t, err)
+
+	// redeeming the whole supply empties the pool
+	_, shares, err = keeper.RedeemShareTokens(ctx, addrDels[0], sdk.NewCoin(coin.Denom, sdk.NewIntWithDecimal(4, 18)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(8, 18)), shares)
+	_, found = keeper.GetDelegation(ctx, shareToken.PoolAddress(), addrVals[0])
+	require.False(t, found)
+	supply = keeper.GetShareTokenSupply(ctx, shareToken)
+	require.True(t, supply.Supply.IsZero())
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntWithDecimal(12, 18)), delegation.Shares)
+
+	// the share token is kept for the next tokenization
+	coin, err = keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, 18)))
+	require.Nil(t, err)
+	require.Equal(t, shareToken.Denom, coin.Denom)
+	require.Equal(t, uint64(1), keeper.GetLastShareTokenID(ctx))
+}
@@ -201,6 +201,10 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(GetValidatorKey(address))
 	store.Delete(GetValidatorByConsAddrKey(sdk.ConsAddress(validator.ConsPubKey.Address())))
 	store.Delete(GetValidatorsByPowerIndexKey(validator, pool))
+	if rotation, found := k.GetConsPubKeyRotation(ctx, address); found {
+		store.Delete(GetValidatorByConsAddrKey(sdk.GetConsAddress(rotation.NewConsPubKey)))
+		k.DeleteConsPubKeyRotation(ctx, address)
+	}
 	ctx.Logger().Info("Remove validator", "consensus_address", validator.ConsAddress().String(), "operator_address", validator.OperatorAddr.String())
 	k.metrics.BondedToken.With("validator_address", validator.ConsAddress().String()).Set(0)
 
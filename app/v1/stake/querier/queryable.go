@@ -26,6 +26,7 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryShareToken                    = "shareToken"
 )
 
 // creates a querier for staking REST endpoints
@@ -62,6 +63,8 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 			return queryPool(ctx, cdc, k)
 		case QueryParameters:
 			return queryParameters(ctx, cdc, k)
+		case QueryShareToken:
+			return queryShareToken(ctx, cdc, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown stake query endpoint")
 		}
@@ -82,6 +85,7 @@ type QueryDelegatorParams struct {
 // - 'custom/stake/validatorDelegations'
 // - 'custom/stake/validatorUnbondingDelegations'
 // - 'custom/stake/validatorRedelegations'
+// - 'custom/stake/shareToken'
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
 }
@@ -375,3 +379,23 @@ func queryParameters(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []by
 	}
 	return res, nil
 }
+
+func queryShareToken(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryValidatorParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownAddress("")
+	}
+
+	shareToken, found := k.GetShareToken(ctx, params.ValidatorAddr)
+	if !found {
+		return []byte{}, types.ErrNoValidatorShareToken(types.DefaultCodespace)
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, k.GetShareTokenSupply(ctx, shareToken))
+	if errRes != nil {
+		return nil, sdk.MarshalResultErr(err)
+	}
+	return res, nil
+}
@@ -9,27 +9,36 @@ import (
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	Validator               = types.Validator
-	Description             = types.Description
-	Commission              = types.Commission
-	Delegation              = types.Delegation
-	UnbondingDelegation     = types.UnbondingDelegation
-	Redelegation            = types.Redelegation
-	Params                  = types.Params
-	Pool                    = types.Pool
-	BondedPool              = types.BondedPool
-	PoolStatus              = types.PoolStatus
-	MsgCreateValidator      = types.MsgCreateValidator
-	MsgEditValidator        = types.MsgEditValidator
-	MsgDelegate             = types.MsgDelegate
-	MsgBeginUnbonding       = types.MsgBeginUnbonding
-	MsgBeginRedelegate      = types.MsgBeginRedelegate
-	GenesisState            = types.GenesisState
-	QueryDelegatorParams    = querier.QueryDelegatorParams
-	QueryValidatorParams    = querier.QueryValidatorParams
-	QueryBondsParams        = querier.QueryBondsParams
-	QueryRedelegationParams = querier.QueryRedelegationParams
+	Keeper                       = keeper.Keeper
+	Validator                    = types.Validator
+	Description                  = types.Description
+	Commission                   = types.Commission
+	Delegation                   = types.Delegation
+	UnbondingDelegation          = types.UnbondingDelegation
+	Redelegation                 = types.Redelegation
+	Params                       = types.Params
+	Pool                         = types.Pool
+	BondedPool                   = types.BondedPool
+	PoolStatus                   = types.PoolStatus
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginUnbonding            = types.MsgBeginUnbonding
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgRotateConsPubKey          = types.MsgRotateConsPubKey
+	ConsPubKeyRotation           = types.ConsPubKeyRotation
+	LastConsPubKeyRotationTime   = types.LastConsPubKeyRotationTime
+	RotatedConsAddr              = types.RotatedConsAddr
+	MsgTokenizeShares            = types.MsgTokenizeShares
+	MsgRedeemShareTokens         = types.MsgRedeemShareTokens
+	ShareToken                   = types.ShareToken
+	ShareTokenSupply             = types.ShareTokenSupply
+	GenesisState                 = types.GenesisState
+	QueryDelegatorParams         = querier.QueryDelegatorParams
+	QueryValidatorParams         = querier.QueryValidatorParams
+	QueryBondsParams             = querier.QueryBondsParams
+	QueryRedelegationParams      = querier.QueryRedelegationParams
 )
 
 var (
@@ -66,10 +75,12 @@ var (
 	RedelegationQueueKey         = keeper.RedelegationQueueKey
 	ValidatorQueueKey            = keeper.ValidatorQueueKey
 
-	DefaultParamspace = types.DefaultParamSpace
-	KeyUnbondingTime  = types.KeyUnbondingTime
-	KeyMaxValidators  = types.KeyMaxValidators
-	BondDenom         = types.StakeDenom
+	DefaultParamspace             = types.DefaultParamSpace
+	KeyUnbondingTime              = types.KeyUnbondingTime
+	KeyMaxValidators              = types.KeyMaxValidators
+	KeyConsPubKeyRotationInterval = types.KeyConsPubKeyRotationInterval
+	KeyMaxValidatorBondedRatio    = types.KeyMaxValidatorBondedRatio
+	BondDenom                     = types.StakeDenom
 
 	DefaultParams         = types.DefaultParams
 	InitialBondedPool     = types.InitialBondedPool
@@ -87,7 +98,11 @@ var (
 	NewMsgEditValidator             = types.NewMsgEditValidator
 	NewMsgDelegate                  = types.NewMsgDelegate
 	NewMsgBeginUnbonding            = types.NewMsgBeginUnbonding
+	NewMsgCancelUnbondingDelegation = types.NewMsgCancelUnbondingDelegation
 	NewMsgBeginRedelegate           = types.NewMsgBeginRedelegate
+	NewMsgRotateConsPubKey          = types.NewMsgRotateConsPubKey
+	NewMsgTokenizeShares            = types.NewMsgTokenizeShares
+	NewMsgRedeemShareTokens         = types.NewMsgRedeemShareTokens
 
 	NewQuerier              = querier.NewQuerier
 	NewQueryDelegatorParams = querier.NewQueryDelegatorParams
@@ -111,6 +126,7 @@ const (
 	QueryDelegatorValidator            = querier.QueryDelegatorValidator
 	QueryPool                          = querier.QueryPool
 	QueryParameters                    = querier.QueryParameters
+	QueryShareToken                    = querier.QueryShareToken
 )
 
 const (
@@ -135,6 +151,10 @@ var (
 	ErrDescriptionLength              = types.ErrDescriptionLength
 	ErrCommissionNegative             = types.ErrCommissionNegative
 	ErrCommissionHuge                 = types.ErrCommissionHuge
+	ErrConsPubKeyRotationPending      = types.ErrConsPubKeyRotationPending
+	ErrConsPubKeyRotationTooFrequent  = types.ErrConsPubKeyRotationTooFrequent
+	ErrMinSelfDelegationInvalid       = types.ErrMinSelfDelegationInvalid
+	ErrSelfDelegationBelowMinimum     = types.ErrSelfDelegationBelowMinimum
 
 	ErrNilDelegatorAddr          = types.ErrNilDelegatorAddr
 	ErrBadDenom                  = types.ErrBadDenom
@@ -148,10 +168,21 @@ var (
 	ErrBadSharesAmount           = types.ErrBadSharesAmount
 	ErrBadSharesPercent          = types.ErrBadSharesPercent
 
-	ErrNotMature             = types.ErrNotMature
-	ErrNoUnbondingDelegation = types.ErrNoUnbondingDelegation
-	ErrNoRedelegation        = types.ErrNoRedelegation
-	ErrBadRedelegationDst    = types.ErrBadRedelegationDst
+	ErrValidatorBondedRatioExceeded = types.ErrValidatorBondedRatioExceeded
+
+	ErrNotMature                     = types.ErrNotMature
+	ErrNoUnbondingDelegation         = types.ErrNoUnbondingDelegation
+	ErrUnbondingDelegationMature     = types.ErrUnbondingDelegationMature
+	ErrCancelUnbondingExceedsBalance = types.ErrCancelUnbondingExceedsBalance
+	ErrNoRedelegation                = types.ErrNoRedelegation
+	ErrBadRedelegationDst            = types.ErrBadRedelegationDst
+
+	ErrTokenizeReceivingRedelegation = types.ErrTokenizeReceivingRedelegation
+	ErrTokenizedSharesTooSmall       = types.ErrTokenizedSharesTooSmall
+	ErrShareTokenLimit               = types.ErrShareTokenLimit
+	ErrNoShareToken                  = types.ErrNoShareToken
+	ErrNoValidatorShareToken         = types.ErrNoValidatorShareToken
+	ErrInsufficientShareTokens       = types.ErrInsufficientShareTokens
 
 	ErrBothShareMsgsGiven    = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven = types.ErrNeitherShareMsgsGiven
@@ -161,11 +192,15 @@ var (
 var (
 	ActionCreateValidator      = tags.ActionCreateValidator
 	ActionEditValidator        = tags.ActionEditValidator
+	ActionRotateConsPubKey     = tags.ActionRotateConsPubKey
 	ActionDelegate             = tags.ActionDelegate
 	ActionBeginUnbonding       = tags.ActionBeginUnbonding
 	ActionCompleteUnbonding    = tags.ActionCompleteUnbonding
+	ActionCancelUnbonding      = tags.ActionCancelUnbonding
 	ActionBeginRedelegation    = tags.ActionBeginRedelegation
 	ActionCompleteRedelegation = tags.ActionCompleteRedelegation
+	ActionTokenizeShares       = tags.ActionTokenizeShares
+	ActionRedeemShareTokens    = tags.ActionRedeemShareTokens
 
 	TagAction       = tags.Action
 	TagSrcValidator = tags.SrcValidator
@@ -173,4 +208,7 @@ var (
 	TagDelegator    = tags.Delegator
 	TagMoniker      = tags.Moniker
 	TagIdentity     = tags.Identity
+	TagConsPubKey   = tags.ConsPubKey
+	TagShares       = tags.Shares
+	TagShareTokens  = tags.ShareTokens
 )
@@ -8,11 +8,15 @@ import (
 var (
 	ActionCreateValidator      = []byte("create-validator")
 	ActionEditValidator        = []byte("edit-validator")
+	ActionRotateConsPubKey     = []byte("rotate-cons-pubkey")
 	ActionDelegate             = []byte("delegate")
 	ActionBeginUnbonding       = []byte("begin-unbonding")
 	ActionCompleteUnbonding    = []byte("complete-unbonding")
+	ActionCancelUnbonding      = []byte("cancel-unbonding")
 	ActionBeginRedelegation    = []byte("begin-redelegation")
 	ActionCompleteRedelegation = []byte("complete-redelegation")
+	ActionTokenizeShares       = []byte("tokenize-shares")
+	ActionRedeemShareTokens    = []byte("redeem-share-tokens")
 
 	Action       = sdk.TagAction
 	SrcValidator = sdk.TagSrcValidator
@@ -20,8 +24,11 @@ var (
 	Delegator    = sdk.TagDelegator
 	Moniker      = "moniker"
 	Identity     = "identity"
+	ConsPubKey   = "cons-pubkey"
 	EndTime      = "end-time"
 	Balance      = "balance"
 	SharesSrc    = "shares-src"
 	SharesDst    = "shares-dst"
+	Shares       = "shares"
+	ShareTokens  = "share-tokens"
 )
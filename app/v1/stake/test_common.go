@@ -28,7 +28,7 @@ var (
 
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) MsgCreateValidator {
 	return types.NewMsgCreateValidator(
-		address, pubKey, sdk.NewCoin(types.StakeDenom, amt), Description{}, commissionMsg,
+		address, pubKey, sdk.NewCoin(types.StakeDenom, amt), sdk.OneInt(), Description{}, commissionMsg,
 	)
 }
 
@@ -38,7 +38,7 @@ func NewTestMsgCreateValidatorWithCommission(address sdk.ValAddress, pubKey cryp
 	commission := NewCommissionMsg(commissionRate, sdk.OneDec(), sdk.ZeroDec())
 
 	return types.NewMsgCreateValidator(
-		address, pubKey, sdk.NewCoin(types.StakeDenom, amt), Description{}, commission,
+		address, pubKey, sdk.NewCoin(types.StakeDenom, amt), sdk.OneInt(), Description{}, commission,
 	)
 }
 
@@ -58,5 +58,7 @@ func NewTestMsgCreateValidatorOnBehalfOf(delAddr sdk.AccAddress, valAddr sdk.Val
 		ValidatorAddr: valAddr,
 		PubKey:        valPubKey,
 		Delegation:    sdk.NewCoin(types.StakeDenom, amt),
+
+		MinSelfDelegation: sdk.OneInt(),
 	}
 }
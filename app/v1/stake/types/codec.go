@@ -10,7 +10,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgEditValidator{}, "irishub/stake/MsgEditValidator", nil)
 	cdc.RegisterConcrete(MsgDelegate{}, "irishub/stake/MsgDelegate", nil)
 	cdc.RegisterConcrete(MsgBeginUnbonding{}, "irishub/stake/BeginUnbonding", nil)
+	cdc.RegisterConcrete(MsgCancelUnbondingDelegation{}, "irishub/stake/MsgCancelUnbondingDelegation", nil)
 	cdc.RegisterConcrete(MsgBeginRedelegate{}, "irishub/stake/BeginRedelegate", nil)
+	cdc.RegisterConcrete(MsgRotateConsPubKey{}, "irishub/stake/MsgRotateConsPubKey", nil)
+	cdc.RegisterConcrete(MsgTokenizeShares{}, "irishub/stake/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(MsgRedeemShareTokens{}, "irishub/stake/MsgRedeemShareTokens", nil)
 
 	cdc.RegisterConcrete(Pool{}, "irishub/stake/Pool", nil)
 	cdc.RegisterConcrete(BondedPool{}, "irishub/stake/BondedPool", nil)
@@ -18,6 +22,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(Delegation{}, "irishub/stake/Delegation", nil)
 	cdc.RegisterConcrete(UnbondingDelegation{}, "irishub/stake/UnbondingDelegation", nil)
 	cdc.RegisterConcrete(Redelegation{}, "irishub/stake/Redelegation", nil)
+	cdc.RegisterConcrete(ConsPubKeyRotation{}, "irishub/stake/ConsPubKeyRotation", nil)
+	cdc.RegisterConcrete(ShareToken{}, "irishub/stake/ShareToken", nil)
 
 	cdc.RegisterConcrete(&Params{}, "irishub/stake/Params", nil)
 }
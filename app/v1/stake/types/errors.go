@@ -58,6 +58,22 @@ func ErrBadRemoveValidator(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "error removing validator")
 }
 
+func ErrConsPubKeyRotationPending(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "a consensus pubkey rotation of this validator is already pending")
+}
+
+func ErrConsPubKeyRotationTooFrequent(codespace sdk.CodespaceType, next time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, fmt.Sprintf("the consensus pubkey of this validator can not be rotated again before %v", next))
+}
+
+func ErrMinSelfDelegationInvalid(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "minimum self-delegation must be a positive integer")
+}
+
+func ErrSelfDelegationBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "self-delegation is below the minimum self-delegation of the validator")
+}
+
 func ErrDescriptionLength(codespace sdk.CodespaceType, descriptor string, got, max int) sdk.Error {
 	msg := fmt.Sprintf("bad description length for %v, got length %v, max is %v", descriptor, got, max)
 	return sdk.NewError(codespace, CodeInvalidValidator, msg)
@@ -153,6 +169,14 @@ func ErrExistingUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "existing unbonding delegation found")
 }
 
+func ErrUnbondingDelegationMature(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "unbonding delegation has already reached its completion time")
+}
+
+func ErrCancelUnbondingExceedsBalance(codespace sdk.CodespaceType, balance sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, fmt.Sprintf("the cancelled amount exceeds the unbonding balance %s", balance.String()))
+}
+
 func ErrBadRedelegationAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unexpected address length for this (address, srcValidator, dstValidator) tuple")
 }
@@ -179,6 +203,36 @@ func ErrConflictingRedelegation(codespace sdk.CodespaceType) sdk.Error {
 		"conflicting redelegation from this source validator to this dest validator already exists, you must wait for it to finish")
 }
 
+func ErrTokenizeReceivingRedelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"redelegation to this validator in progress, the redelegated shares can not be tokenized before the redelegation completes")
+}
+
+func ErrTokenizedSharesTooSmall(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "the shares are worth less than one share token")
+}
+
+func ErrShareTokenLimit(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "no more share token can be created")
+}
+
+func ErrNoShareToken(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, fmt.Sprintf("%s is not a share token", denom))
+}
+
+func ErrNoValidatorShareToken(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "no share token for this validator, its delegations have never been tokenized")
+}
+
+func ErrInsufficientShareTokens(codespace sdk.CodespaceType, supply sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, fmt.Sprintf("the redeemed amount exceeds the share token supply %s", supply.String()))
+}
+
+func ErrValidatorBondedRatioExceeded(codespace sdk.CodespaceType, ratio, max sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("the validator would hold %s of the bonded tokens, exceeding the maximum %s", ratio.String(), max.String()))
+}
+
 func ErrDelegatorShareExRateInvalid(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"cannot delegate to validators with invalid (zero) ex-rate")
@@ -14,7 +14,13 @@ type GenesisState struct {
 	Bonds                []Delegation          `json:"bonds"`
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations"`
+	ShareTokens          []ShareToken          `json:"share_tokens"`
+	LastShareTokenID     uint64                `json:"last_share_token_id"`
 	Exported             bool                  `json:"exported"`
+
+	ConsPubKeyRotations         []ConsPubKeyRotation         `json:"cons_pubkey_rotations"`
+	LastConsPubKeyRotationTimes []LastConsPubKeyRotationTime `json:"last_cons_pubkey_rotation_times"`
+	RotatedConsAddrs            []RotatedConsAddr            `json:"rotated_cons_addrs"`
 }
 
 // Last validator power, needed for validator set update logic
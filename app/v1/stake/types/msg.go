@@ -11,7 +11,8 @@ import (
 const MsgRoute = "stake"
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgDelegate{}
+var _, _, _, _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgDelegate{}, &MsgRotateConsPubKey{},
+	&MsgTokenizeShares{}, &MsgRedeemShareTokens{}
 
 //______________________________________________________________________
 
@@ -23,27 +24,30 @@ type MsgCreateValidator struct {
 	ValidatorAddr sdk.ValAddress `json:"validator_address"`
 	PubKey        crypto.PubKey  `json:"pubkey"`
 	Delegation    sdk.Coin       `json:"delegation"`
+
+	MinSelfDelegation sdk.Int `json:"min_self_delegation"`
 }
 
 // Default way to create validator. Delegator address and validator address are the same
-func NewMsgCreateValidator(valAddr sdk.ValAddress, pubkey crypto.PubKey,
-	selfDelegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
+func NewMsgCreateValidator(valAddr sdk.ValAddress, pubkey crypto.PubKey, selfDelegation sdk.Coin,
+	minSelfDelegation sdk.Int, description Description, commission CommissionMsg) MsgCreateValidator {
 
 	return NewMsgCreateValidatorOnBehalfOf(
-		sdk.AccAddress(valAddr), valAddr, pubkey, selfDelegation, description, commission,
+		sdk.AccAddress(valAddr), valAddr, pubkey, selfDelegation, minSelfDelegation, description, commission,
 	)
 }
 
 // Creates validator msg by delegator address on behalf of validator address
-func NewMsgCreateValidatorOnBehalfOf(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
-	pubkey crypto.PubKey, delegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
+func NewMsgCreateValidatorOnBehalfOf(delAddr sdk.AccAddress, valAddr sdk.ValAddress, pubkey crypto.PubKey,
+	delegation sdk.Coin, minSelfDelegation sdk.Int, description Description, commission CommissionMsg) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   description,
-		DelegatorAddr: delAddr,
-		ValidatorAddr: valAddr,
-		PubKey:        pubkey,
-		Delegation:    delegation,
-		Commission:    commission,
+		Description:       description,
+		DelegatorAddr:     delAddr,
+		ValidatorAddr:     valAddr,
+		PubKey:            pubkey,
+		Delegation:        delegation,
+		Commission:        commission,
+		MinSelfDelegation: minSelfDelegation,
 	}
 }
 
@@ -73,11 +77,14 @@ func (msg MsgCreateValidator) GetSignBytes() []byte {
 		ValidatorAddr sdk.ValAddress `json:"validator_address"`
 		PubKey        string         `json:"pubkey"`
 		Delegation    sdk.Coin       `json:"delegation"`
+
+		MinSelfDelegation sdk.Int `json:"min_self_delegation"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
-		PubKey:        sdk.MustBech32ifyConsPub(msg.PubKey),
-		Delegation:    msg.Delegation,
+		Description:       msg.Description,
+		ValidatorAddr:     msg.ValidatorAddr,
+		PubKey:            sdk.MustBech32ifyConsPub(msg.PubKey),
+		Delegation:        msg.Delegation,
+		MinSelfDelegation: msg.MinSelfDelegation,
 	})
 	if err != nil {
 		panic(err)
@@ -96,6 +103,12 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if !msg.Delegation.IsValidIrisAtto() {
 		return ErrBadDelegationAmount(DefaultCodespace)
 	}
+	if msg.MinSelfDelegation == (sdk.Int{}) || !msg.MinSelfDelegation.IsPositive() {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
+	if msg.Delegation.Amount.LT(msg.MinSelfDelegation) {
+		return ErrSelfDelegationBelowMinimum(DefaultCodespace)
+	}
 	if msg.Description == (Description{}) {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "description must be included")
 	}
@@ -332,3 +345,194 @@ func (msg MsgBeginUnbonding) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgCancelUnbondingDelegation - struct for delegating the pending unbonding tokens back to the validator
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Amount        sdk.Coin       `json:"amount"`
+}
+
+func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgCancelUnbondingDelegation {
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		Amount:        amount,
+	}
+}
+
+//nolint
+func (msg MsgCancelUnbondingDelegation) Route() string { return MsgRoute }
+func (msg MsgCancelUnbondingDelegation) Type() string  { return "cancel_unbonding_delegation" }
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.IsValidIrisAtto() {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgRotateConsPubKey - struct for replacing the consensus key of a validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	PubKey        crypto.PubKey  `json:"pubkey"`
+}
+
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, pubkey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddr: valAddr,
+		PubKey:        pubkey,
+	}
+}
+
+//nolint
+func (msg MsgRotateConsPubKey) Route() string { return MsgRoute }
+func (msg MsgRotateConsPubKey) Type() string  { return "rotate_cons_pubkey" }
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+		PubKey        string         `json:"pubkey"`
+	}{
+		ValidatorAddr: msg.ValidatorAddr,
+		PubKey:        sdk.MustBech32ifyConsPub(msg.PubKey),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.PubKey == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "consensus pubkey must be included")
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgTokenizeShares - struct for converting delegation shares into share tokens
+type MsgTokenizeShares struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	SharesAmount  sdk.Dec        `json:"shares_amount"`
+}
+
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		SharesAmount:  sharesAmount,
+	}
+}
+
+//nolint
+func (msg MsgTokenizeShares) Route() string                { return MsgRoute }
+func (msg MsgTokenizeShares) Type() string                 { return "tokenize_shares" }
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddr} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+		ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+		SharesAmount  string         `json:"shares_amount"`
+	}{
+		DelegatorAddr: msg.DelegatorAddr,
+		ValidatorAddr: msg.ValidatorAddr,
+		SharesAmount:  msg.SharesAmount.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.SharesAmount.Int == nil || msg.SharesAmount.LTE(sdk.ZeroDec()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgRedeemShareTokens - struct for converting share tokens back into delegation shares
+type MsgRedeemShareTokens struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	Amount        sdk.Coin       `json:"amount"`
+}
+
+func NewMsgRedeemShareTokens(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemShareTokens {
+	return MsgRedeemShareTokens{
+		DelegatorAddr: delAddr,
+		Amount:        amount,
+	}
+}
+
+//nolint
+func (msg MsgRedeemShareTokens) Route() string                { return MsgRoute }
+func (msg MsgRedeemShareTokens) Type() string                 { return "redeem_share_tokens" }
+func (msg MsgRedeemShareTokens) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddr} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedeemShareTokens) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRedeemShareTokens) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	if !IsShareTokenDenom(msg.Amount.Denom) {
+		return ErrNoShareToken(DefaultCodespace, msg.Amount.Denom)
+	}
+	return nil
+}
@@ -37,7 +37,7 @@ func TestMsgCreateValidator(t *testing.T) {
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, tc.commissionMsg)
+		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, sdk.OneInt(), description, tc.commissionMsg)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -98,7 +98,7 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
 		msg := NewMsgCreateValidatorOnBehalfOf(
-			tc.delegatorAddr, tc.validatorAddr, tc.validatorPubKey, tc.bond, description, tc.commissionMsg,
+			tc.delegatorAddr, tc.validatorAddr, tc.validatorPubKey, tc.bond, sdk.OneInt(), description, tc.commissionMsg,
 		)
 
 		if tc.expectPass {
@@ -108,11 +108,11 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 		}
 	}
 
-	msg := NewMsgCreateValidator(addr1, pk1, coinPos, Description{}, CommissionMsg{})
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, sdk.OneInt(), Description{}, CommissionMsg{})
 	addrs := msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(addr1)}, addrs, "Signers on default msg is wrong")
 
-	msg = NewMsgCreateValidatorOnBehalfOf(sdk.AccAddress(addr2), addr1, pk1, coinPos, Description{}, CommissionMsg{})
+	msg = NewMsgCreateValidatorOnBehalfOf(sdk.AccAddress(addr2), addr1, pk1, coinPos, sdk.OneInt(), Description{}, CommissionMsg{})
 	addrs = msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(addr2), sdk.AccAddress(addr1)}, addrs, "Signers for onbehalfof msg is wrong")
 }
@@ -196,3 +196,28 @@ func TestMsgBeginUnbonding(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCancelUnbondingDelegation
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(addr1), addr2, coinPos, true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), addr1, coinPos, false},
+		{"empty validator", sdk.AccAddress(addr1), emptyAddr, coinPos, false},
+		{"zero amount", sdk.AccAddress(addr1), addr2, coinZero, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -31,6 +31,9 @@ const (
 var (
 	KeyUnbondingTime = []byte("UnbondingTime")
 	KeyMaxValidators = []byte("MaxValidators")
+
+	KeyConsPubKeyRotationInterval = []byte("ConsPubKeyRotationInterval")
+	KeyMaxValidatorBondedRatio    = []byte("MaxValidatorBondedRatio")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -39,13 +42,18 @@ var _ params.ParamSet = (*Params)(nil)
 type Params struct {
 	UnbondingTime time.Duration `json:"unbonding_time"`
 	MaxValidators uint16        `json:"max_validators"` // maximum number of validators
+
+	ConsPubKeyRotationInterval time.Duration `json:"cons_pubkey_rotation_interval"` // minimum interval between two consensus key rotations of a validator
+	MaxValidatorBondedRatio    sdk.Dec       `json:"max_validator_bonded_ratio"`    // maximum share of the bonded tokens a validator can reach by delegations, 1 for no limit
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf(`Stake Params:
-  stake/UnbondingTime:  %s
-  stake/MaxValidators:  %d`,
-		p.UnbondingTime, p.MaxValidators)
+  stake/UnbondingTime:               %s
+  stake/MaxValidators:               %d
+  stake/ConsPubKeyRotationInterval:  %s
+  stake/MaxValidatorBondedRatio:     %s`,
+		p.UnbondingTime, p.MaxValidators, p.ConsPubKeyRotationInterval, p.MaxValidatorBondedRatio.String())
 }
 
 // Implements params.Params
@@ -53,6 +61,8 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 	return params.KeyValuePairs{
 		{KeyUnbondingTime, &p.UnbondingTime},
 		{KeyMaxValidators, &p.MaxValidators},
+		{KeyConsPubKeyRotationInterval, &p.ConsPubKeyRotationInterval},
+		{KeyMaxValidatorBondedRatio, &p.MaxValidatorBondedRatio},
 	}
 }
 
@@ -76,6 +86,24 @@ func (p *Params) Validate(key string, value string) (interface{}, sdk.Error) {
 			return nil, err
 		}
 		return uint16(maxValidators), nil
+	case string(KeyConsPubKeyRotationInterval):
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateConsPubKeyRotationInterval(interval); err != nil {
+			return nil, err
+		}
+		return interval, nil
+	case string(KeyMaxValidatorBondedRatio):
+		ratio, err := sdk.NewDecFromStr(value)
+		if err != nil {
+			return nil, params.ErrInvalidString(value)
+		}
+		if err := validateMaxValidatorBondedRatio(ratio); err != nil {
+			return nil, err
+		}
+		return ratio, nil
 	default:
 		return nil, sdk.NewError(params.DefaultCodespace, params.CodeInvalidKey, fmt.Sprintf("%s is not found", key))
 	}
@@ -93,6 +121,12 @@ func (p *Params) StringFromBytes(cdc *codec.Codec, key string, bytes []byte) (st
 	case string(KeyMaxValidators):
 		err := cdc.UnmarshalJSON(bytes, &p.MaxValidators)
 		return strconv.Itoa(int(p.MaxValidators)), err
+	case string(KeyConsPubKeyRotationInterval):
+		err := cdc.UnmarshalJSON(bytes, &p.ConsPubKeyRotationInterval)
+		return p.ConsPubKeyRotationInterval.String(), err
+	case string(KeyMaxValidatorBondedRatio):
+		err := cdc.UnmarshalJSON(bytes, &p.MaxValidatorBondedRatio)
+		return p.MaxValidatorBondedRatio.String(), err
 	default:
 		return "", fmt.Errorf("%s is not existed", key)
 	}
@@ -112,8 +146,10 @@ func (p Params) Equal(p2 Params) bool {
 // default stake module params
 func DefaultParams() Params {
 	return Params{
-		UnbondingTime: 3 * sdk.Week,
-		MaxValidators: 100,
+		UnbondingTime:              3 * sdk.Week,
+		MaxValidators:              100,
+		ConsPubKeyRotationInterval: sdk.Week,
+		MaxValidatorBondedRatio:    sdk.OneDec(),
 	}
 }
 
@@ -124,6 +160,12 @@ func ValidateParams(p Params) error {
 	if err := validateMaxValidators(p.MaxValidators); err != nil {
 		return err
 	}
+	if err := validateConsPubKeyRotationInterval(p.ConsPubKeyRotationInterval); err != nil {
+		return err
+	}
+	if err := validateMaxValidatorBondedRatio(p.MaxValidatorBondedRatio); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -133,6 +175,8 @@ func (p Params) HumanReadableString() string {
 	resp := "Params \n"
 	resp += fmt.Sprintf("Unbonding Time: %s\n", p.UnbondingTime)
 	resp += fmt.Sprintf("Max Validators: %d: \n", p.MaxValidators)
+	resp += fmt.Sprintf("Consensus PubKey Rotation Interval: %s\n", p.ConsPubKeyRotationInterval)
+	resp += fmt.Sprintf("Max Validator Bonded Ratio: %s\n", p.MaxValidatorBondedRatio.String())
 	return resp
 }
 
@@ -159,3 +203,25 @@ func validateMaxValidators(v uint16) sdk.Error {
 	}
 	return nil
 }
+
+func validateConsPubKeyRotationInterval(v time.Duration) sdk.Error {
+	if sdk.NetworkType == sdk.Mainnet {
+		if v < sdk.Day {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidConsPubKeyRotationInterval, fmt.Sprintf("Invalid ConsPubKeyRotationInterval [%s] should be greater than or equal to 1 day", v.String()))
+		}
+	} else if v < 0 {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidConsPubKeyRotationInterval, fmt.Sprintf("Invalid ConsPubKeyRotationInterval [%s] should not be negative", v.String()))
+	}
+	return nil
+}
+
+func validateMaxValidatorBondedRatio(v sdk.Dec) sdk.Error {
+	if sdk.NetworkType == sdk.Mainnet {
+		if v.LT(sdk.NewDecWithPrec(5, 2)) || v.GT(sdk.OneDec()) {
+			return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMaxValidatorBondedRatio, fmt.Sprintf("Invalid MaxValidatorBondedRatio [%s] should be between [0.05, 1]", v.String()))
+		}
+	} else if v.LTE(sdk.ZeroDec()) || v.GT(sdk.OneDec()) {
+		return sdk.NewError(params.DefaultCodespace, params.CodeInvalidMaxValidatorBondedRatio, fmt.Sprintf("Invalid MaxValidatorBondedRatio [%s] should be between (0, 1]", v.String()))
+	}
+	return nil
+}
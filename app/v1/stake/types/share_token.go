@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	// the share tokens are named iris.sh<id>, the asset tokens and gateways can not contain the native token name
+	ShareTokenNamePrefix = sdk.Iris + ".sh"
+	// the coin name is limited to 8 characters after the prefix
+	MaxShareTokenID uint64 = 999999
+	// the main unit of a share token is worth 10^18 shares, as the main unit of iris is worth 10^18 iris-atto
+	ShareTokenDecimal = 18
+)
+
+// ShareToken is the transferable token of the tokenized delegations to a validator. The tokenized
+// shares are pooled in a delegation held by the share token pool address of the validator, and each
+// share token is redeemable for its part of the pool.
+type ShareToken struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Denom         string         `json:"denom"`
+}
+
+// NewShareToken creates the share token of a validator from its id
+func NewShareToken(valAddr sdk.ValAddress, id uint64) ShareToken {
+	return ShareToken{
+		ValidatorAddr: valAddr,
+		Denom:         fmt.Sprintf("%s%d%s", ShareTokenNamePrefix, id, sdk.MinDenomSuffix),
+	}
+}
+
+// PoolAddress returns the address holding the tokenized delegation to the validator
+func (st ShareToken) PoolAddress() sdk.AccAddress {
+	return GetShareTokenPoolAddress(st.ValidatorAddr)
+}
+
+func (st ShareToken) String() string {
+	return fmt.Sprintf(`Share Token:
+  Validator:     %s
+  Denom:         %s
+  Pool Address:  %s`,
+		st.ValidatorAddr, st.Denom, st.PoolAddress())
+}
+
+// GetShareTokenPoolAddress returns the address holding the tokenized delegation to a validator,
+// nobody owns its private key
+func GetShareTokenPoolAddress(valAddr sdk.ValAddress) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash(append([]byte("stakeShareTokenPool"), valAddr.Bytes()...)))
+}
+
+// IsShareTokenName checks if the coin name is the one of a share token
+func IsShareTokenName(coinName string) bool {
+	return strings.HasPrefix(coinName, ShareTokenNamePrefix) && sdk.IsCoinNameValid(coinName)
+}
+
+// IsShareTokenDenom checks if the denom is the min denom of a share token
+func IsShareTokenDenom(denom string) bool {
+	return strings.HasSuffix(denom, sdk.MinDenomSuffix) && IsShareTokenName(strings.TrimSuffix(denom, sdk.MinDenomSuffix))
+}
+
+// ShareTokenCoinType returns the coin type of a share token
+func ShareTokenCoinType(coinName string) sdk.CoinType {
+	units := make(sdk.Units, 2)
+	units[0] = sdk.NewUnit(coinName, 0)
+	units[1] = sdk.NewUnit(fmt.Sprintf("%s%s", coinName, sdk.MinDenomSuffix), ShareTokenDecimal)
+
+	return sdk.CoinType{
+		Name:    coinName,
+		Units:   units,
+		MinUnit: units[1],
+		Desc:    "Tokenized Delegation Shares",
+	}
+}
+
+// ShareTokenSupply is the supply of a share token and the tokenized shares backing it
+type ShareTokenSupply struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Denom         string         `json:"denom"`
+	PoolAddress   sdk.AccAddress `json:"pool_address"`
+	Supply        sdk.Int        `json:"supply"`
+	Shares        sdk.Dec        `json:"shares"`
+}
+
+func (s ShareTokenSupply) String() string {
+	return fmt.Sprintf(`Share Token:
+  Validator:     %s
+  Denom:         %s
+  Pool Address:  %s
+  Supply:        %s
+  Shares:        %s`,
+		s.ValidatorAddr, s.Denom, s.PoolAddress, s.Supply.String(), s.Shares.String())
+}
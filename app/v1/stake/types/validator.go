@@ -36,52 +36,57 @@ type Validator struct {
 	UnbondingMinTime time.Time `json:"unbonding_time"`   // if unbonding, min time for the validator to complete unbonding
 
 	Commission Commission `json:"commission"` // commission parameters
+
+	MinSelfDelegation sdk.Int `json:"min_self_delegation"` // validator is jailed when the self-delegation of its operator drops below it
 }
 
 // NewValidator - initialize a new validator
 func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Description) Validator {
 	return Validator{
-		OperatorAddr:     operator,
-		ConsPubKey:       pubKey,
-		Jailed:           false,
-		Status:           sdk.Unbonded,
-		Tokens:           sdk.ZeroDec(),
-		DelegatorShares:  sdk.ZeroDec(),
-		Description:      description,
-		BondHeight:       int64(0),
-		UnbondingHeight:  int64(0),
-		UnbondingMinTime: time.Unix(0, 0).UTC(),
-		Commission:       NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		OperatorAddr:      operator,
+		ConsPubKey:        pubKey,
+		Jailed:            false,
+		Status:            sdk.Unbonded,
+		Tokens:            sdk.ZeroDec(),
+		DelegatorShares:   sdk.ZeroDec(),
+		Description:       description,
+		BondHeight:        int64(0),
+		UnbondingHeight:   int64(0),
+		UnbondingMinTime:  time.Unix(0, 0).UTC(),
+		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.OneInt(),
 	}
 }
 
 // what's kept in the store value
 type validatorValue struct {
-	ConsPubKey       crypto.PubKey
-	Jailed           bool
-	Status           sdk.BondStatus
-	Tokens           sdk.Dec
-	DelegatorShares  sdk.Dec
-	Description      Description
-	BondHeight       int64
-	UnbondingHeight  int64
-	UnbondingMinTime time.Time
-	Commission       Commission
+	ConsPubKey        crypto.PubKey
+	Jailed            bool
+	Status            sdk.BondStatus
+	Tokens            sdk.Dec
+	DelegatorShares   sdk.Dec
+	Description       Description
+	BondHeight        int64
+	UnbondingHeight   int64
+	UnbondingMinTime  time.Time
+	Commission        Commission
+	MinSelfDelegation sdk.Int
 }
 
 // return the redelegation without fields contained within the key for the store
 func MustMarshalValidator(cdc *codec.Codec, validator Validator) []byte {
 	val := validatorValue{
-		ConsPubKey:       validator.ConsPubKey,
-		Jailed:           validator.Jailed,
-		Status:           validator.Status,
-		Tokens:           validator.Tokens,
-		DelegatorShares:  validator.DelegatorShares,
-		Description:      validator.Description,
-		BondHeight:       validator.BondHeight,
-		UnbondingHeight:  validator.UnbondingHeight,
-		UnbondingMinTime: validator.UnbondingMinTime,
-		Commission:       validator.Commission,
+		ConsPubKey:        validator.ConsPubKey,
+		Jailed:            validator.Jailed,
+		Status:            validator.Status,
+		Tokens:            validator.Tokens,
+		DelegatorShares:   validator.DelegatorShares,
+		Description:       validator.Description,
+		BondHeight:        validator.BondHeight,
+		UnbondingHeight:   validator.UnbondingHeight,
+		UnbondingMinTime:  validator.UnbondingMinTime,
+		Commission:        validator.Commission,
+		MinSelfDelegation: validator.MinSelfDelegation,
 	}
 	return cdc.MustMarshalBinaryLengthPrefixed(val)
 }
@@ -108,17 +113,18 @@ func UnmarshalValidator(cdc *codec.Codec, operatorAddr, value []byte) (validator
 	}
 
 	return Validator{
-		OperatorAddr:     operatorAddr,
-		ConsPubKey:       storeValue.ConsPubKey,
-		Jailed:           storeValue.Jailed,
-		Tokens:           storeValue.Tokens,
-		Status:           storeValue.Status,
-		DelegatorShares:  storeValue.DelegatorShares,
-		Description:      storeValue.Description,
-		BondHeight:       storeValue.BondHeight,
-		UnbondingHeight:  storeValue.UnbondingHeight,
-		UnbondingMinTime: storeValue.UnbondingMinTime,
-		Commission:       storeValue.Commission,
+		OperatorAddr:      operatorAddr,
+		ConsPubKey:        storeValue.ConsPubKey,
+		Jailed:            storeValue.Jailed,
+		Tokens:            storeValue.Tokens,
+		Status:            storeValue.Status,
+		DelegatorShares:   storeValue.DelegatorShares,
+		Description:       storeValue.Description,
+		BondHeight:        storeValue.BondHeight,
+		UnbondingHeight:   storeValue.UnbondingHeight,
+		UnbondingMinTime:  storeValue.UnbondingMinTime,
+		Commission:        storeValue.Commission,
+		MinSelfDelegation: minSelfDelegationOrZero(storeValue.MinSelfDelegation),
 	}, nil
 }
 
@@ -143,6 +149,7 @@ func (v Validator) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Unbonding Height: %d\n", v.UnbondingHeight)
 	resp += fmt.Sprintf("Minimum Unbonding Time: %v\n", v.UnbondingMinTime)
 	resp += fmt.Sprintf("Commission: {%s}\n", v.Commission)
+	resp += fmt.Sprintf("Min Self Delegation: %s\n", v.MinSelfDelegation)
 
 	return resp, nil
 }
@@ -166,6 +173,8 @@ type bechValidator struct {
 	UnbondingMinTime time.Time `json:"unbonding_time"`   // if unbonding, min time for the validator to complete unbonding
 
 	Commission Commission `json:"commission"` // commission parameters
+
+	MinSelfDelegation sdk.Int `json:"min_self_delegation"` // validator is jailed when the self-delegation of its operator drops below it
 }
 
 // MarshalJSON marshals the validator to JSON using Bech32
@@ -176,17 +185,18 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 	}
 
 	return codec.Cdc.MarshalJSON(bechValidator{
-		OperatorAddr:     v.OperatorAddr,
-		ConsPubKey:       bechConsPubKey,
-		Jailed:           v.Jailed,
-		Status:           v.Status,
-		Tokens:           v.Tokens,
-		DelegatorShares:  v.DelegatorShares,
-		Description:      v.Description,
-		BondHeight:       v.BondHeight,
-		UnbondingHeight:  v.UnbondingHeight,
-		UnbondingMinTime: v.UnbondingMinTime,
-		Commission:       v.Commission,
+		OperatorAddr:      v.OperatorAddr,
+		ConsPubKey:        bechConsPubKey,
+		Jailed:            v.Jailed,
+		Status:            v.Status,
+		Tokens:            v.Tokens,
+		DelegatorShares:   v.DelegatorShares,
+		Description:       v.Description,
+		BondHeight:        v.BondHeight,
+		UnbondingHeight:   v.UnbondingHeight,
+		UnbondingMinTime:  v.UnbondingMinTime,
+		Commission:        v.Commission,
+		MinSelfDelegation: v.MinSelfDelegation,
 	})
 }
 
@@ -201,21 +211,30 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	*v = Validator{
-		OperatorAddr:     bv.OperatorAddr,
-		ConsPubKey:       consPubKey,
-		Jailed:           bv.Jailed,
-		Tokens:           bv.Tokens,
-		Status:           bv.Status,
-		DelegatorShares:  bv.DelegatorShares,
-		Description:      bv.Description,
-		BondHeight:       bv.BondHeight,
-		UnbondingHeight:  bv.UnbondingHeight,
-		UnbondingMinTime: bv.UnbondingMinTime,
-		Commission:       bv.Commission,
+		OperatorAddr:      bv.OperatorAddr,
+		ConsPubKey:        consPubKey,
+		Jailed:            bv.Jailed,
+		Tokens:            bv.Tokens,
+		Status:            bv.Status,
+		DelegatorShares:   bv.DelegatorShares,
+		Description:       bv.Description,
+		BondHeight:        bv.BondHeight,
+		UnbondingHeight:   bv.UnbondingHeight,
+		UnbondingMinTime:  bv.UnbondingMinTime,
+		Commission:        bv.Commission,
+		MinSelfDelegation: minSelfDelegationOrZero(bv.MinSelfDelegation),
 	}
 	return nil
 }
 
+// the validators created before the minimum self-delegation was introduced have none
+func minSelfDelegationOrZero(minSelfDelegation sdk.Int) sdk.Int {
+	if minSelfDelegation == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return minSelfDelegation
+}
+
 //___________________________________________________________________
 
 // only the vitals
@@ -456,7 +475,43 @@ func (v Validator) GetPower() sdk.Dec {
 func (v Validator) GetPotentialPower() sdk.Dec {
 	return v.Tokens.QuoInt(sdk.AttoScaleFactor)
 }
-func (v Validator) GetTokens() sdk.Dec          { return v.Tokens }
-func (v Validator) GetCommission() sdk.Dec      { return v.Commission.Rate }
-func (v Validator) GetDelegatorShares() sdk.Dec { return v.DelegatorShares }
-func (v Validator) GetBondHeight() int64        { return v.BondHeight }
+func (v Validator) GetTokens() sdk.Dec            { return v.Tokens }
+func (v Validator) GetCommission() sdk.Dec        { return v.Commission.Rate }
+func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+func (v Validator) GetBondHeight() int64          { return v.BondHeight }
+func (v Validator) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }
+
+//______________________________________________________________________
+
+// ConsPubKeyRotation is a rotation of the consensus pubkey of a validator, it is requested
+// by MsgRotateConsPubKey and applied at the end of the block
+type ConsPubKeyRotation struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	OldConsPubKey crypto.PubKey  `json:"old_consensus_pubkey"`
+	NewConsPubKey crypto.PubKey  `json:"new_consensus_pubkey"`
+	Height        int64          `json:"height"` // height at which the rotation was requested
+}
+
+func (r ConsPubKeyRotation) String() string {
+	return fmt.Sprintf(`Consensus PubKey Rotation:
+  Validator:     %s
+  Old PubKey:    %s
+  New PubKey:    %s
+  Height:        %d`,
+		r.ValidatorAddr, sdk.MustBech32ifyConsPub(r.OldConsPubKey), sdk.MustBech32ifyConsPub(r.NewConsPubKey), r.Height)
+}
+
+// LastConsPubKeyRotationTime is the time of the last consensus pubkey rotation of a validator,
+// from which the next rotation is rate limited
+type LastConsPubKeyRotationTime struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Time          time.Time      `json:"time"`
+}
+
+// RotatedConsAddr is the consensus address of a rotated pubkey, which keeps pointing to its
+// validator until the release time so that no other validator can claim it
+type RotatedConsAddr struct {
+	ConsAddr      sdk.ConsAddress `json:"cons_addr"`
+	ValidatorAddr sdk.ValAddress  `json:"validator_addr"`
+	ReleaseTime   time.Time       `json:"release_time"`
+}
@@ -27,6 +27,15 @@ const (
 
 	FlagToken  = "token"
 	FlagAmount = "amount"
+	FlagRedeem = "redeem"
+
+	FlagHolder    = "holder"
+	FlagWhitelist = "whitelist"
+	FlagAdd       = "add"
+	FlagRemove    = "remove"
+
+	FlagCollection = "collection"
+	FlagTokenURI   = "token-uri"
 )
 
 var (
@@ -39,6 +48,14 @@ var (
 	FsFeeQuery             = flag.NewFlagSet("", flag.ContinueOnError)
 	FsTransferTokenOwner   = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMintToken            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBurnToken            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsFreezeToken          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsEditWhitelist        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMintNFT              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTransferNFT          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsEditNFT              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCollectionsQuery     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsNFTsQuery            = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -84,4 +101,25 @@ func init() {
 
 	FsMintToken.String(FlagTo, "", "address of mint token to")
 	FsMintToken.Uint64(FlagAmount, 0, "amount of mint token")
+
+	FsBurnToken.Uint64(FlagAmount, 0, "amount of burn token")
+	FsBurnToken.Bool(FlagRedeem, false, "whether the owner redeems the token, which keeps the max supply unchanged")
+
+	FsFreezeToken.String(FlagHolder, "", "the holder of which the token balance is to be frozen or unfrozen")
+
+	FsEditWhitelist.String(FlagWhitelist, "", "whether to enable the whitelist mode, in which only the whitelisted accounts can transfer the token")
+	FsEditWhitelist.StringSlice(FlagAdd, []string{}, "the accounts to be added to the whitelist, separated by comma")
+	FsEditWhitelist.StringSlice(FlagRemove, []string{}, "the accounts to be removed from the whitelist, separated by comma")
+
+	FsMintNFT.String(FlagTo, "", "address of the nft recipient, default to the collection owner")
+	FsMintNFT.String(FlagTokenURI, "", "the URI pointing to the metadata of the nft")
+
+	FsTransferNFT.String(FlagTo, "", "the new owner of the nft")
+
+	FsEditNFT.String(FlagTokenURI, asset.DoNotModify, "the URI pointing to the metadata of the nft")
+
+	FsCollectionsQuery.String(FlagOwner, "", "the owner address to be queried")
+
+	FsNFTsQuery.String(FlagCollection, "", "the collection id to be queried")
+	FsNFTsQuery.String(FlagOwner, "", "the owner address to be queried")
 }
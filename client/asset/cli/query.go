@@ -49,6 +49,117 @@ func GetCmdQueryToken(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySupply implements the query token supply command.
+func GetCmdQuerySupply(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-supply",
+		Short:   "Query the supply of a token",
+		Example: "iriscli asset query-supply <token-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := asset.QuerySupplyParams{
+				TokenId: args[0],
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QuerySupply), bz)
+			if err != nil {
+				return err
+			}
+
+			var supply asset.TokenSupply
+			err = cdc.UnmarshalJSON(res, &supply)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(supply)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryFrozen implements the query frozen command.
+func GetCmdQueryFrozen(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-frozen",
+		Short:   "Query the frozen accounts of a token",
+		Example: "iriscli asset query-frozen <token-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := asset.QueryTokenAccountsParams{
+				TokenId: args[0],
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryFrozen), bz)
+			if err != nil {
+				return err
+			}
+
+			var accounts asset.TokenAccounts
+			err = cdc.UnmarshalJSON(res, &accounts)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryWhitelist implements the query whitelist command.
+func GetCmdQueryWhitelist(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-whitelist",
+		Short:   "Query the whitelisted accounts of a token",
+		Example: "iriscli asset query-whitelist <token-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := asset.QueryTokenAccountsParams{
+				TokenId: args[0],
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryWhitelist), bz)
+			if err != nil {
+				return err
+			}
+
+			var accounts asset.TokenAccounts
+			err = cdc.UnmarshalJSON(res, &accounts)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdQueryTokens implements the query tokens command.
 func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -231,6 +342,184 @@ func GetCmdQueryFee(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCollection implements the query collection command.
+func GetCmdQueryCollection(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-collection",
+		Short:   "Query details of a non-fungible collection",
+		Example: "iriscli asset query-collection <collection-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := asset.QueryCollectionParams{
+				Id: args[0],
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryCollection), bz)
+			if err != nil {
+				return err
+			}
+
+			var collection asset.Collection
+			err = cdc.UnmarshalJSON(res, &collection)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(collection)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryCollections implements the query collections command.
+func GetCmdQueryCollections(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-collections",
+		Short:   "Query all non-fungible collections with an optional owner",
+		Example: "iriscli asset query-collections --owner=<address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var (
+				owner sdk.AccAddress
+				err   error
+			)
+
+			ownerStr := viper.GetString(FlagOwner)
+			if ownerStr != "" {
+				owner, err = sdk.AccAddressFromBech32(ownerStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := asset.QueryCollectionsParams{
+				Owner: owner,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryCollections), bz)
+			if err != nil {
+				return err
+			}
+
+			var collections asset.Collections
+			err = cdc.UnmarshalJSON(res, &collections)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(collections)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCollectionsQuery)
+
+	return cmd
+}
+
+// GetCmdQueryNFT implements the query nft command.
+func GetCmdQueryNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-nft",
+		Short:   "Query details of a unique token",
+		Example: "iriscli asset query-nft <collection-id> <nft-id>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := asset.QueryNFTParams{
+				CollectionId: args[0],
+				TokenId:      args[1],
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryNFT), bz)
+			if err != nil {
+				return err
+			}
+
+			var nft asset.NFT
+			err = cdc.UnmarshalJSON(res, &nft)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(nft)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryNFTs implements the query nfts command.
+func GetCmdQueryNFTs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-nfts",
+		Short:   "Query the unique tokens of a collection or an owner",
+		Example: "iriscli asset query-nfts --collection=<collection-id> --owner=<address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var (
+				owner sdk.AccAddress
+				err   error
+			)
+
+			ownerStr := viper.GetString(FlagOwner)
+			if ownerStr != "" {
+				owner, err = sdk.AccAddressFromBech32(ownerStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := asset.QueryNFTsParams{
+				CollectionId: viper.GetString(FlagCollection),
+				Owner:        owner,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.AssetRoute, asset.QueryNFTs), bz)
+			if err != nil {
+				return err
+			}
+
+			var nfts asset.NFTs
+			err = cdc.UnmarshalJSON(res, &nfts)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(nfts)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsNFTsQuery)
+
+	return cmd
+}
+
 // preQueryFeeCmd is used to check if the specified flags are valid
 func preQueryFeeCmd(cmd *cobra.Command, args []string) error {
 	flags := cmd.Flags()
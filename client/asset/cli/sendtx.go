@@ -395,6 +395,176 @@ func GetCmdMintToken(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdBurnToken implements the burn token command
+func GetCmdBurnToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "burn-token",
+		Short:   "Burn tokens from the sender's account, or redeem tokens by the token owner",
+		Example: "iriscli asset burn-token <token-id> --amount=<amount> --redeem=<true|false>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			sender, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgBurnToken(
+				args[0], sender, uint64(viper.GetInt64(FlagAmount)), viper.GetBool(FlagRedeem),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsBurnToken)
+	cmd.MarkFlagRequired(FlagAmount)
+	return cmd
+}
+
+// GetCmdFreezeToken implements the freeze token command
+func GetCmdFreezeToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freeze-token",
+		Short:   "Freeze the token balance of a holder by the token owner",
+		Example: "iriscli asset freeze-token <token-id> --holder=<holder-address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			owner, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			holder, err := sdk.AccAddressFromBech32(viper.GetString(FlagHolder))
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgFreezeToken(args[0], owner, holder)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsFreezeToken)
+	cmd.MarkFlagRequired(FlagHolder)
+	return cmd
+}
+
+// GetCmdUnfreezeToken implements the unfreeze token command
+func GetCmdUnfreezeToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unfreeze-token",
+		Short:   "Unfreeze the token balance of a holder by the token owner",
+		Example: "iriscli asset unfreeze-token <token-id> --holder=<holder-address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			owner, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			holder, err := sdk.AccAddressFromBech32(viper.GetString(FlagHolder))
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgUnfreezeToken(args[0], owner, holder)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsFreezeToken)
+	cmd.MarkFlagRequired(FlagHolder)
+	return cmd
+}
+
+// GetCmdEditWhitelist implements the edit whitelist command
+func GetCmdEditWhitelist(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "edit-whitelist",
+		Short:   "Switch the whitelist mode of a token and edit the whitelisted accounts by the token owner",
+		Example: "iriscli asset edit-whitelist <token-id> --whitelist=<true|false> --add=<address>,<address> --remove=<address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			owner, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			whitelist, err := asset.ParseBool(viper.GetString(FlagWhitelist))
+			if err != nil {
+				return err
+			}
+
+			add, err := parseAddresses(viper.GetStringSlice(FlagAdd))
+			if err != nil {
+				return err
+			}
+
+			remove, err := parseAddresses(viper.GetStringSlice(FlagRemove))
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgEditWhitelist(args[0], owner, whitelist, add, remove)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsEditWhitelist)
+	return cmd
+}
+
 // GetCmdTransferTokenOwner implements the transfer token owner command
 func GetCmdTransferTokenOwner(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -435,3 +605,158 @@ func GetCmdTransferTokenOwner(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMintNFT implements the mint nft command
+func GetCmdMintNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-nft",
+		Short:   "The collection owner mints a unique token to a specified address",
+		Example: "iriscli asset mint-nft <collection-id> <nft-id> --to=<recipient> --token-uri=<token-uri>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			owner, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var to sdk.AccAddress
+			addr := viper.GetString(FlagTo)
+			if len(strings.TrimSpace(addr)) > 0 {
+				to, err = sdk.AccAddressFromBech32(addr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgMintNFT(owner, args[0], args[1], to, viper.GetString(FlagTokenURI))
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsMintNFT)
+	return cmd
+}
+
+// GetCmdTransferNFT implements the transfer nft command
+func GetCmdTransferNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-nft",
+		Short:   "transfer a unique token to a new owner",
+		Example: "iriscli asset transfer-nft <collection-id> <nft-id> --to=<new owner>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			sender, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(viper.GetString(FlagTo))
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgTransferNFT(sender, to, args[0], args[1])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsTransferNFT)
+	cmd.MarkFlagRequired(FlagTo)
+
+	return cmd
+}
+
+// GetCmdEditNFT implements the edit nft command
+func GetCmdEditNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "edit-nft",
+		Short:   "edit the metadata of a unique token",
+		Example: "iriscli asset edit-nft <collection-id> <nft-id> --token-uri=<token-uri>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			owner, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgEditNFT(owner, args[0], args[1], viper.GetString(FlagTokenURI))
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsEditNFT)
+	return cmd
+}
+
+// GetCmdBurnNFT implements the burn nft command
+func GetCmdBurnNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "burn-nft",
+		Short:   "burn a unique token",
+		Example: "iriscli asset burn-nft <collection-id> <nft-id>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			owner, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			msg = asset.NewMsgBurnNFT(owner, args[0], args[1])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -2,10 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/asset"
 	"github.com/irisnet/irishub/client/context"
+	sdk "github.com/irisnet/irishub/types"
 )
 
 // queryGatewayFee retrieves the gateway creation fee for the specified moniker
@@ -61,3 +63,17 @@ func queryTokenFees(cliCtx context.CLIContext, tokenID string) (asset.TokenFeesO
 
 	return out, nil
 }
+
+// parseAddresses converts the given bech32 strings to account addresses
+func parseAddresses(addrs []string) ([]sdk.AccAddress, error) {
+	var accounts []sdk.AccAddress
+	for _, addr := range addrs {
+		acc, err := sdk.AccAddressFromBech32(strings.TrimSpace(addr))
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+
+	return accounts, nil
+}
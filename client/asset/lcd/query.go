@@ -14,6 +14,21 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/asset/tokens/{id}",
 		queryTokenHandlerFn(cliCtx, cdc),
 	).Methods("GET")
+	// Get the supply of a token
+	r.HandleFunc(
+		"/asset/tokens/{id}/supply",
+		querySupplyHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+	// Get the frozen accounts of a token
+	r.HandleFunc(
+		"/asset/tokens/{id}/frozen",
+		queryFrozenHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+	// Get the whitelisted accounts of a token
+	r.HandleFunc(
+		"/asset/tokens/{id}/whitelist",
+		queryWhitelistHandlerFn(cliCtx, cdc),
+	).Methods("GET")
 	// Search tokens
 	r.HandleFunc(
 		"/asset/tokens",
@@ -42,6 +57,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/asset/fees/tokens/{id}",
 		tokenFeesHandlerFn(cliCtx, cdc),
 	).Methods("GET")
+
+	// Get collection by id
+	r.HandleFunc(
+		"/asset/collections/{id}",
+		queryCollectionHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get all collections with an optional owner
+	r.HandleFunc(
+		"/asset/collections",
+		queryCollectionsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get nft by collection id and nft id
+	r.HandleFunc(
+		"/asset/collections/{id}/nfts/{nft-id}",
+		queryNFTHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Search nfts by collection and owner
+	r.HandleFunc(
+		"/asset/nfts",
+		queryNFTsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
 }
 
 // queryTokenHandlerFn performs token information query
@@ -49,6 +88,21 @@ func queryTokenHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handl
 	return queryToken(cliCtx, cdc, "custom/asset/tokens/{id}")
 }
 
+// querySupplyHandlerFn performs token supply query
+func querySupplyHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return querySupply(cliCtx, cdc, "custom/asset/supply")
+}
+
+// queryFrozenHandlerFn performs frozen accounts query
+func queryFrozenHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryTokenAccounts(cliCtx, cdc, "custom/asset/frozen")
+}
+
+// queryWhitelistHandlerFn performs whitelisted accounts query
+func queryWhitelistHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryTokenAccounts(cliCtx, cdc, "custom/asset/whitelist")
+}
+
 // queryTokenHandlerFn performs token information query
 func queryTokensHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return queryTokens(cliCtx, cdc, "custom/asset/tokens")
@@ -73,3 +127,23 @@ func gatewayFeeHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handl
 func tokenFeesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return queryTokenFees(cliCtx, cdc, "custom/asset/fees/tokens")
 }
+
+// queryCollectionHandlerFn is the HTTP request handler to query a collection
+func queryCollectionHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryCollection(cliCtx, cdc, "custom/asset/collection")
+}
+
+// queryCollectionsHandlerFn is the HTTP request handler to query a set of collections
+func queryCollectionsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryCollections(cliCtx, cdc, "custom/asset/collections")
+}
+
+// queryNFTHandlerFn is the HTTP request handler to query an nft
+func queryNFTHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryNFT(cliCtx, cdc, "custom/asset/nft")
+}
+
+// queryNFTsHandlerFn is the HTTP request handler to query a set of nfts
+func queryNFTsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryNFTs(cliCtx, cdc, "custom/asset/nfts")
+}
@@ -53,6 +53,54 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/asset/tokens/{token-id}/mint",
 		mintTokenHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+
+	// burn token
+	r.HandleFunc(
+		"/asset/tokens/{token-id}/burn",
+		burnTokenHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// freeze token
+	r.HandleFunc(
+		"/asset/tokens/{token-id}/freeze",
+		freezeTokenHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// unfreeze token
+	r.HandleFunc(
+		"/asset/tokens/{token-id}/unfreeze",
+		unfreezeTokenHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// edit the whitelist of a token
+	r.HandleFunc(
+		"/asset/tokens/{token-id}/whitelist",
+		editWhitelistHandlerFn(cdc, cliCtx),
+	).Methods("PUT")
+
+	// mint an nft
+	r.HandleFunc(
+		"/asset/collections/{id}/nfts",
+		mintNFTHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// edit an nft
+	r.HandleFunc(
+		"/asset/collections/{id}/nfts/{nft-id}",
+		editNFTHandlerFn(cdc, cliCtx),
+	).Methods("PUT")
+
+	// transfer an nft
+	r.HandleFunc(
+		"/asset/collections/{id}/nfts/{nft-id}/transfer",
+		transferNFTHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// burn an nft
+	r.HandleFunc(
+		"/asset/collections/{id}/nfts/{nft-id}/burn",
+		burnNFTHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 }
 
 type issueTokenReq struct {
@@ -117,6 +165,52 @@ type mintTokenReq struct {
 	Amount uint64         `json:"amount"` // amount of mint token
 }
 
+type burnTokenReq struct {
+	BaseTx utils.BaseTx   `json:"base_tx"`
+	Sender sdk.AccAddress `json:"sender"` // address of the holder who burns the token
+	Amount uint64         `json:"amount"` // amount of burn token
+	Redeem bool           `json:"redeem"` // true if the owner redeems the token
+}
+
+type freezeTokenReq struct {
+	BaseTx utils.BaseTx   `json:"base_tx"`
+	Owner  sdk.AccAddress `json:"owner"`  // the owner of the token
+	Holder sdk.AccAddress `json:"holder"` // the holder of which the balance is to be frozen or unfrozen
+}
+
+type editWhitelistReq struct {
+	BaseTx    utils.BaseTx     `json:"base_tx"`
+	Owner     sdk.AccAddress   `json:"owner"`     // the owner of the token
+	Whitelist string           `json:"whitelist"` // whether the whitelist mode is enabled
+	Add       []sdk.AccAddress `json:"add"`       // the accounts to be added to the whitelist
+	Remove    []sdk.AccAddress `json:"remove"`    // the accounts to be removed from the whitelist
+}
+
+type mintNFTReq struct {
+	BaseTx   utils.BaseTx   `json:"base_tx"`
+	Owner    sdk.AccAddress `json:"owner"`     // the owner of the collection
+	TokenId  string         `json:"token_id"`  // the unique id of the nft
+	To       sdk.AccAddress `json:"to"`        // the recipient of the nft
+	TokenURI string         `json:"token_uri"` // the URI pointing to the metadata of the nft
+}
+
+type editNFTReq struct {
+	BaseTx   utils.BaseTx   `json:"base_tx"`
+	Owner    sdk.AccAddress `json:"owner"`     // the owner of the nft
+	TokenURI string         `json:"token_uri"` // the new token URI
+}
+
+type transferNFTReq struct {
+	BaseTx    utils.BaseTx   `json:"base_tx"`
+	Sender    sdk.AccAddress `json:"sender"`    // the current owner of the nft
+	Recipient sdk.AccAddress `json:"recipient"` // the new owner of the nft
+}
+
+type burnNFTReq struct {
+	BaseTx utils.BaseTx   `json:"base_tx"`
+	Owner  sdk.AccAddress `json:"owner"` // the owner of the nft
+}
+
 func createGatewayHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req createGatewayReq
@@ -323,3 +417,244 @@ func mintTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
 	}
 }
+
+func burnTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		tokenId := vars["token-id"]
+		var req burnTokenReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgBurnToken message
+		msg := asset.NewMsgBurnToken(tokenId, req.Sender, req.Amount, req.Redeem)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func freezeTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		tokenId := vars["token-id"]
+		var req freezeTokenReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgFreezeToken message
+		msg := asset.NewMsgFreezeToken(tokenId, req.Owner, req.Holder)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func unfreezeTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		tokenId := vars["token-id"]
+		var req freezeTokenReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgUnfreezeToken message
+		msg := asset.NewMsgUnfreezeToken(tokenId, req.Owner, req.Holder)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func editWhitelistHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		tokenId := vars["token-id"]
+		var req editWhitelistReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		whitelist, err := asset.ParseBool(req.Whitelist)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the MsgEditWhitelist message
+		msg := asset.NewMsgEditWhitelist(tokenId, req.Owner, whitelist, req.Add, req.Remove)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func mintNFTHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		collectionId := vars["id"]
+		var req mintNFTReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgMintNFT message
+		msg := asset.NewMsgMintNFT(req.Owner, collectionId, req.TokenId, req.To, req.TokenURI)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func editNFTHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		collectionId := vars["id"]
+		tokenId := vars["nft-id"]
+		var req editNFTReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgEditNFT message
+		msg := asset.NewMsgEditNFT(req.Owner, collectionId, tokenId, req.TokenURI)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func transferNFTHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		collectionId := vars["id"]
+		tokenId := vars["nft-id"]
+		var req transferNFTReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgTransferNFT message
+		msg := asset.NewMsgTransferNFT(req.Sender, req.Recipient, collectionId, tokenId)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func burnNFTHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		collectionId := vars["id"]
+		tokenId := vars["nft-id"]
+		var req burnNFTReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgBurnNFT message
+		msg := asset.NewMsgBurnNFT(req.Owner, collectionId, tokenId)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
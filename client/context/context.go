@@ -10,6 +10,7 @@ import (
 	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/asset"
 	"github.com/irisnet/irishub/app/v1/auth"
+	stake "github.com/irisnet/irishub/app/v1/stake/types"
 	"github.com/irisnet/irishub/client"
 	"github.com/irisnet/irishub/client/keys"
 	"github.com/irisnet/irishub/codec"
@@ -242,6 +243,8 @@ func (cliCtx CLIContext) GetCoinType(coinName string) (sdk.CoinType, error) {
 	}
 	if coinName == sdk.Iris {
 		coinType = sdk.IrisCoinType
+	} else if stake.IsShareTokenName(coinName) {
+		coinType = stake.ShareTokenCoinType(coinName)
 	} else {
 		params := asset.QueryTokenParams{
 			TokenId: coinName,
@@ -3,4 +3,7 @@ package cli
 const (
 	FlagAddressDelegator = "address-delegator"
 	FlagAddressValidator = "address-validator"
+	FlagRecipient        = "recipient"
+	FlagValidators       = "validators"
+	FlagMinReward        = "min-reward"
 )
@@ -9,6 +9,7 @@ import (
 	"github.com/irisnet/irishub/codec"
 	sdk "github.com/irisnet/irishub/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetWithdrawAddress returns withdraw address of a given delegator address
@@ -89,3 +90,82 @@ func GetRewards(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+// GetBudgetStreams returns the active budget streams paid from the community tax pool
+func GetBudgetStreams(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "budget-streams",
+		Short:   "Query the active budget streams and their remaining amounts",
+		Example: "iriscli distribution budget-streams --recipient=<recipient address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var recipient sdk.AccAddress
+			if recipientStr := viper.GetString(FlagRecipient); len(recipientStr) > 0 {
+				var err error
+				recipient, err = sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := distribution.NewQueryBudgetStreamsParams(recipient)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", protocol.DistrRoute, distribution.QueryBudgetStreams),
+				bz)
+			if err != nil {
+				return err
+			}
+
+			var streams distribution.BudgetStreamOutputs
+			err = cdc.UnmarshalJSON(res, &streams)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(streams)
+		},
+	}
+	cmd.Flags().String(FlagRecipient, "", "only list the budget streams paid to the recipient")
+	return cmd
+}
+
+// GetRestakeConfig returns the restake config of a given delegator address
+func GetRestakeConfig(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "restake-config",
+		Short:   "Query the restake config of a delegator and its last run height",
+		Example: "iriscli distribution restake-config <delegator address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := distribution.NewQueryDelegatorParams(delAddr)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", protocol.DistrRoute, distribution.QueryRestakeConfig),
+				bz)
+			if err != nil {
+				return err
+			}
+
+			var config distribution.RestakeConfig
+			err = cdc.UnmarshalJSON(res, &config)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(config)
+		},
+	}
+}
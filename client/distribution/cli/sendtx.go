@@ -4,6 +4,8 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/irisnet/irishub/app/v1/distribution/types"
 	"github.com/irisnet/irishub/client/context"
@@ -113,3 +115,117 @@ func GetCmdSetWithdrawAddr(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+// GetCmdCancelBudgetStream implements the cancel budget stream command.
+func GetCmdCancelBudgetStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-budget-stream [stream-id]",
+		Short:   "cancel an active budget stream, only the trustees are allowed",
+		Example: "iriscli distribution cancel-budget-stream <stream id> --from <key name> --fee=0.4iris --chain-id=<chain-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			canceller, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelBudgetStream(streamID, canceller)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdSetRestake implements the command opting in the restaking of the delegator's rewards.
+func GetCmdSetRestake(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-restake",
+		Short:   "delegate the rewards again automatically, or update the restake setting",
+		Example: "iriscli distribution set-restake --validators=<validator address>,<validator address> --min-reward=1iris --from <key name> --fee=0.4iris --chain-id=<chain-id>",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var validators []sdk.ValAddress
+			if validatorsStr := viper.GetString(FlagValidators); len(validatorsStr) > 0 {
+				for _, valStr := range strings.Split(validatorsStr, ",") {
+					valAddr, err := sdk.ValAddressFromBech32(strings.TrimSpace(valStr))
+					if err != nil {
+						return err
+					}
+					validators = append(validators, valAddr)
+				}
+			}
+
+			minReward := sdk.ZeroInt()
+			if minRewardStr := viper.GetString(FlagMinReward); len(minRewardStr) > 0 {
+				minRewardCoin, err := cliCtx.ParseCoin(minRewardStr)
+				if err != nil {
+					return err
+				}
+				minReward = minRewardCoin.Amount
+			}
+
+			msg := types.NewMsgSetRestake(delAddr, validators, minReward)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagValidators, "", "comma separated validator addresses whose delegations are restaked, all the delegations if empty")
+	cmd.Flags().String(FlagMinReward, "", "minimum reward of a delegation to restake it")
+	return cmd
+}
+
+// GetCmdRemoveRestake implements the command opting out the restaking of the delegator's rewards.
+func GetCmdRemoveRestake(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-restake",
+		Short:   "stop delegating the rewards again automatically",
+		Example: "iriscli distribution remove-restake --from <key name> --fee=0.4iris --chain-id=<chain-id>",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).WithCliCtx(cliCtx)
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveRestake(delAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
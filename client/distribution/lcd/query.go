@@ -70,3 +70,60 @@ func QueryRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.PostProcessResponse(w, cliCtx.Codec, res, cliCtx.Indent)
 	}
 }
+
+// QueryBudgetStreamsHandlerFn query the active budget streams, optionally filtered by recipient
+func QueryBudgetStreamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var recipient sdk.AccAddress
+		if recipientStr := r.URL.Query().Get("recipient"); len(recipientStr) > 0 {
+			var err error
+			recipient, err = sdk.AccAddressFromBech32(recipientStr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := distribution.NewQueryBudgetStreamsParams(recipient)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		res, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", protocol.DistrRoute, distribution.QueryBudgetStreams),
+			bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cliCtx.Codec, res, cliCtx.Indent)
+	}
+}
+
+// QueryRestakeConfigHandlerFn query the restake config of a delegator
+func QueryRestakeConfigHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		delAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distribution.NewQueryDelegatorParams(delAddr)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		res, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", protocol.DistrRoute, distribution.QueryRestakeConfig),
+			bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cliCtx.Codec, res, cliCtx.Indent)
+	}
+}
@@ -10,9 +10,16 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/distribution/{delegatorAddr}/withdraw-address", SetWithdrawAddressHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/distribution/{delegatorAddr}/rewards/withdraw", WithdrawRewardsHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/distribution/budget-streams/{streamID}/cancel", CancelBudgetStreamHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/distribution/{delegatorAddr}/restake", SetRestakeHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/distribution/{delegatorAddr}/restake/remove", RemoveRestakeHandlerFn(cdc, cliCtx)).Methods("POST")
 
 	r.HandleFunc("/distribution/{delegatorAddr}/withdraw-address",
 		QueryWithdrawAddressHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/distribution/{address}/rewards",
 		QueryRewardsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/distribution/budget-streams",
+		QueryBudgetStreamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/distribution/{delegatorAddr}/restake",
+		QueryRestakeConfigHandlerFn(cliCtx)).Methods("GET")
 }
@@ -2,6 +2,7 @@ package lcd
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/irisnet/irishub/app/v1/distribution/types"
@@ -97,3 +98,114 @@ func WithdrawRewardsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.
 		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
 	}
 }
+
+type cancelBudgetStreamBody struct {
+	Canceller sdk.AccAddress `json:"canceller"`
+	BaseTx    utils.BaseTx   `json:"base_tx"`
+}
+
+// CancelBudgetStreamHandlerFn - http request handler to cancel a budget stream
+func CancelBudgetStreamHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		streamID, err := strconv.ParseUint(vars["streamID"], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var m cancelBudgetStreamBody
+		err = utils.ReadPostBody(w, r, cdc, &m)
+		if err != nil {
+			return
+		}
+		baseReq := m.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelBudgetStream(streamID, m.Canceller)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+type setRestakeBody struct {
+	Validators []sdk.ValAddress `json:"validators"`
+	MinReward  sdk.Int          `json:"min_reward"`
+	BaseTx     utils.BaseTx     `json:"base_tx"`
+}
+
+// SetRestakeHandlerFn - http request handler to opt in the restaking of the delegator's rewards
+func SetRestakeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		delegatorAddress, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var m setRestakeBody
+		err = utils.ReadPostBody(w, r, cdc, &m)
+		if err != nil {
+			return
+		}
+		baseReq := m.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		minReward := m.MinReward
+		if minReward == (sdk.Int{}) {
+			minReward = sdk.ZeroInt()
+		}
+		msg := types.NewMsgSetRestake(delegatorAddress, m.Validators, minReward)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+type removeRestakeBody struct {
+	BaseTx utils.BaseTx `json:"base_tx"`
+}
+
+// RemoveRestakeHandlerFn - http request handler to opt out the restaking of the delegator's rewards
+func RemoveRestakeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		delegatorAddress, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var m removeRestakeBody
+		err = utils.ReadPostBody(w, r, cdc, &m)
+		if err != nil {
+			return
+		}
+		baseReq := m.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRemoveRestake(delegatorAddress)
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
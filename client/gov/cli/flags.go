@@ -8,6 +8,7 @@ const (
 	flagDeposit      = "deposit"
 	flagVoter        = "voter"
 	flagOption       = "option"
+	flagOptions      = "options"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
@@ -24,6 +25,9 @@ const (
 	flagSwitchHeight = "switch-height"
 	flagThreshold    = "threshold"
 
+	//for scheduled ParameterProposal
+	flagActivationHeight = "activation-height"
+
 	//for addTokenProposal
 	flagTokenSymbol          = "token-symbol"
 	flagTokenCanonicalSymbol = "token-canonical-symbol"
@@ -31,4 +35,25 @@ const (
 	flagTokenDecimal         = "token-decimal"
 	flagTokenMinUnitAlias    = "token-min-unit-alias"
 	flagTokenInitialSupply   = "token-initial-supply"
+
+	//for MsgExecutionProposal
+	flagMsgs = "msgs"
+
+	//for CommunityBudgetProposal
+	flagBudgetAmount    = "budget-amount"
+	flagStartHeight     = "start-height"
+	flagEndHeight       = "end-height"
+	flagTrancheInterval = "tranche-interval"
+
+	//for GuardianProposal
+	flagGuardianAction      = "guardian-action"
+	flagGuardianRole        = "guardian-role"
+	flagGuardianAddress     = "guardian-address"
+	flagNewGuardianAddress  = "new-guardian-address"
+	flagGuardianDescription = "guardian-description"
+	flagExpiryHeight        = "expiry-height"
+
+	//for vote representatives
+	flagDelegator      = "delegator"
+	flagRepresentative = "representative"
 )
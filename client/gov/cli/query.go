@@ -168,6 +168,47 @@ func GetCmdQueryVote(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryRepresentative implements the command to query the voting representative of a delegator.
+func GetCmdQueryRepresentative(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-representative",
+		Short:   "query the voting representative of a delegator",
+		Example: "iriscli gov query-representative --delegator=<delegator address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagDelegator))
+			if err != nil {
+				return err
+			}
+
+			params := gov.QueryRepresentativeParams{
+				Delegator: delegatorAddr,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.GovRoute, gov.QueryRepresentative), bz)
+			if err != nil {
+				return err
+			}
+
+			var representation gov.Representation
+			if err := cdc.UnmarshalJSON(res, &representation); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(representation)
+		},
+	}
+
+	cmd.Flags().String(flagDelegator, "", "bech32 delegator address")
+	cmd.MarkFlagRequired(flagDelegator)
+	return cmd
+}
+
 // GetCmdQueryVotes implements the command to query for proposal votes.
 func GetCmdQueryVotes(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -324,3 +365,79 @@ func GetCmdQueryTally(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryRefund implements the query refund command.
+func GetCmdQueryRefund(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-refund",
+		Short:   "Get the expected refund of the deposits of a proposal if it ended now",
+		Example: "iriscli gov query-refund --proposal-id=4",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			params := gov.QueryRefundParams{
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/refund", protocol.GovRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var outcome gov.RefundOutcome
+			if err := cdc.UnmarshalJSON(res, &outcome); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(outcome)
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's deposits are being settled")
+	cmd.MarkFlagRequired(flagProposalID)
+
+	return cmd
+}
+
+// GetCmdSimulateProposal implements the simulate proposal command.
+func GetCmdSimulateProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-proposal",
+		Short:   "Simulate the result and the execution of a proposal in voting period as if its voting period ended now",
+		Example: "iriscli gov simulate-proposal --proposal-id=4",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+
+			params := gov.QuerySimulateParams{
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/simulate", protocol.GovRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var simulation gov.ProposalSimulation
+			if err := cdc.UnmarshalJSON(res, &simulation); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(simulation)
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal is being simulated")
+	cmd.MarkFlagRequired(flagProposalID)
+
+	return cmd
+}
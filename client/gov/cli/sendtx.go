@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -61,6 +62,8 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 				if err := client.ValidateParam(params[0]); err != nil {
 					return err
 				}
+				params[0].ActivationHeight = viper.GetInt64(flagActivationHeight)
+				params[0].ExpiryHeight = viper.GetInt64(flagExpiryHeight)
 			}
 			msg := gov.NewMsgSubmitProposal(title, description, proposalType, fromAddr, amount, params)
 			if proposalType == gov.ProposalTypeCommunityTaxUsage {
@@ -122,18 +125,77 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 				msg := gov.NewMsgSubmitTokenAdditionProposal(msg, symbol, canonicalSymbol, name, alias, decimal, initialSupply)
 				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 			}
+
+			if proposalType == gov.ProposalTypeCommunityBudget {
+				recipient, err := sdk.AccAddressFromBech32(viper.GetString(flagDestAddress))
+				if err != nil {
+					return err
+				}
+				budget, err := cliCtx.ParseCoins(viper.GetString(flagBudgetAmount))
+				if err != nil {
+					return err
+				}
+				startHeight := viper.GetInt64(flagStartHeight)
+				endHeight := viper.GetInt64(flagEndHeight)
+				trancheInterval := viper.GetInt64(flagTrancheInterval)
+
+				msg := gov.NewMsgSubmitCommunityBudgetProposal(msg, recipient, budget, startHeight, endHeight, trancheInterval)
+				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+			}
+
+			if proposalType == gov.ProposalTypeGuardian {
+				action, err := gov.GuardianActionFromString(viper.GetString(flagGuardianAction))
+				if err != nil {
+					return err
+				}
+				role, err := gov.GuardianRoleFromString(viper.GetString(flagGuardianRole))
+				if err != nil {
+					return err
+				}
+				address, err := sdk.AccAddressFromBech32(viper.GetString(flagGuardianAddress))
+				if err != nil {
+					return err
+				}
+				var newAddress sdk.AccAddress
+				if newAddressStr := viper.GetString(flagNewGuardianAddress); len(newAddressStr) > 0 {
+					newAddress, err = sdk.AccAddressFromBech32(newAddressStr)
+					if err != nil {
+						return err
+					}
+				}
+				change := gov.GuardianChange{
+					Action:       action,
+					Role:         role,
+					Address:      address,
+					NewAddress:   newAddress,
+					Description:  viper.GetString(flagGuardianDescription),
+					ExpiryHeight: viper.GetInt64(flagExpiryHeight),
+				}
+				msg := gov.NewMsgSubmitGuardianProposal(msg, change)
+				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+			}
+
+			if proposalType == gov.ProposalTypeMsgExecution {
+				msgs, err := getMsgsFromFile(cdc, viper.GetString(flagMsgs))
+				if err != nil {
+					return err
+				}
+				msg := gov.NewMsgSubmitMsgExecutionProposal(msg, msgs)
+				return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+			}
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagTitle, "", "title of proposal")
 	cmd.Flags().String(flagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal,eg:PlainText/Parameter/SoftwareUpgrade/SystemHalt/CommunityTaxUsage/TokenAddition")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal,eg:PlainText/Parameter/SoftwareUpgrade/SystemHalt/CommunityTaxUsage/TokenAddition/MsgExecution/CommunityBudget/Guardian")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal(at least 30% of MinDeposit)")
 	cmd.Flags().String(flagParam, "", "parameter of proposal,eg. key=value")
+	cmd.Flags().Int64(flagActivationHeight, 0, "the height at which the parameter change is applied, 0 means applied when the proposal passes")
 	cmd.Flags().String(flagUsage, "", "the transaction fee tax usage type, valid values can be Burn, Distribute and Grant")
 	cmd.Flags().String(flagPercent, "", "percent of transaction fee tax pool to use, integer or decimal >0 and <=1")
-	cmd.Flags().String(flagDestAddress, "", "the destination trustee address")
+	cmd.Flags().String(flagDestAddress, "", "the destination trustee address, or the recipient of a community budget")
 
 	cmd.Flags().String(flagVersion, "0", "the version of the new protocol")
 	cmd.Flags().String(flagSoftware, " ", "the software of the new protocol")
@@ -148,15 +210,48 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagTokenMinUnitAlias, "", "the asset symbol minimum alias")
 	cmd.Flags().Uint64(flagTokenInitialSupply, 0, "the initial supply token of asset")
 
+	//for CommunityBudgetProposal
+	cmd.Flags().String(flagBudgetAmount, "", "total amount of the budget paid from the community tax pool")
+	cmd.Flags().Int64(flagStartHeight, 0, "the height from which the budget is released")
+	cmd.Flags().Int64(flagEndHeight, 0, "the height at which the whole budget has been released")
+	cmd.Flags().Int64(flagTrancheInterval, 1, "the number of blocks between two payouts of the budget")
+
+	//for GuardianProposal
+	cmd.Flags().String(flagGuardianAction, "", "the change of the guardians, valid values can be Add, Remove and Rotate")
+	cmd.Flags().String(flagGuardianRole, "", "the role of the changed guardian, valid values can be Profiler and Trustee")
+	cmd.Flags().String(flagGuardianAddress, "", "the address of the added, removed or rotated guardian")
+	cmd.Flags().String(flagNewGuardianAddress, "", "the address replacing the rotated guardian")
+	cmd.Flags().String(flagGuardianDescription, "", "the description of the added or rotated guardian")
+	cmd.Flags().Int64(flagExpiryHeight, 0, "the height at which the term of the guardian ends or the previous value of the parameter is restored, 0 means no term limit or keeps the current term on rotation, or a permanent parameter change")
+
+	//for MsgExecutionProposal
+	cmd.Flags().String(flagMsgs, "", "path to a JSON file containing the msgs to execute, each signed by the gov module account")
+
 	cmd.MarkFlagRequired(flagTitle)
 	cmd.MarkFlagRequired(flagDescription)
 	cmd.MarkFlagRequired(flagProposalType)
 	return cmd
 }
 
+// getMsgsFromFile reads a JSON array of amino encoded msgs
+func getMsgsFromFile(cdc *codec.Codec, path string) ([]sdk.Msg, error) {
+	if len(path) == 0 {
+		return nil, errors.New("the msgs file of MsgExecutionProposal is required")
+	}
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
 func getParamFromString(paramsStr string) (gov.Params, error) {
 	var govParams gov.Params
-	str := strings.Split(paramsStr, "=")
+	str := strings.SplitN(paramsStr, "=", 2)
 	if len(str) != 2 {
 		return gov.Params{}, fmt.Errorf("%s is not valid", paramsStr)
 	}
@@ -217,9 +312,12 @@ func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 // GetCmdVote implements creating a new vote command.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "vote",
-		Short:   "vote for an active proposal, options: Yes/No/NoWithVeto/Abstain",
-		Example: "iriscli gov vote --chain-id=<chain-id> --from=<key name> --fee=0.4iris --proposal-id=1 --option=Yes",
+		Use:   "vote",
+		Short: "vote for an active proposal, options: Yes/No/NoWithVeto/Abstain",
+		Long: `vote for an active proposal with a single option, or split the voting power
+among several options with --options, where the weights must sum up to 1`,
+		Example: `iriscli gov vote --chain-id=<chain-id> --from=<key name> --fee=0.4iris --proposal-id=1 --option=Yes
+iriscli gov vote --chain-id=<chain-id> --from=<key name> --fee=0.4iris --proposal-id=1 --options="Yes=0.6,No=0.4"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().
 				WithCodec(cdc).
@@ -235,22 +333,39 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 
 			proposalID := uint64(viper.GetInt64(flagProposalID))
 			option := viper.GetString(flagOption)
-
-			byteVoteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(option))
-			if err != nil {
-				return err
+			options := viper.GetString(flagOptions)
+
+			var msg sdk.Msg
+			switch {
+			case len(option) != 0 && len(options) != 0:
+				return fmt.Errorf("only one of --%s and --%s can be specified", flagOption, flagOptions)
+			case len(options) != 0:
+				weightedOptions, err := gov.WeightedVoteOptionsFromString(client.NormalizeWeightedVoteOptions(options))
+				if err != nil {
+					return err
+				}
+				msg = gov.NewMsgWeightedVote(voterAddr, proposalID, weightedOptions)
+				fmt.Printf("Vote[Voter:%s,ProposalID:%d,Options:%s]",
+					voterAddr.String(), proposalID, weightedOptions.String(),
+				)
+			case len(option) != 0:
+				byteVoteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(option))
+				if err != nil {
+					return err
+				}
+				msg = gov.NewMsgVote(voterAddr, proposalID, byteVoteOption)
+				fmt.Printf("Vote[Voter:%s,ProposalID:%d,Option:%s]",
+					voterAddr.String(), proposalID, byteVoteOption.String(),
+				)
+			default:
+				return fmt.Errorf("either --%s or --%s is required", flagOption, flagOptions)
 			}
 
-			msg := gov.NewMsgVote(voterAddr, proposalID, byteVoteOption)
-
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("Vote[Voter:%s,ProposalID:%d,Option:%s]",
-				voterAddr.String(), msg.ProposalID, msg.Option.String(),
-			)
 			// Build and sign the transaction, then broadcast to a Tendermint
 			// node.
 			cliCtx.PrintResponse = true
@@ -261,7 +376,141 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of proposal voting on")
 	cmd.Flags().String(flagOption, "", "vote option {Yes, No, NoWithVeto, Abstain}")
+	cmd.Flags().String(flagOptions, "", "weighted vote options splitting the voting power, e.g. Yes=0.6,No=0.4")
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdSetRepresentative implements the command to set the voting representative of a delegator
+func GetCmdSetRepresentative(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-representative",
+		Short: "set the representative voting on behalf of the sender, an empty representative removes it",
+		Long: `set the representative which votes on behalf of the delegator. The vote of the representative
+is counted for the delegations of the delegator unless the delegator votes itself`,
+		Example: "iriscli gov set-representative --chain-id=<chain-id> --from=<key name> --fee=0.4iris --representative=<representative address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var representative sdk.AccAddress
+			if representativeStr := viper.GetString(flagRepresentative); len(representativeStr) != 0 {
+				representative, err = sdk.AccAddressFromBech32(representativeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := gov.NewMsgSetRepresentative(delegatorAddr, representative)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRepresentative, "", "bech32 address of the representative, empty to remove the current one")
+	return cmd
+}
+
+// GetCmdCancelProposal implements the command to cancel a proposal by its proposer
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-proposal",
+		Short:   "cancel a proposal in deposit or voting period, a share of the deposits is burned",
+		Example: "iriscli gov cancel-proposal --chain-id=<chain-id> --from=<key name> --fee=0.4iris --proposal-id=1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			proposerAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+			msg := gov.NewMsgCancelProposal(proposerAddr, proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal to cancel")
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdAmendProposal implements the command to amend a proposal in deposit period by its proposer
+func GetCmdAmendProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend-proposal",
+		Short:   "amend the title, description or parameter change of a proposal in deposit period",
+		Example: "iriscli gov amend-proposal --chain-id=<chain-id> --from=<key name> --fee=0.4iris --proposal-id=1 --param='mint/Inflation=0.050'",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			proposerAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var params gov.Params
+			if paramStr := viper.GetString(flagParam); len(paramStr) != 0 {
+				params, err = getParamFromString(paramStr)
+				if err != nil {
+					return err
+				}
+				if err := client.ValidateParam(params[0]); err != nil {
+					return err
+				}
+				params[0].ActivationHeight = viper.GetInt64(flagActivationHeight)
+				params[0].ExpiryHeight = viper.GetInt64(flagExpiryHeight)
+			}
+
+			proposalID := uint64(viper.GetInt64(flagProposalID))
+			msg := gov.NewMsgAmendProposal(proposerAddr, proposalID, viper.GetString(flagTitle), viper.GetString(flagDescription), params)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal to amend")
+	cmd.Flags().String(flagTitle, "", "new title of proposal, empty to keep it")
+	cmd.Flags().String(flagDescription, "", "new description of proposal, empty to keep it")
+	cmd.Flags().String(flagParam, "", "new parameter of a parameter proposal, eg. key=value")
+	cmd.Flags().Int64(flagActivationHeight, 0, "the height at which the new parameter change is applied, 0 means applied when the proposal passes")
+	cmd.Flags().Int64(flagExpiryHeight, 0, "the height at which the previous value of the new parameter is restored, 0 means a permanent change")
 	cmd.MarkFlagRequired(flagProposalID)
-	cmd.MarkFlagRequired(flagOption)
 	return cmd
 }
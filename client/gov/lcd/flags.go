@@ -6,4 +6,5 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestDelegator      = "delegator"
 )
@@ -14,17 +14,28 @@ import (
 )
 
 type postProposalReq struct {
-	BaseTx         utils.BaseTx   `json:"base_tx"`
-	Title          string         `json:"title"`           //  Title of the proposal
-	Description    string         `json:"description"`     //  Description of the proposal
-	ProposalType   string         `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
-	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
-	InitialDeposit string         `json:"initial_deposit"` // Coins to add to the proposal's deposit
-	Param          gov.Param      `json:"param"`
-	Usage          gov.UsageType  `json:"usage"`
-	DestAddress    sdk.AccAddress `json:"dest_address"`
-	Percent        sdk.Dec        `json:"percent"`
-	Token          token          `json:"token"`
+	BaseTx         utils.BaseTx       `json:"base_tx"`
+	Title          string             `json:"title"`           //  Title of the proposal
+	Description    string             `json:"description"`     //  Description of the proposal
+	ProposalType   string             `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	Proposer       sdk.AccAddress     `json:"proposer"`        //  Address of the proposer
+	InitialDeposit string             `json:"initial_deposit"` // Coins to add to the proposal's deposit
+	Param          gov.Param          `json:"param"`
+	Usage          gov.UsageType      `json:"usage"`
+	DestAddress    sdk.AccAddress     `json:"dest_address"`
+	Percent        sdk.Dec            `json:"percent"`
+	Token          token              `json:"token"`
+	Msgs           []sdk.Msg          `json:"msgs"`
+	Budget         budget             `json:"budget"`
+	Guardian       gov.GuardianChange `json:"guardian"`
+}
+
+type budget struct {
+	Recipient       sdk.AccAddress `json:"recipient"`
+	Amount          string         `json:"amount"`
+	StartHeight     int64          `json:"start_height"`
+	EndHeight       int64          `json:"end_height"`
+	TrancheInterval int64          `json:"tranche_interval"`
 }
 
 type token struct {
@@ -43,9 +54,28 @@ type depositReq struct {
 }
 
 type voteReq struct {
-	BaseTx utils.BaseTx   `json:"base_tx"`
-	Voter  sdk.AccAddress `json:"voter"`  //  address of the voter
-	Option string         `json:"option"` //  option from OptionSet chosen by the voter
+	BaseTx  utils.BaseTx   `json:"base_tx"`
+	Voter   sdk.AccAddress `json:"voter"`   //  address of the voter
+	Option  string         `json:"option"`  //  option from OptionSet chosen by the voter
+	Options string         `json:"options"` //  weighted options splitting the voting power, e.g. "Yes=0.6,No=0.4"
+}
+
+type cancelProposalReq struct {
+	BaseTx   utils.BaseTx   `json:"base_tx"`
+	Proposer sdk.AccAddress `json:"proposer"` //  address of the proposer
+}
+
+type amendProposalReq struct {
+	BaseTx      utils.BaseTx   `json:"base_tx"`
+	Proposer    sdk.AccAddress `json:"proposer"`    //  address of the proposer
+	Title       string         `json:"title"`       //  new title of the proposal, empty to keep it
+	Description string         `json:"description"` //  new description of the proposal, empty to keep it
+	Param       gov.Param      `json:"param"`       //  new parameter change of a parameter proposal, empty to keep it
+}
+
+type setRepresentativeReq struct {
+	BaseTx         utils.BaseTx   `json:"base_tx"`
+	Representative sdk.AccAddress `json:"representative"` //  address voting on behalf of the delegator, empty to remove it
 }
 
 func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -103,6 +133,38 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{tokenMsg})
 			return
 		}
+		if msg.ProposalType == gov.ProposalTypeCommunityBudget {
+			amount, err := cliCtx.ParseCoins(req.Budget.Amount)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			budgetMsg := gov.NewMsgSubmitCommunityBudgetProposal(msg, req.Budget.Recipient, amount, req.Budget.StartHeight, req.Budget.EndHeight, req.Budget.TrancheInterval)
+			if err := budgetMsg.ValidateBasic(); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{budgetMsg})
+			return
+		}
+		if msg.ProposalType == gov.ProposalTypeGuardian {
+			guardianMsg := gov.NewMsgSubmitGuardianProposal(msg, req.Guardian)
+			if err := guardianMsg.ValidateBasic(); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{guardianMsg})
+			return
+		}
+		if msg.ProposalType == gov.ProposalTypeMsgExecution {
+			execMsg := gov.NewMsgSubmitMsgExecutionProposal(msg, req.Msgs)
+			if err := execMsg.ValidateBasic(); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{execMsg})
+			return
+		}
 
 		err = msg.ValidateBasic()
 		if err != nil {
@@ -187,14 +249,132 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		voteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(req.Option))
+		// create the message
+		var msg sdk.Msg
+		if len(req.Options) != 0 {
+			options, err := gov.WeightedVoteOptionsFromString(client.NormalizeWeightedVoteOptions(req.Options))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = gov.NewMsgWeightedVote(req.Voter, proposalID, options)
+		} else {
+			voteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(req.Option))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = gov.NewMsgVote(req.Voter, proposalID, voteOption)
+		}
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func setRepresentativeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars[RestDelegator])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req setRepresentativeReq
+		err = utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgSetRepresentative(delegatorAddr, req.Representative)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func cancelProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		proposalID, ok := utils.ParseUint64OrReturnBadRequest(w, vars[RestProposalID])
+		if !ok {
+			return
+		}
+
+		var req cancelProposalReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := gov.NewMsgCancelProposal(req.Proposer, proposalID)
+		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func amendProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		proposalID, ok := utils.ParseUint64OrReturnBadRequest(w, vars[RestProposalID])
+		if !ok {
+			return
+		}
+
+		var req amendProposalReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		var params gov.Params
+		if len(req.Param.Key) != 0 {
+			if err := client.ValidateParam(req.Param); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = gov.Params{req.Param}
+		}
+
 		// create the message
-		msg := gov.NewMsgVote(req.Voter, proposalID, voteOption)
+		msg := gov.NewMsgAmendProposal(req.Proposer, proposalID, req.Title, req.Description, params)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
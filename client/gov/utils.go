@@ -1,12 +1,15 @@
 package gov
 
 import (
+	"strings"
+
 	"github.com/irisnet/irishub/app/v1/asset"
 	"github.com/irisnet/irishub/app/v1/auth"
 	distr "github.com/irisnet/irishub/app/v1/distribution"
 	"github.com/irisnet/irishub/app/v1/gov"
 	"github.com/irisnet/irishub/app/v1/mint"
 	"github.com/irisnet/irishub/app/v1/params"
+	"github.com/irisnet/irishub/app/v1/rand"
 	"github.com/irisnet/irishub/app/v1/service"
 	"github.com/irisnet/irishub/app/v1/slashing"
 	"github.com/irisnet/irishub/app/v1/stake"
@@ -16,7 +19,7 @@ import (
 var ParamSets = make(map[string]params.ParamSet)
 
 func init() {
-	params.RegisterParamSet(ParamSets, &mint.Params{}, &slashing.Params{}, &service.Params{}, &auth.Params{}, &stake.Params{}, &distr.Params{}, &asset.Params{}, &gov.GovParams{})
+	params.RegisterParamSet(ParamSets, &mint.Params{}, &slashing.Params{}, &service.Params{}, &auth.Params{}, &stake.Params{}, &distr.Params{}, &asset.Params{}, &rand.Params{}, &gov.GovParams{})
 }
 
 // Deposit
@@ -46,6 +49,17 @@ func NormalizeVoteOption(option string) string {
 	return option
 }
 
+//NormalizeWeightedVoteOptions - normalize the options of a user specified split vote, e.g. "yes=0.6,no=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	var normalized []string
+	for _, option := range strings.Split(options, ",") {
+		fields := strings.Split(strings.TrimSpace(option), "=")
+		fields[0] = NormalizeVoteOption(fields[0])
+		normalized = append(normalized, strings.Join(fields, "="))
+	}
+	return strings.Join(normalized, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -55,6 +69,12 @@ func NormalizeProposalType(proposalType string) string {
 		return "SoftwareUpgrade"
 	case "CommunityTaxUsage", "community_tax_usage":
 		return "CommunityTaxUsage"
+	case "MsgExecution", "msg_execution":
+		return "MsgExecution"
+	case "CommunityBudget", "community_budget":
+		return "CommunityBudget"
+	case "Guardian", "guardian":
+		return "Guardian"
 	}
 	return proposalType
 }
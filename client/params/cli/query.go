@@ -11,7 +11,11 @@ import (
 	"strings"
 )
 
-const flagModule = "module"
+const (
+	flagModule = "module"
+	flagStatus = "status"
+	flagKey    = "key"
+)
 
 func Commands(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -56,3 +60,86 @@ func Commands(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagModule, "", "module name can be stake/mint/distr/slashing/service/asset/auth")
 	return cmd
 }
+
+// GetCmdQueryParamChanges implements the query of the parameter changes scheduled by proposals
+func GetCmdQueryParamChanges(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "changes",
+		Short:   "query the parameter changes scheduled by proposals",
+		Example: "iriscli params changes --module=<module name> --status=<Pending|Active|Applied|Reverted|Superseded|Failed>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			status := strings.TrimSpace(viper.GetString(flagStatus))
+			if len(status) > 0 {
+				if _, err := params.ParamChangeStatusFromString(status); err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(params.QueryChangesParams{
+				Module: strings.TrimSpace(viper.GetString(flagModule)),
+				Status: status,
+			})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.ParamsRoute, params.QueryChanges), bz)
+			if err != nil {
+				return err
+			}
+
+			var changes params.ParamChanges
+			if err := cdc.UnmarshalJSON(res, &changes); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+
+	cmd.Flags().String(flagModule, "", "module name of the changed parameters, empty for all the modules")
+	cmd.Flags().String(flagStatus, "", "status of the changes: Pending, Active, Applied, Reverted, Superseded or Failed, empty for all")
+	return cmd
+}
+
+// GetCmdQueryParamHistory implements the query of the history of the parameter changes
+func GetCmdQueryParamHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "query the history of the parameter changes",
+		Example: "iriscli params history --module=<module name> --key=<parameter key>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			module := strings.TrimSpace(viper.GetString(flagModule))
+			key := strings.TrimSpace(viper.GetString(flagKey))
+			if len(module) == 0 && len(key) > 0 {
+				return fmt.Errorf("--%s is required when --%s is specified", flagModule, flagKey)
+			}
+
+			bz, err := cdc.MarshalJSON(params.QueryHistoryParams{
+				Module: module,
+				Key:    key,
+			})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.ParamsRoute, params.QueryHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			var records params.ParamRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().String(flagModule, "", "module name of the changed parameters, empty for all the modules")
+	cmd.Flags().String(flagKey, "", "key of the changed parameter, empty for all the parameters of the module")
+	return cmd
+}
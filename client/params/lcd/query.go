@@ -33,3 +33,60 @@ func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func queryParamChangesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := r.FormValue("status")
+		if len(status) > 0 {
+			if _, err := params.ParamChangeStatusFromString(status); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(params.QueryChangesParams{
+			Module: r.FormValue("module"),
+			Status: status,
+		})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.ParamsRoute, params.QueryChanges), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryParamHistoryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		module := r.FormValue("module")
+		key := r.FormValue("key")
+		if len(module) == 0 && len(key) > 0 {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "the module of the key must be specified")
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(params.QueryHistoryParams{
+			Module: module,
+			Key:    key,
+		})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.ParamsRoute, params.QueryHistory), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
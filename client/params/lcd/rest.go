@@ -9,4 +9,6 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/params", queryParamsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/params/changes", queryParamChangesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/params/history", queryParamHistoryHandlerFn(cdc, cliCtx)).Methods("GET")
 }
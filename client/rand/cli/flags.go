@@ -10,16 +10,24 @@ const (
 	FlagConsumer      = "consumer"
 	FlagBlockInterval = "block-interval"
 	FlagQueueHeight   = "queue-height"
+	FlagSource        = "source"
+	FlagSeed          = "seed"
 )
 
 var (
-	FsRequestRand = flag.NewFlagSet("", flag.ContinueOnError)
-	FsQueryRand   = flag.NewFlagSet("", flag.ContinueOnError)
-	FsQueryQueue  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRequestRand           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryRand             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryQueue            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSeed                  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryConsumerRequests = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsRequestRand.Uint64(FlagBlockInterval, rand.DefaultBlockInterval, "the block interval")
+	FsRequestRand.String(FlagSource, string(rand.RandSourcePRNG), "the source of the random number, prng or commit-reveal")
+	FsQueryConsumerRequests.String(FlagConsumer, "", "the consumer address")
+	FsSeed.String(FlagReqID, "", "the request id")
+	FsSeed.String(FlagSeed, "", "hex encoded seed of the validator")
 	FsQueryRand.String(FlagReqID, "", "the request id")
 	FsQueryQueue.Int64(FlagQueueHeight, 0, "optional height")
 }
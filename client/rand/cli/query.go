@@ -9,6 +9,7 @@ import (
 	"github.com/irisnet/irishub/client/context"
 	"github.com/irisnet/irishub/client/rand/types"
 	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -105,3 +106,91 @@ func GetCmdQueryRandRequestQueue(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryParticipation implements the query-participation command.
+func GetCmdQueryParticipation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-participation",
+		Short:   "Query the participation of the validators in a commit-reveal random number request",
+		Example: "iriscli rand query-participation --request-id=<request id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			reqID := viper.GetString(FlagReqID)
+			if err := rand.CheckReqID(reqID); err != nil {
+				return err
+			}
+
+			params := rand.QueryRandParams{
+				ReqID: reqID,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.RandRoute, rand.QueryParticipation), bz)
+			if err != nil {
+				return err
+			}
+
+			var participation rand.Participation
+			err = cdc.UnmarshalJSON(res, &participation)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(participation)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryRand)
+	cmd.MarkFlagRequired(FlagReqID)
+
+	return cmd
+}
+
+// GetCmdQueryConsumerRequests implements the query-consumer-requests command.
+func GetCmdQueryConsumerRequests(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-consumer-requests",
+		Short:   "Query the pending random number requests of a consumer",
+		Example: "iriscli rand query-consumer-requests --consumer=<consumer address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consumer, err := sdk.AccAddressFromBech32(viper.GetString(FlagConsumer))
+			if err != nil {
+				return err
+			}
+
+			params := rand.QueryConsumerRequestsParams{
+				Consumer: consumer,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", protocol.RandRoute, rand.QueryConsumerRequests), bz)
+			if err != nil {
+				return err
+			}
+
+			var requests rand.Requests
+			err = cdc.UnmarshalJSON(res, &requests)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(requests)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryConsumerRequests)
+	cmd.MarkFlagRequired(FlagConsumer)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"os"
 
 	"github.com/irisnet/irishub/app/v1/rand"
@@ -17,7 +18,7 @@ func GetCmdRequestRand(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "request-rand",
 		Short:   "request a random number",
-		Example: "iriscli rand request-rand --block-interval=10",
+		Example: "iriscli rand request-rand --block-interval=10 [--source=commit-reveal]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().
 				WithCodec(cdc).
@@ -31,9 +32,15 @@ func GetCmdRequestRand(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			source, err := rand.RandSourceFromString(viper.GetString(FlagSource))
+			if err != nil {
+				return err
+			}
+
 			msg := rand.MsgRequestRand{
 				Consumer:      consumer,
 				BlockInterval: uint64(viper.GetInt64(FlagBlockInterval)),
+				Source:        source,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -48,3 +55,87 @@ func GetCmdRequestRand(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdCommitSeed implements the commit-seed command
+func GetCmdCommitSeed(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "commit-seed",
+		Short:   "commit the seed hash of a validator to a commit-reveal random number request",
+		Example: "iriscli rand commit-seed --request-id=<request id> --seed=<hex seed>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			seed, err := hex.DecodeString(viper.GetString(FlagSeed))
+			if err != nil {
+				return err
+			}
+
+			// only the seed hash is committed, the seed must be kept for revealing
+			validator := sdk.ValAddress(from)
+			msg := rand.NewMsgCommitRandSeed(viper.GetString(FlagReqID), validator, rand.GenerateSeedHash(seed, validator))
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSeed)
+	cmd.MarkFlagRequired(FlagReqID)
+	cmd.MarkFlagRequired(FlagSeed)
+
+	return cmd
+}
+
+// GetCmdRevealSeed implements the reveal-seed command
+func GetCmdRevealSeed(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reveal-seed",
+		Short:   "reveal the seed committed by a validator",
+		Example: "iriscli rand reveal-seed --request-id=<request id> --seed=<hex seed>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			seed, err := hex.DecodeString(viper.GetString(FlagSeed))
+			if err != nil {
+				return err
+			}
+
+			msg := rand.NewMsgRevealRandSeed(viper.GetString(FlagReqID), sdk.ValAddress(from), seed)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSeed)
+	cmd.MarkFlagRequired(FlagReqID)
+	cmd.MarkFlagRequired(FlagSeed)
+
+	return cmd
+}
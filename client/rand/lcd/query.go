@@ -15,6 +15,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		queryRandHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// Get the participation of the validators in a commit-reveal request
+	r.HandleFunc(
+		"/rand/rands/{request-id}/participation",
+		queryParticipationHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the pending rand requests of a consumer
+	r.HandleFunc(
+		"/rand/consumers/{consumer}/requests",
+		queryConsumerRequestsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// Get the pending rand requests from queue
 	r.HandleFunc(
 		"/rand/queue",
@@ -31,3 +43,13 @@ func queryRandHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 func queryQueueHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return queryQueue(cliCtx, cdc, "custom/rand/queue")
 }
+
+// queryParticipationHandlerFn performs the participation query by the request id
+func queryParticipationHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryParticipation(cliCtx, cdc, "custom/rand/participation")
+}
+
+// queryConsumerRequestsHandlerFn performs the pending requests query by the consumer
+func queryConsumerRequestsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return queryConsumerRequests(cliCtx, cdc, "custom/rand/consumer_requests")
+}
@@ -1,6 +1,7 @@
 package lcd
 
 import (
+	"encoding/hex"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -17,12 +18,37 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/rand/rands",
 		requestRandHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+
+	// commit the seed hash of a validator
+	r.HandleFunc(
+		"/rand/rands/{request-id}/commit",
+		commitSeedHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// reveal the seed of a validator
+	r.HandleFunc(
+		"/rand/rands/{request-id}/reveal",
+		revealSeedHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 }
 
 type requestRandReq struct {
 	BaseTx        utils.BaseTx   `json:"base_tx"`        // base tx
 	Consumer      sdk.AccAddress `json:"consumer"`       // request address
 	BlockInterval uint64         `json:"block_interval"` // block interval
+	Source        string         `json:"source"`         // source of the random number
+}
+
+type commitSeedReq struct {
+	BaseTx    utils.BaseTx   `json:"base_tx"`   // base tx
+	Validator sdk.ValAddress `json:"validator"` // validator operator address
+	SeedHash  string         `json:"seed_hash"` // hex encoded seed hash
+}
+
+type revealSeedReq struct {
+	BaseTx    utils.BaseTx   `json:"base_tx"`   // base tx
+	Validator sdk.ValAddress `json:"validator"` // validator operator address
+	Seed      string         `json:"seed"`      // hex encoded seed
 }
 
 func requestRandHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -38,8 +64,86 @@ func requestRandHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 			return
 		}
 
+		source, err := rand.RandSourceFromString(req.Source)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// create the MsgRequestRand message
-		msg := rand.NewMsgRequestRand(req.Consumer, req.BlockInterval)
+		msg := rand.NewMsgRequestRand(req.Consumer, req.BlockInterval, source)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func commitSeedHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		reqID := vars["request-id"]
+
+		var req commitSeedReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		seedHash, err := hex.DecodeString(req.SeedHash)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the MsgCommitRandSeed message
+		msg := rand.NewMsgCommitRandSeed(reqID, req.Validator, seedHash)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func revealSeedHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		reqID := vars["request-id"]
+
+		var req revealSeedReq
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		seed, err := hex.DecodeString(req.Seed)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the MsgRevealRandSeed message
+		msg := rand.NewMsgRevealRandSeed(reqID, req.Validator, seed)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/irisnet/irishub/app/protocol"
+	"github.com/irisnet/irishub/app/v1/service"
+	"github.com/irisnet/irishub/client/context"
+	"github.com/irisnet/irishub/client/keys"
+	"github.com/irisnet/irishub/client/utils"
+	"github.com/irisnet/irishub/codec"
+	sdk "github.com/irisnet/irishub/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetCmdEncryptOutput(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt-output",
+		Short: "Encrypt the response data with the encryption pubkey of the consumer",
+		Example: "iriscli service encrypt-output --def-chain-id=<service-def-chain-id> --service-name=test " +
+			"--bind-chain-id=<bind-chain-id> --provider=<provider> --request-id=<request-id> --response-data=<resp>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			provider, err := sdk.AccAddressFromBech32(viper.GetString(FlagProvider))
+			if err != nil {
+				return err
+			}
+
+			output, err := hex.DecodeString(viper.GetString(FlagRespData))
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryBindingParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				BindChainId: viper.GetString(FlagBindChainID),
+				Provider:    provider,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryRequests)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var requests []service.SvcRequest
+			if err := cdc.UnmarshalJSON(res, &requests); err != nil {
+				return err
+			}
+
+			reqId := viper.GetString(FlagReqId)
+			for _, request := range requests {
+				if request.RequestID() != reqId {
+					continue
+				}
+				if len(request.EncryptionPubKey) == 0 {
+					return fmt.Errorf("the outputs of the request %s are not encrypted", reqId)
+				}
+
+				ciphertext, err := service.EncryptOutput(request.EncryptionPubKey, output)
+				if err != nil {
+					return err
+				}
+				fmt.Println(hex.EncodeToString(ciphertext))
+				return nil
+			}
+			return fmt.Errorf("the request %s is not active", reqId)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceBinding)
+	cmd.Flags().String(FlagReqId, "", "the ID of the service invocation")
+	cmd.Flags().String(FlagRespData, "", "hex encoded response data of a service invocation")
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagProvider)
+	cmd.MarkFlagRequired(FlagReqId)
+	cmd.MarkFlagRequired(FlagRespData)
+	return cmd
+}
+
+func GetCmdDecryptOutput(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "decrypt-output",
+		Short:   "Decrypt the response data of a service invocation with a local key",
+		Example: "iriscli service decrypt-output --request-chain-id=<req-chain-id> --request-id=<request-id> --name=<key name>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			params := service.QueryResponseParams{
+				ReqChainId: viper.GetString(FlagReqChainId),
+				RequestId:  viper.GetString(FlagReqId),
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// the request may be either unicast or multicast
+			var responses []service.SvcResponse
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryResponse)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err == nil {
+				var response service.SvcResponse
+				if err := cdc.UnmarshalJSON(res, &response); err != nil {
+					return err
+				}
+				responses = append(responses, response)
+			} else {
+				route = fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryMulticastResponses)
+				res, err = cliCtx.QueryWithData(route, bz)
+				if err != nil {
+					return err
+				}
+				if err := cdc.UnmarshalJSON(res, &responses); err != nil {
+					return err
+				}
+			}
+
+			name := viper.GetString(FlagName)
+			kb, err := keys.GetKeyBase()
+			if err != nil {
+				return err
+			}
+			passphrase, err := keys.GetPassphrase(name)
+			if err != nil {
+				return err
+			}
+			privKey, err := kb.ExportPrivateKeyObject(name, passphrase)
+			if err != nil {
+				return err
+			}
+
+			for _, response := range responses {
+				output, err := service.DecryptOutput(privKey, response.Output)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%s: %s\n", response.Provider, hex.EncodeToString(output))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagReqChainId, "", "the ID of the blockchain that the service invocation initiated")
+	cmd.Flags().String(FlagReqId, "", "the ID of the service invocation")
+	cmd.Flags().String(FlagName, "", "name of the local key to decrypt the response data")
+	cmd.MarkFlagRequired(FlagReqChainId)
+	cmd.MarkFlagRequired(FlagReqId)
+	cmd.MarkFlagRequired(FlagName)
+	return cmd
+}
+
+// getEncryptionPubKey returns the encryption pubkey of the key specified by --from
+func getEncryptionPubKey(cliCtx context.CLIContext) ([]byte, error) {
+	name, err := cliCtx.GetFromName()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := keys.GetKeyInfo(name)
+	if err != nil {
+		return nil, err
+	}
+	return service.GetEncryptionPubKey(info.GetPubKey())
+}
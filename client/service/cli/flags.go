@@ -29,6 +29,15 @@ const (
 	FlagReqId              = "request-id"
 	FlagDestAddress        = "dest-address"
 	FlagWithdrawAmount     = "withdraw-amount"
+	FlagRepeatedFrequency  = "repeated-frequency"
+	FlagRepeatedTotal      = "repeated-total"
+	FlagTimeout            = "timeout"
+	FlagRetryLimit         = "retry-limit"
+	FlagProviders          = "providers"
+	FlagQuorum             = "quorum"
+	FlagEncryptOutput      = "encrypt-output"
+	FlagName               = "name"
+	FlagLimit              = "limit"
 )
 
 var (
@@ -40,6 +49,7 @@ var (
 	FsServiceRequest          = flag.NewFlagSet("", flag.ContinueOnError)
 	FsServiceResponse         = flag.NewFlagSet("", flag.ContinueOnError)
 	FsServiceWithdrawTax      = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRequestContext          = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -72,6 +82,9 @@ func init() {
 	FsServiceRequest.String(FlagServiceFee, "", "fee to pay for a service invocation")
 	FsServiceRequest.BytesHex(FlagReqData, nil, "hex encoded request data of a service invocation")
 	FsServiceRequest.Bool(FlagProfiling, false, "service invocation profiling model, default false")
+	FsServiceRequest.StringSlice(FlagProviders, []string{}, "bech32 encoded accounts of the providers to multicast the service invocation to")
+	FsServiceRequest.Uint16(FlagQuorum, 0, "the number of responses required to finalize a multicast service invocation")
+	FsServiceRequest.Bool(FlagEncryptOutput, false, "encrypt the response data with the pubkey of the consumer, required by the methods with PubKeyEncryption privacy")
 
 	FsServiceResponse.BytesHex(FlagRespData, nil, "hex encoded response data of a service invocation")
 	FsServiceResponse.BytesHex(FlagErrMsg, nil, "hex encoded response error msg of a service invocation")
@@ -80,4 +93,13 @@ func init() {
 
 	FsServiceWithdrawTax.String(FlagDestAddress, "", "bech32 encoded address of the destination account")
 	FsServiceWithdrawTax.String(FlagWithdrawAmount, "", "withdraw amount")
+
+	FsRequestContext.Int16(FlagMethodID, 0, "the method id called")
+	FsRequestContext.String(FlagServiceFee, "", "max fee to pay for each batch of the service invocation")
+	FsRequestContext.BytesHex(FlagReqData, nil, "hex encoded request data of a service invocation")
+	FsRequestContext.Uint64(FlagRepeatedFrequency, 0, "the number of blocks between two batches")
+	FsRequestContext.Uint64(FlagRepeatedTotal, 0, "the total number of batches, 0 means unlimited")
+	FsRequestContext.Int64(FlagTimeout, 0, "the number of blocks a batch waits for the response, must not be greater than the repeated frequency")
+	FsRequestContext.Uint16(FlagRetryLimit, 0, "the maximum number of retries of a timeout batch")
+	FsRequestContext.Bool(FlagEncryptOutput, false, "encrypt the response data with the pubkey of the consumer, required by the methods with PubKeyEncryption privacy")
 }
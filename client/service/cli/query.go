@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/irisnet/irishub/app/protocol"
 	"github.com/irisnet/irishub/app/v1/service"
@@ -230,6 +231,42 @@ func GetCmdQuerySvcResponse(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdQuerySvcMulticastResponses(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multicast-responses",
+		Short:   "Query the responses of a multicast service request",
+		Example: "iriscli service multicast-responses --request-chain-id=<req-chain-id> --request-id=<request-id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			params := service.QueryResponseParams{
+				ReqChainId: viper.GetString(FlagReqChainId),
+				RequestId:  viper.GetString(FlagReqId),
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryMulticastResponses)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagReqChainId, "", "the ID of the blockchain that the service invocation initiated")
+	cmd.Flags().String(FlagReqId, "", "the ID of the service invocation")
+	cmd.MarkFlagRequired(FlagReqChainId)
+	cmd.MarkFlagRequired(FlagReqId)
+	return cmd
+}
+
 func GetCmdQuerySvcFees(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "fees",
@@ -268,3 +305,142 @@ func GetCmdQuerySvcFees(cdc *codec.Codec) *cobra.Command {
 	}
 	return cmd
 }
+
+func GetCmdQueryRequestContext(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "request-context",
+		Short:   "Query a request context",
+		Example: "iriscli service request-context <request-context-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryRequestContext(cdc, args[0], service.QueryRequestContext)
+		},
+	}
+	return cmd
+}
+
+func GetCmdQueryRequestBatches(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "request-batches",
+		Short:   "Query all the batches of a request context",
+		Example: "iriscli service request-batches <request-context-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryRequestContext(cdc, args[0], service.QueryRequestBatches)
+		},
+	}
+	return cmd
+}
+
+func queryRequestContext(cdc *codec.Codec, idStr string, path string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+		WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+	requestContextID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	params := service.QueryRequestContextParams{
+		RequestContextID: requestContextID,
+	}
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, path)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
+
+func GetCmdQueryReputation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reputation",
+		Short:   "Query the reputation of a service binding measured by the chain",
+		Example: "iriscli service reputation --def-chain-id=<chain-id> --service-name=<service name> --bind-chain-id=<chain-id> --provider=<provider>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			provider, err := sdk.AccAddressFromBech32(viper.GetString(FlagProvider))
+			if err != nil {
+				return err
+			}
+
+			params := service.QueryBindingParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				BindChainId: viper.GetString(FlagBindChainID),
+				Provider:    provider,
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryReputation)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceBinding)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagProvider)
+	return cmd
+}
+
+func GetCmdQueryBestProviders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "best-providers",
+		Short:   "Query the available providers of a service method ranked by their reputations",
+		Example: "iriscli service best-providers --def-chain-id=<chain-id> --service-name=<service name> --method-id=1 --limit=5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+
+			params := service.QueryBestProvidersParams{
+				DefChainID:  viper.GetString(FlagDefChainID),
+				ServiceName: viper.GetString(FlagServiceName),
+				MethodID:    int16(viper.GetInt(FlagMethodID)),
+				Limit:       viper.GetInt(FlagLimit),
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryBestProviders)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().Int16(FlagMethodID, 0, "the method id called")
+	cmd.Flags().Int(FlagLimit, 0, "the max number of providers returned, 0 means all")
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagMethodID)
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/irisnet/irishub/app/v1/service"
@@ -307,7 +308,9 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 		Use:   "call",
 		Short: "Call a service method",
 		Example: "iriscli service call --chain-id=<chain-id> --from=<key name> --fee=0.4iris --def-chain-id=<bind-chain-id> " +
-			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --provider=<provider> --service-fee=1iris --request-data=<req>",
+			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --provider=<provider> --service-fee=1iris --request-data=<req>\n" +
+			"iriscli service call --chain-id=<chain-id> --from=<key name> --fee=0.4iris --def-chain-id=<bind-chain-id> " +
+			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --providers=<provider1>,<provider2> --quorum=1 --service-fee=1iris --request-data=<req>",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
 				WithAccountDecoder(utils.GetAccountDecoder(cdc))
@@ -326,10 +329,22 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 			bindChainId := viper.GetString(FlagBindChainID)
 			methodId := int16(viper.GetInt(FlagMethodID))
 
-			providerStr := viper.GetString(FlagProvider)
-			provider, err := sdk.AccAddressFromBech32(providerStr)
-			if err != nil {
-				return err
+			var providers []sdk.AccAddress
+			for _, providerStr := range viper.GetStringSlice(FlagProviders) {
+				provider, err := sdk.AccAddressFromBech32(providerStr)
+				if err != nil {
+					return err
+				}
+				providers = append(providers, provider)
+			}
+
+			var provider sdk.AccAddress
+			if len(providers) == 0 {
+				providerStr := viper.GetString(FlagProvider)
+				provider, err = sdk.AccAddressFromBech32(providerStr)
+				if err != nil {
+					return err
+				}
 			}
 
 			serviceFeeStr := viper.GetString(FlagServiceFee)
@@ -346,7 +361,20 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 
 			profiling := viper.GetBool(FlagProfiling)
 
-			msg := service.NewMsgSvcRequest(defChainId, name, bindChainId, chainId, fromAddr, provider, methodId, input, serviceFee, profiling)
+			var msg service.MsgSvcRequest
+			if len(providers) > 0 {
+				quorum := uint16(viper.GetInt(FlagQuorum))
+				msg = service.NewMsgSvcMulticastRequest(defChainId, name, bindChainId, chainId, fromAddr, providers, quorum, methodId, input, serviceFee, profiling)
+			} else {
+				msg = service.NewMsgSvcRequest(defChainId, name, bindChainId, chainId, fromAddr, provider, methodId, input, serviceFee, profiling)
+			}
+
+			if viper.GetBool(FlagEncryptOutput) {
+				msg.EncryptionPubKey, err = getEncryptionPubKey(cliCtx)
+				if err != nil {
+					return err
+				}
+			}
 			cliCtx.PrintResponse = true
 			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
 		},
@@ -357,7 +385,6 @@ func GetCmdSvcCall(cdc *codec.Codec) *cobra.Command {
 	cmd.MarkFlagRequired(FlagDefChainID)
 	cmd.MarkFlagRequired(FlagServiceName)
 	cmd.MarkFlagRequired(FlagBindChainID)
-	cmd.MarkFlagRequired(FlagProvider)
 	cmd.MarkFlagRequired(FlagMethodID)
 	return cmd
 }
@@ -487,3 +514,143 @@ func GetCmdSvcWithdrawTax(cdc *codec.Codec) *cobra.Command {
 	cmd.MarkFlagRequired(FlagWithdrawAmount)
 	return cmd
 }
+
+func GetCmdStartRequestContext(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-request-context",
+		Short: "Call a service method repeatedly at a block interval",
+		Example: "iriscli service start-request-context --chain-id=<chain-id> --from=<key name> --fee=0.4iris --def-chain-id=<def-chain-id> " +
+			"--service-name=<service name> --method-id=<method-id> --bind-chain-id=<chain-id> --provider=<provider> --service-fee=1iris --request-data=<req> " +
+			"--repeated-frequency=10 --repeated-total=100 --timeout=5 --retry-limit=1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			fromAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			chainId := viper.GetString(client.FlagChainID)
+
+			defChainId := viper.GetString(FlagDefChainID)
+			name := viper.GetString(FlagServiceName)
+			bindChainId := viper.GetString(FlagBindChainID)
+			methodId := int16(viper.GetInt(FlagMethodID))
+
+			providerStr := viper.GetString(FlagProvider)
+			provider, err := sdk.AccAddressFromBech32(providerStr)
+			if err != nil {
+				return err
+			}
+
+			serviceFeeStr := viper.GetString(FlagServiceFee)
+			serviceFee, err := cliCtx.ParseCoins(serviceFeeStr)
+			if err != nil {
+				return err
+			}
+
+			inputString := viper.GetString(FlagReqData)
+			input, err := hex.DecodeString(inputString)
+			if err != nil {
+				return err
+			}
+
+			repeatedFrequency := viper.GetInt64(FlagRepeatedFrequency)
+			repeatedTotal := viper.GetInt64(FlagRepeatedTotal)
+			timeout := viper.GetInt64(FlagTimeout)
+			retryLimit := viper.GetInt(FlagRetryLimit)
+
+			msg := service.NewMsgStartRequestContext(defChainId, name, bindChainId, chainId, fromAddr, provider, methodId, input,
+				serviceFee, uint64(repeatedFrequency), uint64(repeatedTotal), timeout, uint16(retryLimit))
+			if viper.GetBool(FlagEncryptOutput) {
+				msg.EncryptionPubKey, err = getEncryptionPubKey(cliCtx)
+				if err != nil {
+					return err
+				}
+			}
+			cliCtx.PrintResponse = true
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsServiceDefinition)
+	cmd.Flags().AddFlagSet(FsServiceBinding)
+	cmd.Flags().AddFlagSet(FsRequestContext)
+	cmd.MarkFlagRequired(FlagDefChainID)
+	cmd.MarkFlagRequired(FlagServiceName)
+	cmd.MarkFlagRequired(FlagBindChainID)
+	cmd.MarkFlagRequired(FlagProvider)
+	cmd.MarkFlagRequired(FlagMethodID)
+	cmd.MarkFlagRequired(FlagRepeatedFrequency)
+	cmd.MarkFlagRequired(FlagTimeout)
+	return cmd
+}
+
+func GetCmdPauseRequestContext(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-request-context",
+		Short:   "Pause a running request context",
+		Example: "iriscli service pause-request-context <request-context-id> --chain-id=<chain-id> --from=<key name> --fee=0.4iris",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendRequestContextOperation(cdc, args[0], func(id uint64, consumer sdk.AccAddress) sdk.Msg {
+				return service.NewMsgPauseRequestContext(id, consumer)
+			})
+		},
+	}
+	return cmd
+}
+
+func GetCmdResumeRequestContext(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resume-request-context",
+		Short:   "Resume a paused request context",
+		Example: "iriscli service resume-request-context <request-context-id> --chain-id=<chain-id> --from=<key name> --fee=0.4iris",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendRequestContextOperation(cdc, args[0], func(id uint64, consumer sdk.AccAddress) sdk.Msg {
+				return service.NewMsgResumeRequestContext(id, consumer)
+			})
+		},
+	}
+	return cmd
+}
+
+func GetCmdKillRequestContext(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "kill-request-context",
+		Short:   "Terminate a running or paused request context",
+		Example: "iriscli service kill-request-context <request-context-id> --chain-id=<chain-id> --from=<key name> --fee=0.4iris",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendRequestContextOperation(cdc, args[0], func(id uint64, consumer sdk.AccAddress) sdk.Msg {
+				return service.NewMsgKillRequestContext(id, consumer)
+			})
+		},
+	}
+	return cmd
+}
+
+// send the msg which operates the request context signed by the consumer
+func sendRequestContextOperation(cdc *codec.Codec, idStr string, newMsg func(uint64, sdk.AccAddress) sdk.Msg) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithLogger(os.Stdout).
+		WithAccountDecoder(utils.GetAccountDecoder(cdc))
+	txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+		WithCliCtx(cliCtx)
+
+	requestContextID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	fromAddr, err := cliCtx.GetFromAddress()
+	if err != nil {
+		return err
+	}
+
+	msg := newMsg(requestContextID, fromAddr)
+	cliCtx.PrintResponse = true
+	return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+}
@@ -9,4 +9,7 @@ const (
 	Provider    = "provider"
 	Consumer    = "consumer"
 	Address     = "address"
+	MethodId    = "methodId"
+
+	RequestContextId = "requestContextId"
 )
@@ -3,6 +3,7 @@ package lcd
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/irisnet/irishub/app/protocol"
@@ -24,7 +25,19 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	// get a single binding info
 	r.HandleFunc(
 		fmt.Sprintf("/service/bindings/{%s}/{%s}/{%s}/{%s}", DefChainId, ServiceName, BindChainId, Provider),
-		bindingHandlerFn(cliCtx, cdc),
+		bindingHandlerFn(cliCtx, cdc, service.QueryBinding),
+	).Methods("GET")
+
+	// get the reputation of a binding
+	r.HandleFunc(
+		fmt.Sprintf("/service/reputations/{%s}/{%s}/{%s}/{%s}", DefChainId, ServiceName, BindChainId, Provider),
+		bindingHandlerFn(cliCtx, cdc, service.QueryReputation),
+	).Methods("GET")
+
+	// get the providers of a method ranked by their reputations
+	r.HandleFunc(
+		fmt.Sprintf("/service/best-providers/{%s}/{%s}/{%s}", DefChainId, ServiceName, MethodId),
+		bestProvidersHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
 	// get all bindings of a definition
@@ -42,7 +55,13 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	// get a single response
 	r.HandleFunc(
 		fmt.Sprintf("/service/responses/{%s}/{%s}", ReqChainId, ReqId),
-		responseGetHandlerFn(cliCtx, cdc),
+		responseGetHandlerFn(cliCtx, cdc, service.QueryResponse),
+	).Methods("GET")
+
+	// get all responses of a multicast request
+	r.HandleFunc(
+		fmt.Sprintf("/service/multicast-responses/{%s}/{%s}", ReqChainId, ReqId),
+		responseGetHandlerFn(cliCtx, cdc, service.QueryMulticastResponses),
 	).Methods("GET")
 
 	// get return fee and incoming fee of a account
@@ -50,6 +69,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		fmt.Sprintf("/service/fees/{%s}", Address),
 		feesHandlerFn(cliCtx, cdc),
 	).Methods("GET")
+
+	// get a single request context
+	r.HandleFunc(
+		fmt.Sprintf("/service/request-contexts/{%s}", RequestContextId),
+		requestContextHandlerFn(cliCtx, cdc, service.QueryRequestContext),
+	).Methods("GET")
+
+	// get all batches of a request context
+	r.HandleFunc(
+		fmt.Sprintf("/service/request-contexts/{%s}/batches", RequestContextId),
+		requestContextHandlerFn(cliCtx, cdc, service.QueryRequestBatches),
+	).Methods("GET")
 }
 
 func definitionGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
@@ -80,7 +111,7 @@ func definitionGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Ha
 	}
 }
 
-func bindingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+func bindingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		defChainId := vars[DefChainId]
@@ -107,7 +138,49 @@ func bindingHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerF
 			return
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryBinding)
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, queryRoute)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func bestProvidersHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		methodId, err := strconv.ParseInt(vars[MethodId], 10, 16)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		limit := 0
+		if limitStr := r.FormValue("limit"); limitStr != "" {
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := service.QueryBestProvidersParams{
+			DefChainID:  vars[DefChainId],
+			ServiceName: vars[ServiceName],
+			MethodID:    int16(methodId),
+			Limit:       limit,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryBestProviders)
 		res, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -183,7 +256,7 @@ func requestsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handler
 	}
 }
 
-func responseGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+func responseGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		reqChainId := vars[ReqChainId]
@@ -200,7 +273,7 @@ func responseGetHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Hand
 			return
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, service.QueryResponse)
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, queryRoute)
 		res, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -242,3 +315,33 @@ func feesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func requestContextHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		requestContextID, err := strconv.ParseUint(vars[RequestContextId], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := service.QueryRequestContextParams{
+			RequestContextID: requestContextID,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", protocol.ServiceRoute, path)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
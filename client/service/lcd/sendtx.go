@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/irisnet/irishub/app/v1/service"
@@ -73,6 +74,36 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		fmt.Sprintf("/service/fees/{%s}/withdraw", Provider),
 		FeesWithdrawHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+
+	// start a request context
+	r.HandleFunc(
+		"/service/request-contexts",
+		requestContextStartHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	// pause a request context
+	r.HandleFunc(
+		fmt.Sprintf("/service/request-contexts/{%s}/pause", RequestContextId),
+		requestContextOperationHandlerFn(cdc, cliCtx, func(id uint64, consumer sdk.AccAddress) sdk.Msg {
+			return service.NewMsgPauseRequestContext(id, consumer)
+		}),
+	).Methods("POST")
+
+	// resume a request context
+	r.HandleFunc(
+		fmt.Sprintf("/service/request-contexts/{%s}/resume", RequestContextId),
+		requestContextOperationHandlerFn(cdc, cliCtx, func(id uint64, consumer sdk.AccAddress) sdk.Msg {
+			return service.NewMsgResumeRequestContext(id, consumer)
+		}),
+	).Methods("POST")
+
+	// kill a request context
+	r.HandleFunc(
+		fmt.Sprintf("/service/request-contexts/{%s}/kill", RequestContextId),
+		requestContextOperationHandlerFn(cdc, cliCtx, func(id uint64, consumer sdk.AccAddress) sdk.Msg {
+			return service.NewMsgKillRequestContext(id, consumer)
+		}),
+	).Methods("POST")
 }
 
 func definitionPostHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -365,11 +396,23 @@ func requestAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 				return
 			}
 
-			providerStr := request.Provider
-			provider, err := sdk.AccAddressFromBech32(providerStr)
-			if err != nil {
-				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
+			var providers []sdk.AccAddress
+			for _, providerStr := range request.Providers {
+				provider, err := sdk.AccAddressFromBech32(providerStr)
+				if err != nil {
+					utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				providers = append(providers, provider)
+			}
+
+			var provider sdk.AccAddress
+			if len(providers) == 0 {
+				provider, err = sdk.AccAddressFromBech32(request.Provider)
+				if err != nil {
+					utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
 			}
 
 			inputString := request.Data
@@ -387,6 +430,14 @@ func requestAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 			}
 
 			msg := service.NewMsgSvcRequest(request.DefChainId, request.ServiceName, request.BindChainId, baseReq.ChainID, consumer, provider, request.MethodId, input, serviceFee, request.Profiling)
+			if len(providers) > 0 {
+				msg = service.NewMsgSvcMulticastRequest(request.DefChainId, request.ServiceName, request.BindChainId, baseReq.ChainID, consumer, providers, request.Quorum, request.MethodId, input, serviceFee, request.Profiling)
+			}
+			msg.EncryptionPubKey, err = hex.DecodeString(request.EncryptionPubKey)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -518,6 +569,101 @@ func FeesWithdrawHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 	}
 }
 
+func requestContextStartHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req requestContextStart
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		provider, err := sdk.AccAddressFromBech32(req.Provider)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		input, err := hex.DecodeString(req.Data)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		serviceFee, err := cliCtx.ParseCoins(req.ServiceFee)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := service.NewMsgStartRequestContext(req.DefChainId, req.ServiceName, req.BindChainId, baseReq.ChainID, consumer, provider,
+			req.MethodId, input, serviceFee, req.RepeatedFrequency, req.RepeatedTotal, req.Timeout, req.RetryLimit)
+		msg.EncryptionPubKey, err = hex.DecodeString(req.EncryptionPubKey)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
+func requestContextOperationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, newMsg func(uint64, sdk.AccAddress) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		requestContextID, err := strconv.ParseUint(vars[RequestContextId], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req requestContextOperation
+		err = utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseTx.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := newMsg(requestContextID, consumer)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
+
 type definition struct {
 	BaseTx             utils.BaseTx `json:"base_tx"` // basic tx info
 	ServiceName        string       `json:"service_name"`
@@ -553,15 +699,19 @@ type bindingEnable struct {
 }
 
 type serviceRequest struct {
-	ServiceName string `json:"service_name"`
-	BindChainId string `json:"bind_chain_id"`
-	DefChainId  string `json:"def_chain_id"`
-	MethodId    int16  `json:"method_id"`
-	Provider    string `json:"provider"`
-	Consumer    string `json:"consumer"`
-	ServiceFee  string `json:"service_fee"`
-	Data        string `json:"data"`
-	Profiling   bool   `json:"profiling"`
+	ServiceName string   `json:"service_name"`
+	BindChainId string   `json:"bind_chain_id"`
+	DefChainId  string   `json:"def_chain_id"`
+	MethodId    int16    `json:"method_id"`
+	Provider    string   `json:"provider"`
+	Providers   []string `json:"providers"` // providers of a multicast request
+	Quorum      uint16   `json:"quorum"`    // the number of responses required by a multicast request
+	Consumer    string   `json:"consumer"`
+	ServiceFee  string   `json:"service_fee"`
+	Data        string   `json:"data"`
+	Profiling   bool     `json:"profiling"`
+
+	EncryptionPubKey string `json:"encryption_pubkey"` // hex encoded compressed secp256k1 pubkey to encrypt the outputs
 }
 
 type serviceRequestWithBasic struct {
@@ -581,3 +731,25 @@ type serviceResponse struct {
 type basicReq struct {
 	BaseTx utils.BaseTx `json:"base_tx"` // basic tx info
 }
+
+type requestContextStart struct {
+	BaseTx            utils.BaseTx `json:"base_tx"` // basic tx info
+	ServiceName       string       `json:"service_name"`
+	BindChainId       string       `json:"bind_chain_id"`
+	DefChainId        string       `json:"def_chain_id"`
+	MethodId          int16        `json:"method_id"`
+	Provider          string       `json:"provider"`
+	Consumer          string       `json:"consumer"`
+	ServiceFee        string       `json:"service_fee"`
+	Data              string       `json:"data"`
+	RepeatedFrequency uint64       `json:"repeated_frequency"`
+	RepeatedTotal     uint64       `json:"repeated_total"`
+	Timeout           int64        `json:"timeout"`
+	RetryLimit        uint16       `json:"retry_limit"`
+	EncryptionPubKey  string       `json:"encryption_pubkey"` // hex encoded compressed secp256k1 pubkey to encrypt the outputs
+}
+
+type requestContextOperation struct {
+	BaseTx   utils.BaseTx `json:"base_tx"` // basic tx info
+	Consumer string       `json:"consumer"`
+}
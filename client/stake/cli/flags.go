@@ -24,6 +24,8 @@ const (
 
 	FlagCommissionRate = "commission-rate"
 
+	FlagMinSelfDelegation = "min-self-delegation"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
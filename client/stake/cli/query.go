@@ -401,3 +401,43 @@ func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryShareToken implements the share token query command.
+func GetCmdQueryShareToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "share-token [validator-address]",
+		Short:   "Query the share token of a validator",
+		Example: "iriscli stake share-token <validator address>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := stake.NewQueryValidatorParams(addr)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", protocol.StakeRoute, stake.QueryShareToken)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var supply stake.ShareTokenSupply
+			err = cdc.UnmarshalJSON(res, &supply)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(stakeClient.ConvertShareTokenToShareTokenOutput(cliCtx, supply))
+		},
+	}
+
+	return cmd
+}
@@ -73,6 +73,19 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// the operator must keep at least this much self-delegation, defaults to the smallest unit
+			minSelfDelegation := sdk.OneInt()
+			if minSelfDelegationStr := viper.GetString(FlagMinSelfDelegation); minSelfDelegationStr != "" {
+				minSelfDelegationCoin, err := cliCtx.ParseCoin(minSelfDelegationStr)
+				if err != nil {
+					return err
+				}
+				if minSelfDelegationCoin.Denom != amount.Denom {
+					return fmt.Errorf("the minimum self-delegation must be denominated in %s", amount.Denom)
+				}
+				minSelfDelegation = minSelfDelegationCoin.Amount
+			}
+
 			var msg sdk.Msg
 			if viper.GetString(FlagAddressDelegator) != "" {
 				delAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressDelegator))
@@ -81,11 +94,11 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 				}
 
 				msg = stake.NewMsgCreateValidatorOnBehalfOf(
-					delAddr, sdk.ValAddress(validatorAddr), pk, amount, description, commissionMsg,
+					delAddr, sdk.ValAddress(validatorAddr), pk, amount, minSelfDelegation, description, commissionMsg,
 				)
 			} else {
 				msg = stake.NewMsgCreateValidator(
-					sdk.ValAddress(validatorAddr), pk, amount, description, commissionMsg,
+					sdk.ValAddress(validatorAddr), pk, amount, minSelfDelegation, description, commissionMsg,
 				)
 			}
 
@@ -110,6 +123,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
 	cmd.Flags().AddFlagSet(FsCommissionCreate)
 	cmd.Flags().AddFlagSet(fsDelegator)
+	cmd.Flags().String(FlagMinSelfDelegation, "", "Minimum amount of self-delegation the validator must keep, it is jailed otherwise")
 	cmd.Flags().Bool(FlagGenesisFormat, false, "Export the transaction in gen-tx format; it implies --generate-only")
 	cmd.Flags().String(FlagIP, "", fmt.Sprintf("Node's public IP. It takes effect only when used in combination with --%s", FlagGenesisFormat))
 	cmd.Flags().String(FlagNodeID, "", "Node's ID")
@@ -175,6 +189,52 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdRotateConsPubKey implements the rotate consensus pubkey command.
+func GetCmdRotateConsPubKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rotate-cons-pubkey",
+		Short:   "rotate the consensus pubkey of an existing validator at the end of the block",
+		Example: "iriscli stake rotate-cons-pubkey --chain-id=<chain-id> --from=<key name> --fee=0.4iris --pubkey=<new validator public key>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			valAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			pkStr := viper.GetString(FlagPubKey)
+			if len(pkStr) == 0 {
+				return fmt.Errorf("must use --pubkey flag")
+			}
+
+			pk, err := sdk.GetConsPubKeyBech32(pkStr)
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), pk)
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txCtx, cliCtx, []sdk.Msg{msg}, false)
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsPk)
+	cmd.MarkFlagRequired(FlagPubKey)
+
+	return cmd
+}
+
 // GetCmdDelegate implements the delegate command.
 func GetCmdDelegate(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -318,3 +378,129 @@ func GetCmdUnbond(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdCancelUnbonding implements the cancel unbonding delegation command.
+func GetCmdCancelUnbonding(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-unbonding",
+		Short:   "delegate the tokens of an unbonding delegation back to its validator",
+		Example: "iriscli stake cancel-unbonding --chain-id=<chain-id> --from=<key name> --fee=0.4iris --amount=10iris --address-validator=<validator address>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			amount, err := cliCtx.ParseCoin(viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, amount)
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.MarkFlagRequired(FlagAmount)
+	cmd.MarkFlagRequired(FlagAddressValidator)
+	return cmd
+}
+
+// GetCmdTokenizeShares implements the tokenize shares command.
+func GetCmdTokenizeShares(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tokenize-shares",
+		Short:   "tokenize delegation shares into transferable share tokens",
+		Example: "iriscli stake tokenize-shares --chain-id=<chain-id> --from=<key name> --fee=0.4iris --address-validator=<validator address> --shares-amount=10",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			// get the shares amount
+			sharesAmountStr := viper.GetString(FlagSharesAmount)
+			sharesPercentStr := viper.GetString(FlagSharesPercent)
+			sharesAmount, err := stakeClient.GetShares(
+				protocol.StakeStore, cliCtx, cdc, sharesAmountStr, sharesPercentStr,
+				delegatorAddr, validatorAddr,
+			)
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sharesAmount)
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsShares)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.MarkFlagRequired(FlagAddressValidator)
+
+	return cmd
+}
+
+// GetCmdRedeemShareTokens implements the redeem share tokens command.
+func GetCmdRedeemShareTokens(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redeem-shares",
+		Short:   "redeem share tokens for the delegation shares backing them",
+		Example: "iriscli stake redeem-shares --chain-id=<chain-id> --from=<key name> --fee=0.4iris --amount=10iris.sh1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(utils.GetAccountDecoder(cdc))
+			txCtx := utils.NewTxContextFromCLI().WithCodec(cdc).
+				WithCliCtx(cliCtx)
+
+			amount, err := cliCtx.ParseCoin(viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgRedeemShareTokens(delegatorAddr, amount)
+
+			return utils.SendOrPrintTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.MarkFlagRequired(FlagAmount)
+
+	return cmd
+}
@@ -27,6 +27,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/stake/delegators/{delegatorAddr}/unbonding-delegations",
 		beginUnbondingRequestHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/unbonding-delegations/cancel",
+		cancelUnbondingRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -48,6 +53,11 @@ type (
 		SharesPercent string `json:"shares_percent"`
 	}
 
+	msgCancelUnbondingInput struct {
+		ValidatorAddr string `json:"validator_addr"` // in bech32
+		Amount        string `json:"amount"`
+	}
+
 	// the request body for edit delegations
 	DelegationsReq struct {
 		BaseReq    utils.BaseTx     `json:"base_tx"`
@@ -59,6 +69,11 @@ type (
 		BeginUnbond msgUnbondInput `json:"unbond"`
 	}
 
+	CancelUnbondingReq struct {
+		BaseReq         utils.BaseTx            `json:"base_tx"`
+		CancelUnbonding msgCancelUnbondingInput `json:"cancel_unbonding"`
+	}
+
 	BeginRedelegatesReq struct {
 		BaseReq         utils.BaseTx       `json:"base_tx"`
 		BeginRedelegate msgRedelegateInput `json:"redelegate"`
@@ -229,3 +244,46 @@ func beginUnbondingRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
 	}
 }
+
+func cancelUnbondingRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32delegator := vars["delegatorAddr"]
+
+		var req CancelUnbondingReq
+
+		err := utils.ReadPostBody(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(bech32delegator)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(req.CancelUnbonding.ValidatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := cliCtx.ParseCoin(req.CancelUnbonding.Amount)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := stake.NewMsgCancelUnbondingDelegation(delAddr, valAddr, amount)
+
+		txCtx := utils.BuildReqTxCtx(cliCtx, baseReq, w)
+
+		utils.WriteGenerateStdTxResponse(w, txCtx, []sdk.Msg{msg})
+	}
+}
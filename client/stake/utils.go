@@ -127,6 +127,8 @@ type ValidatorOutput struct {
 	UnbondingHeight  int64             `json:"unbonding_height"`
 	UnbondingMinTime time.Time         `json:"unbonding_time"`
 	Commission       stake.Commission  `json:"commission"`
+
+	MinSelfDelegation string `json:"min_self_delegation"`
 }
 
 func (v ValidatorOutput) String() string {
@@ -140,10 +142,11 @@ func (v ValidatorOutput) String() string {
   Description:                 %s
   Unbonding Height:            %d
   Minimum Unbonding Time:      %v
-  Commission:                  %s`, v.OperatorAddr, v.ConsPubKey,
+  Commission:                  %s
+  Min Self Delegation:         %s`, v.OperatorAddr, v.ConsPubKey,
 		v.Jailed, sdk.BondStatusToString(v.Status), v.Tokens,
 		v.DelegatorShares, v.Description,
-		v.UnbondingHeight, v.UnbondingMinTime, v.Commission)
+		v.UnbondingHeight, v.UnbondingMinTime, v.Commission, v.MinSelfDelegation)
 }
 
 // Validators is a collection of Validator
@@ -175,6 +178,24 @@ func (p PoolOutput) String() string {
 		p.BondedTokens, p.TokenSupply, p.BondedRatio)
 }
 
+type ShareTokenOutput struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Denom         string         `json:"denom"`
+	PoolAddress   sdk.AccAddress `json:"pool_address"`
+	Supply        string         `json:"supply"`
+	Shares        string         `json:"shares"`
+}
+
+func (s ShareTokenOutput) String() string {
+	return fmt.Sprintf(`Share Token:
+  Validator:     %s
+  Denom:         %s
+  Pool Address:  %s
+  Supply:        %s
+  Shares:        %s`, s.ValidatorAddr,
+		s.Denom, s.PoolAddress, s.Supply, s.Shares)
+}
+
 func ConvertValidatorToValidatorOutput(cliCtx context.CLIContext, v stake.Validator) ValidatorOutput {
 	exRate := utils.ExRateFromStakeTokenToMainUnit(cliCtx)
 
@@ -195,6 +216,8 @@ func ConvertValidatorToValidatorOutput(cliCtx context.CLIContext, v stake.Valida
 		UnbondingHeight:  v.UnbondingHeight,
 		UnbondingMinTime: v.UnbondingMinTime,
 		Commission:       v.Commission,
+
+		MinSelfDelegation: utils.ConvertDecToRat(sdk.NewDecFromInt(v.MinSelfDelegation)).Mul(exRate).FloatString(),
 	}
 }
 
@@ -250,6 +273,21 @@ func ConvertREDToREDOutput(cliCtx context.CLIContext, red stake.Redelegation) Re
 	}
 }
 
+func ConvertShareTokenToShareTokenOutput(cliCtx context.CLIContext, supply stake.ShareTokenSupply) ShareTokenOutput {
+	exRate := utils.ExRateFromStakeTokenToMainUnit(cliCtx)
+	supplyStr, err := cliCtx.ConvertToMainUnit(sdk.Coins{sdk.NewCoin(supply.Denom, supply.Supply)}.String())
+	if err != nil || len(supplyStr) != 1 {
+		supplyStr = []string{sdk.NewCoin(supply.Denom, supply.Supply).String()}
+	}
+	return ShareTokenOutput{
+		ValidatorAddr: supply.ValidatorAddr,
+		Denom:         supply.Denom,
+		PoolAddress:   supply.PoolAddress,
+		Supply:        supplyStr[0],
+		Shares:        utils.ConvertDecToRat(supply.Shares).Mul(exRate).FloatString(),
+	}
+}
+
 func ConvertPoolToPoolOutput(cliCtx context.CLIContext, pool stake.PoolStatus) PoolOutput {
 	exRate := utils.ExRateFromStakeTokenToMainUnit(cliCtx)
 	return PoolOutput{
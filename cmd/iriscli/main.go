@@ -115,11 +115,16 @@ func main() {
 		client.GetCommands(
 			distributioncmd.GetWithdrawAddress(cdc),
 			distributioncmd.GetRewards(cdc),
+			distributioncmd.GetBudgetStreams(cdc),
+			distributioncmd.GetRestakeConfig(cdc),
 		)...)
 	distributionCmd.AddCommand(
 		client.PostCommands(
 			distributioncmd.GetCmdSetWithdrawAddr(cdc),
 			distributioncmd.GetCmdWithdrawRewards(cdc),
+			distributioncmd.GetCmdCancelBudgetStream(cdc),
+			distributioncmd.GetCmdSetRestake(cdc),
+			distributioncmd.GetCmdRemoveRestake(cdc),
 		)...)
 	rootCmd.AddCommand(
 		distributionCmd,
@@ -139,12 +144,18 @@ func main() {
 			govcmd.GetCmdQueryDeposit(cdc),
 			govcmd.GetCmdQueryDeposits(cdc),
 			govcmd.GetCmdQueryTally(cdc),
+			govcmd.GetCmdQueryRefund(cdc),
+			govcmd.GetCmdSimulateProposal(cdc),
+			govcmd.GetCmdQueryRepresentative(cdc),
 		)...)
 	govCmd.AddCommand(
 		client.PostCommands(
 			govcmd.GetCmdSubmitProposal(cdc),
 			govcmd.GetCmdDeposit(cdc),
 			govcmd.GetCmdVote(cdc),
+			govcmd.GetCmdSetRepresentative(cdc),
+			govcmd.GetCmdCancelProposal(cdc),
+			govcmd.GetCmdAmendProposal(cdc),
 		)...)
 	rootCmd.AddCommand(
 		govCmd,
@@ -170,15 +181,20 @@ func main() {
 			stakecmd.GetCmdQueryRedelegations(cdc),
 			stakecmd.GetCmdQueryPool(cdc),
 			stakecmd.GetCmdQueryParams(cdc),
+			stakecmd.GetCmdQueryShareToken(cdc),
 			slashingcmd.GetCmdQuerySigningInfo(protocol.SlashingRoute, cdc),
 		)...)
 	stakeCmd.AddCommand(
 		client.PostCommands(
 			stakecmd.GetCmdCreateValidator(cdc),
 			stakecmd.GetCmdEditValidator(cdc),
+			stakecmd.GetCmdRotateConsPubKey(cdc),
 			stakecmd.GetCmdDelegate(cdc),
 			stakecmd.GetCmdUnbond(cdc),
+			stakecmd.GetCmdCancelUnbonding(cdc),
 			stakecmd.GetCmdRedelegate(cdc),
+			stakecmd.GetCmdTokenizeShares(cdc),
+			stakecmd.GetCmdRedeemShareTokens(cdc),
 			slashingcmd.GetCmdUnrevoke(cdc),
 		)...)
 	rootCmd.AddCommand(
@@ -211,7 +227,14 @@ func main() {
 			servicecmd.GetCmdQuerySvcBinds(cdc),
 			servicecmd.GetCmdQuerySvcRequests(cdc),
 			servicecmd.GetCmdQuerySvcResponse(cdc),
+			servicecmd.GetCmdQuerySvcMulticastResponses(cdc),
 			servicecmd.GetCmdQuerySvcFees(cdc),
+			servicecmd.GetCmdQueryRequestContext(cdc),
+			servicecmd.GetCmdQueryRequestBatches(cdc),
+			servicecmd.GetCmdQueryReputation(cdc),
+			servicecmd.GetCmdQueryBestProviders(cdc),
+			servicecmd.GetCmdEncryptOutput(cdc),
+			servicecmd.GetCmdDecryptOutput(cdc),
 		)...)
 	serviceCmd.AddCommand(client.PostCommands(
 		servicecmd.GetCmdSvcDef(cdc),
@@ -225,6 +248,10 @@ func main() {
 		servicecmd.GetCmdSvcRefundFees(cdc),
 		servicecmd.GetCmdSvcWithdrawFees(cdc),
 		servicecmd.GetCmdSvcWithdrawTax(cdc),
+		servicecmd.GetCmdStartRequestContext(cdc),
+		servicecmd.GetCmdPauseRequestContext(cdc),
+		servicecmd.GetCmdResumeRequestContext(cdc),
+		servicecmd.GetCmdKillRequestContext(cdc),
 	)...)
 
 	rootCmd.AddCommand(
@@ -268,15 +295,30 @@ func main() {
 			assetcmd.GetCmdTransferTokenOwner(cdc),
 			assetcmd.GetCmdEditAsset(cdc),
 			assetcmd.GetCmdMintToken(cdc),
+			assetcmd.GetCmdBurnToken(cdc),
+			assetcmd.GetCmdFreezeToken(cdc),
+			assetcmd.GetCmdUnfreezeToken(cdc),
+			assetcmd.GetCmdEditWhitelist(cdc),
+			assetcmd.GetCmdMintNFT(cdc),
+			assetcmd.GetCmdTransferNFT(cdc),
+			assetcmd.GetCmdEditNFT(cdc),
+			assetcmd.GetCmdBurnNFT(cdc),
 		)...)
 
 	assetCmd.AddCommand(
 		client.GetCommands(
 			assetcmd.GetCmdQueryToken(cdc),
 			assetcmd.GetCmdQueryTokens(cdc),
+			assetcmd.GetCmdQuerySupply(cdc),
+			assetcmd.GetCmdQueryFrozen(cdc),
+			assetcmd.GetCmdQueryWhitelist(cdc),
 			assetcmd.GetCmdQueryGateway(cdc),
 			assetcmd.GetCmdQueryGateways(cdc),
 			assetcmd.GetCmdQueryFee(cdc),
+			assetcmd.GetCmdQueryCollection(cdc),
+			assetcmd.GetCmdQueryCollections(cdc),
+			assetcmd.GetCmdQueryNFT(cdc),
+			assetcmd.GetCmdQueryNFTs(cdc),
 		)...)
 
 	rootCmd.AddCommand(
@@ -292,12 +334,16 @@ func main() {
 	randCmd.AddCommand(
 		client.PostCommands(
 			randcmd.GetCmdRequestRand(cdc),
+			randcmd.GetCmdCommitSeed(cdc),
+			randcmd.GetCmdRevealSeed(cdc),
 		)...)
 
 	randCmd.AddCommand(
 		client.GetCommands(
 			randcmd.GetCmdQueryRand(cdc),
 			randcmd.GetCmdQueryRandRequestQueue(cdc),
+			randcmd.GetCmdQueryParticipation(cdc),
+			randcmd.GetCmdQueryConsumerRequests(cdc),
 		)...)
 
 	rootCmd.AddCommand(
@@ -305,6 +351,10 @@ func main() {
 	)
 
 	paramsCmd := client.GetCommands(paramscmd.Commands(cdc))[0]
+	paramsCmd.AddCommand(client.GetCommands(
+		paramscmd.GetCmdQueryParamChanges(cdc),
+		paramscmd.GetCmdQueryParamHistory(cdc),
+	)...)
 
 	//Add keys and version commands
 	rootCmd.AddCommand(
@@ -135,3 +135,5 @@ func (h Hooks) OnValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk
 func (h Hooks) OnValidatorPowerDidChange(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
 	h.k.onValidatorPowerDidChange(ctx, valAddr)
 }
+func (h Hooks) OnValidatorConsPubKeyRotated(_ sdk.Context, _ sdk.ValAddress, _, _ sdk.ConsAddress) {
+}
@@ -0,0 +1,71 @@
+package guardian
+
+import (
+	"github.com/irisnet/irishub/modules/guardian/tags"
+	sdk "github.com/irisnet/irishub/types"
+)
+
+// EndBlocker removes the profilers and trustees whose term has ended
+func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
+	resTags = sdk.NewTags()
+	height := ctx.BlockHeight()
+
+	var profilers, trustees []Guardian
+	k.IterateProfilers(ctx, func(profiler Guardian) bool {
+		profilers = append(profilers, profiler)
+		return false
+	})
+	k.IterateTrustees(ctx, func(trustee Guardian) bool {
+		trustees = append(trustees, trustee)
+		return false
+	})
+
+	expiredProfilers, lastProfiler := splitExpiredGuardians(profilers, height)
+	for _, profiler := range expiredProfilers {
+		k.DeleteProfiler(ctx, profiler.Address)
+		resTags = resTags.AppendTag(tags.Action, tags.ActionGuardianExpired)
+		resTags = resTags.AppendTag(tags.Profiler, []byte(profiler.Address.String()))
+		ctx.Logger().Info("Profiler term expired", "address", profiler.Address.String(), "expiry_height", profiler.ExpiryHeight)
+	}
+	if lastProfiler != nil {
+		k.AddProfiler(ctx, *lastProfiler)
+		ctx.Logger().Info("The term of the last profiler is lifted", "address", lastProfiler.Address.String())
+	}
+
+	expiredTrustees, lastTrustee := splitExpiredGuardians(trustees, height)
+	for _, trustee := range expiredTrustees {
+		k.DeleteTrustee(ctx, trustee.Address)
+		resTags = resTags.AppendTag(tags.Action, tags.ActionGuardianExpired)
+		resTags = resTags.AppendTag(tags.Trustee, []byte(trustee.Address.String()))
+		ctx.Logger().Info("Trustee term expired", "address", trustee.Address.String(), "expiry_height", trustee.ExpiryHeight)
+	}
+	if lastTrustee != nil {
+		k.AddTrustee(ctx, *lastTrustee)
+		ctx.Logger().Info("The term of the last trustee is lifted", "address", lastTrustee.Address.String())
+	}
+	return resTags
+}
+
+// returns the guardians whose term has ended. A role must never be left empty, so if all the
+// guardians of the role have expired, the one with the latest expiry is kept and its term limit is lifted
+func splitExpiredGuardians(guardians []Guardian, height int64) (expired []Guardian, last *Guardian) {
+	for _, guardian := range guardians {
+		if guardian.IsExpired(height) {
+			expired = append(expired, guardian)
+		}
+	}
+	if len(expired) == 0 || len(expired) < len(guardians) {
+		return expired, nil
+	}
+
+	lastIndex := 0
+	for i, guardian := range expired {
+		if guardian.ExpiryHeight > expired[lastIndex].ExpiryHeight {
+			lastIndex = i
+		}
+	}
+	kept := expired[lastIndex]
+	kept.ExpiryHeight = 0
+	expired = append(expired[:lastIndex], expired[lastIndex+1:]...)
+	return expired, &kept
+}
@@ -0,0 +1,72 @@
+package guardian
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndBlockerRemovesExpiredGuardians(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	profiler := NewGuardian("test", Ordinary, addrs[0], addrs[1])
+	profiler.ExpiryHeight = 10
+	keeper.AddProfiler(ctx, profiler)
+	keeper.AddProfiler(ctx, NewGuardian("test", Ordinary, addrs[1], addrs[1]))
+
+	trustee := NewGuardian("test", Ordinary, addrs[0], addrs[1])
+	keeper.AddTrustee(ctx, trustee)
+
+	ctx = ctx.WithBlockHeight(9)
+	EndBlocker(ctx, keeper)
+	_, found := keeper.GetProfiler(ctx, addrs[0])
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(10)
+	resTags := EndBlocker(ctx, keeper)
+	_, found = keeper.GetProfiler(ctx, addrs[0])
+	require.False(t, found)
+	require.Equal(t, 2, len(resTags))
+
+	// a trustee without term limit never expires
+	_, found = keeper.GetTrustee(ctx, addrs[0])
+	require.True(t, found)
+}
+
+func TestEndBlockerKeepsTheLastGuardians(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	for i, height := range []int64{10, 10, 8} {
+		guardian := NewGuardian("test", Ordinary, addrs[i], addrs[0])
+		guardian.ExpiryHeight = height
+		keeper.AddProfiler(ctx, guardian)
+		keeper.AddTrustee(ctx, guardian)
+	}
+
+	// all the guardians expire in the same block, the one with the latest expiry is kept without term limit
+	ctx = ctx.WithBlockHeight(10)
+	resTags := EndBlocker(ctx, keeper)
+	require.Equal(t, 8, len(resTags))
+
+	var profilers, trustees []Guardian
+	keeper.IterateProfilers(ctx, func(profiler Guardian) bool {
+		profilers = append(profilers, profiler)
+		return false
+	})
+	keeper.IterateTrustees(ctx, func(trustee Guardian) bool {
+		trustees = append(trustees, trustee)
+		return false
+	})
+	require.Equal(t, 1, len(profilers))
+	require.Equal(t, 1, len(trustees))
+	require.Equal(t, int64(0), profilers[0].ExpiryHeight)
+	require.Equal(t, int64(0), trustees[0].ExpiryHeight)
+	require.Equal(t, profilers[0].Address, trustees[0].Address)
+	require.NotEqual(t, addrs[2], profilers[0].Address)
+
+	// the last guardians never expire
+	ctx = ctx.WithBlockHeight(20)
+	require.Equal(t, 0, len(EndBlocker(ctx, keeper)))
+	_, found := keeper.GetProfiler(ctx, profilers[0].Address)
+	require.True(t, found)
+}
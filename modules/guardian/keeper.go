@@ -81,3 +81,29 @@ func (k Keeper) TrusteesIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, GetTrusteesSubspaceKey())
 }
+
+// Iterates over all profilers
+func (k Keeper) IterateProfilers(ctx sdk.Context, fn func(profiler Guardian) (stop bool)) {
+	iterator := k.ProfilersIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var profiler Guardian
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &profiler)
+		if fn(profiler) {
+			break
+		}
+	}
+}
+
+// Iterates over all trustees
+func (k Keeper) IterateTrustees(ctx sdk.Context, fn func(trustee Guardian) (stop bool)) {
+	iterator := k.TrusteesIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var trustee Guardian
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &trustee)
+		if fn(trustee) {
+			break
+		}
+	}
+}
@@ -6,4 +6,9 @@ import (
 
 var (
 	Action = sdk.TagAction
+
+	ActionGuardianExpired = []byte("guardian-expired")
+
+	Profiler = "profiler"
+	Trustee  = "trustee"
 )
@@ -9,10 +9,11 @@ import (
 )
 
 type Guardian struct {
-	Description string         `json:"description"`
-	AccountType AccountType    `json:"type"`
-	Address     sdk.AccAddress `json:"address"`  // this guardian's address
-	AddedBy     sdk.AccAddress `json:"added_by"` // address that initiated the AddGuardian tx
+	Description  string         `json:"description"`
+	AccountType  AccountType    `json:"type"`
+	Address      sdk.AccAddress `json:"address"`       // this guardian's address
+	AddedBy      sdk.AccAddress `json:"added_by"`      // address that initiated the AddGuardian tx
+	ExpiryHeight int64          `json:"expiry_height"` // height at which the term ends, 0 means no term limit
 }
 
 type Profilers []Guardian
@@ -27,7 +28,8 @@ func (ps Profilers) String() (out string) {
   Type:          %s
   Description:   %s
   AddedBy:       %s
-`, val.Address, val.AccountType, val.Description, val.AddedBy)
+  ExpiryHeight:  %d
+`, val.Address, val.AccountType, val.Description, val.AddedBy, val.ExpiryHeight)
 	}
 	return strings.TrimSpace(out)
 }
@@ -44,7 +46,8 @@ func (ts Trustees) String() (out string) {
   Type:          %s
   Description:   %s
   AddedBy:       %s
-`, val.Address, val.AccountType, val.Description, val.AddedBy)
+  ExpiryHeight:  %d
+`, val.Address, val.AccountType, val.Description, val.AddedBy, val.ExpiryHeight)
 	}
 	return strings.TrimSpace(out)
 }
@@ -62,7 +65,13 @@ func (g Guardian) Equal(guardian Guardian) bool {
 	return g.Address.Equals(guardian.Address) &&
 		g.AddedBy.Equals(guardian.AddedBy) &&
 		g.Description == guardian.Description &&
-		g.AccountType == guardian.AccountType
+		g.AccountType == guardian.AccountType &&
+		g.ExpiryHeight == guardian.ExpiryHeight
+}
+
+// Whether the term of the guardian has ended at the given height
+func (g Guardian) IsExpired(height int64) bool {
+	return g.ExpiryHeight > 0 && height >= g.ExpiryHeight
 }
 
 type AccountType byte
@@ -460,3 +460,6 @@ func (v Validator) GetTokens() sdk.Dec          { return v.Tokens }
 func (v Validator) GetCommission() sdk.Dec      { return v.Commission.Rate }
 func (v Validator) GetDelegatorShares() sdk.Dec { return v.DelegatorShares }
 func (v Validator) GetBondHeight() int64        { return v.BondHeight }
+
+// the validators of this version have no minimum self-delegation
+func (v Validator) GetMinSelfDelegation() sdk.Int { return sdk.ZeroInt() }
@@ -18,12 +18,21 @@ import (
 	"github.com/tendermint/tendermint/libs/common"
 )
 
+const (
+	flagVestingAmount = "vesting-amount"
+	flagVestingStart  = "vesting-start-time"
+	flagVestingEnd    = "vesting-end-time"
+)
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command
 func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-genesis-account [address] [coin][,[coin]]",
 		Short: "Add genesis account to genesis.json",
-		Args:  cobra.ExactArgs(2),
+		Long: `Add genesis account to genesis.json. A vesting account is added if --vesting-amount is specified,
+the vesting coins are unlocked linearly from --vesting-start-time to --vesting-end-time, or all at once
+at --vesting-end-time if no start time is given.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(_ *cobra.Command, args []string) error {
 			config := ctx.Config
 			config.SetRoot(viper.GetString(cli.HomeFlag))
@@ -42,6 +51,29 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 			coins.Sort()
+
+			var vestingCoins sdk.Coins
+			vestingStart := viper.GetInt64(flagVestingStart)
+			vestingEnd := viper.GetInt64(flagVestingEnd)
+			if vestingAmt := viper.GetString(flagVestingAmount); len(vestingAmt) > 0 {
+				vestingCoins, err = cliCtx.ParseCoins(vestingAmt)
+				if err != nil {
+					return err
+				}
+				vestingCoins.Sort()
+				if !coins.IsAllGTE(vestingCoins) {
+					return fmt.Errorf("vesting amount %s exceeds the account coins %s", vestingCoins, coins)
+				}
+				if vestingEnd <= 0 {
+					return fmt.Errorf("--%s must be specified for a vesting account", flagVestingEnd)
+				}
+				if vestingStart != 0 && vestingStart >= vestingEnd {
+					return fmt.Errorf("--%s must be before --%s", flagVestingStart, flagVestingEnd)
+				}
+			} else if vestingStart != 0 || vestingEnd != 0 {
+				return fmt.Errorf("--%s must be specified for a vesting account", flagVestingAmount)
+			}
+
 			genFile := config.GenesisFile()
 			if !common.FileExists(genFile) {
 				return fmt.Errorf("%s does not exist, run `iris init` first", genFile)
@@ -56,7 +88,15 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 			}
 			acc := auth.NewBaseAccountWithAddress(addr)
 			acc.Coins = coins
-			genesisState.Accounts = append(genesisState.Accounts, v1.NewGenesisFileAccount(&acc))
+			genAcc := v1.NewGenesisFileAccount(&acc)
+			if !vestingCoins.IsZero() {
+				for _, coin := range vestingCoins {
+					genAcc.OriginalVesting = append(genAcc.OriginalVesting, coin.String())
+				}
+				genAcc.StartTime = vestingStart
+				genAcc.EndTime = vestingEnd
+			}
+			genesisState.Accounts = append(genesisState.Accounts, genAcc)
 			appStateJSON, err := cdc.MarshalJSON(genesisState)
 			if err != nil {
 				return err
@@ -65,5 +105,8 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 		},
 	}
 	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagVestingAmount, "", "amount of coins locked by the vesting schedule")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for a continuous vesting account")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for a vesting account")
 	return cmd
 }
@@ -189,6 +189,7 @@ func initTestnet(config *cfg.Config, cdc *codec.Codec) error {
 			sdk.ValAddress(addr),
 			valPubKeys[i],
 			sdk.FreeToken4Val,
+			sdk.OneInt(),
 			stake.NewDescription(nodeDirName, "", "", ""),
 			stake.NewCommissionMsg(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(100, 2), sdk.NewDecWithPrec(100, 2)),
 		)
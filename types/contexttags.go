@@ -22,6 +22,7 @@ const (
 	ServiceDepositFlow       = "ServiceDeposit"
 	ServiceDepositRefundFlow = "ServiceDepositRefund"
 	MintTokenFlow            = "MintToken"
+	BurnTokenFlow            = "BurnToken"
 	IssueTokenFlow           = "IssueToken"
 
 	//Trigger: transaction hash, module endBlock
@@ -48,6 +48,7 @@ type Validator interface {
 	GetCommission() Dec           // validator commission rate
 	GetDelegatorShares() Dec      // Total out standing delegator shares
 	GetBondHeight() int64         // height in which the validator became active
+	GetMinSelfDelegation() Int    // minimum self-delegation of the validator operator
 }
 
 // validator which fulfills abci validator interface for use in Tendermint
@@ -124,6 +125,8 @@ type StakingHooks interface {
 	OnValidatorBeginUnbonding(ctx Context, consAddr ConsAddress, valAddr ValAddress) // Must be called when a validator begins unbonding
 	OnValidatorPowerDidChange(ctx Context, consAddr ConsAddress, valAddr ValAddress) // Called at EndBlock when a validator's power did change
 
+	OnValidatorConsPubKeyRotated(ctx Context, valAddr ValAddress, oldConsAddr, newConsAddr ConsAddress) // Called at EndBlock when a validator's consensus key is rotated
+
 	OnDelegationCreated(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called when a delegation is created
 	OnDelegationSharesModified(ctx Context, delAddr AccAddress, valAddr ValAddress) // Must be called when a delegation's shares are modified
 	OnDelegationRemoved(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called when a delegation is removed